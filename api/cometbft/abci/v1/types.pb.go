@@ -2047,6 +2047,10 @@ type InfoResponse struct {
 	AppVersion       uint64 `protobuf:"varint,3,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
 	LastBlockHeight  int64  `protobuf:"varint,4,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
 	LastBlockAppHash []byte `protobuf:"bytes,5,opt,name=last_block_app_hash,json=lastBlockAppHash,proto3" json:"last_block_app_hash,omitempty"`
+	// Whether the application supports FinalizeBlock being called again at the
+	// same height before Commit, discarding the state of the previous call,
+	// which is required for CometBFT to execute blocks optimistically.
+	OptimisticExecution bool `protobuf:"varint,6,opt,name=optimistic_execution,json=optimisticExecution,proto3" json:"optimistic_execution,omitempty"`
 }
 
 func (m *InfoResponse) Reset()         { *m = InfoResponse{} }
@@ -2117,6 +2121,13 @@ func (m *InfoResponse) GetLastBlockAppHash() []byte {
 	return nil
 }
 
+func (m *InfoResponse) GetOptimisticExecution() bool {
+	if m != nil {
+		return m.OptimisticExecution
+	}
+	return false
+}
+
 // InitChainResponse contains the ABCI application's hash and updates to the
 // validator set and/or the consensus params, if any.
 type InitChainResponse struct {
@@ -2300,6 +2311,13 @@ type CheckTxResponse struct {
 	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// Priority of the transaction, used by the priority mempool to order
+	// transactions for reaping and eviction. Higher values are reaped first.
+	Priority int64 `protobuf:"varint,12,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Identifier of the mempool lane the transaction is assigned to. If empty,
+	// the transaction goes to the default lane. It is ignored if the node has no
+	// mempool lanes configured.
+	LaneId string `protobuf:"bytes,13,opt,name=lane_id,json=laneId,proto3" json:"lane_id,omitempty"`
 }
 
 func (m *CheckTxResponse) Reset()         { *m = CheckTxResponse{} }
@@ -2391,6 +2409,20 @@ func (m *CheckTxResponse) GetCodespace() string {
 	return ""
 }
 
+func (m *CheckTxResponse) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CheckTxResponse) GetLaneId() string {
+	if m != nil {
+		return m.LaneId
+	}
+	return ""
+}
+
 // CommitResponse indicates how much blocks should CometBFT retain.
 type CommitResponse struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
@@ -3775,208 +3807,211 @@ func init() {
 func init() { proto.RegisterFile("cometbft/abci/v1/types.proto", fileDescriptor_95dd8f7b670b96e3) }
 
 var fileDescriptor_95dd8f7b670b96e3 = []byte{
-	// 3214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6c, 0x1b, 0xd7,
-	0xb9, 0xf6, 0xf0, 0x21, 0x91, 0x3f, 0x49, 0x69, 0x74, 0x24, 0xd9, 0xb4, 0xe2, 0x48, 0xf2, 0x38,
-	0x8e, 0x1d, 0x3b, 0x91, 0xae, 0x9d, 0xdc, 0x3c, 0x6e, 0x6e, 0x12, 0x50, 0x34, 0x15, 0x49, 0x96,
-	0x45, 0x66, 0x48, 0xeb, 0xc6, 0xc6, 0x6d, 0x27, 0x43, 0xf2, 0x90, 0x9c, 0x98, 0xe4, 0x4c, 0x66,
-	0x0e, 0x15, 0xaa, 0x5d, 0xb5, 0x68, 0x8a, 0x22, 0xab, 0x6c, 0x0a, 0x14, 0x45, 0x0b, 0x14, 0x28,
-	0xba, 0x2a, 0xd0, 0x45, 0x57, 0xdd, 0x74, 0x5b, 0x64, 0xd5, 0x66, 0xd9, 0x55, 0x5a, 0x24, 0xbb,
-	0xee, 0x03, 0x74, 0x59, 0x9c, 0xc7, 0xbc, 0xc8, 0x19, 0x49, 0x76, 0xd2, 0x45, 0xd1, 0xee, 0x78,
-	0xce, 0xf9, 0xfe, 0x7f, 0xce, 0xe3, 0x3f, 0xff, 0xe3, 0x3b, 0x84, 0x4b, 0x2d, 0x73, 0x80, 0x49,
-	0xb3, 0x43, 0x36, 0xf5, 0x66, 0xcb, 0xd8, 0x3c, 0xba, 0xb5, 0x49, 0x8e, 0x2d, 0xec, 0x6c, 0x58,
-	0xb6, 0x49, 0x4c, 0x24, 0xbb, 0xa3, 0x1b, 0x74, 0x74, 0xe3, 0xe8, 0xd6, 0xca, 0xaa, 0x87, 0x6f,
-	0xd9, 0xc7, 0x16, 0x31, 0xa9, 0x84, 0x65, 0x9b, 0x66, 0x87, 0x4b, 0x04, 0xc6, 0x99, 0x1e, 0x36,
-	0xac, 0xdb, 0xfa, 0x40, 0x68, 0x5c, 0xb9, 0x3c, 0x3d, 0x7e, 0xa4, 0xf7, 0x8d, 0xb6, 0x4e, 0x4c,
-	0x5b, 0x40, 0x96, 0xba, 0x66, 0xd7, 0x64, 0x3f, 0x37, 0xe9, 0x2f, 0xd1, 0xbb, 0xd6, 0x35, 0xcd,
-	0x6e, 0x1f, 0x6f, 0xb2, 0x56, 0x73, 0xd4, 0xd9, 0x24, 0xc6, 0x00, 0x3b, 0x44, 0x1f, 0x58, 0xee,
-	0x97, 0x27, 0x01, 0xed, 0x91, 0xad, 0x13, 0xc3, 0x1c, 0xf2, 0x71, 0xe5, 0x4f, 0x59, 0x98, 0x55,
-	0xf1, 0x07, 0x23, 0xec, 0x10, 0xf4, 0x22, 0xa4, 0x70, 0xab, 0x67, 0x16, 0xa5, 0x75, 0xe9, 0x7a,
-	0xee, 0xf6, 0xd3, 0x1b, 0x93, 0xcb, 0xdc, 0xa8, 0xb4, 0x7a, 0xa6, 0x00, 0xef, 0x9c, 0x53, 0x19,
-	0x18, 0xbd, 0x0c, 0xe9, 0x4e, 0x7f, 0xe4, 0xf4, 0x8a, 0x09, 0x26, 0xb5, 0x3a, 0x2d, 0xb5, 0x4d,
-	0x87, 0x7d, 0x31, 0x0e, 0xa7, 0x1f, 0x33, 0x86, 0x1d, 0xb3, 0x98, 0x8c, 0xfb, 0xd8, 0xee, 0xb0,
-	0x13, 0xfc, 0x18, 0x05, 0xa3, 0x32, 0x80, 0x31, 0x34, 0x88, 0xd6, 0xea, 0xe9, 0xc6, 0xb0, 0x98,
-	0x66, 0xa2, 0x4a, 0x94, 0xa8, 0x41, 0xca, 0x14, 0xe2, 0xcb, 0x67, 0x0d, 0xb7, 0x8f, 0xce, 0xf8,
-	0x83, 0x11, 0xb6, 0x8f, 0x8b, 0x33, 0x71, 0x33, 0x7e, 0x87, 0x0e, 0x07, 0x66, 0xcc, 0xe0, 0xe8,
-	0x0d, 0xc8, 0xb4, 0x7a, 0xb8, 0xf5, 0x48, 0x23, 0xe3, 0x62, 0x86, 0x89, 0xae, 0x4f, 0x8b, 0x96,
-	0x29, 0xa2, 0x31, 0xf6, 0x85, 0x67, 0x5b, 0xbc, 0x07, 0xbd, 0x06, 0x33, 0x2d, 0x73, 0x30, 0x30,
-	0x48, 0x31, 0xc7, 0x84, 0xd7, 0x22, 0x84, 0xd9, 0xb8, 0x2f, 0x2b, 0x04, 0x50, 0x15, 0xe6, 0xfa,
-	0x86, 0x43, 0x34, 0x67, 0xa8, 0x5b, 0x4e, 0xcf, 0x24, 0x4e, 0x31, 0xcf, 0x54, 0x3c, 0x3b, 0xad,
-	0x62, 0xdf, 0x70, 0x48, 0xdd, 0x85, 0xf9, 0x9a, 0x0a, 0xfd, 0x60, 0x3f, 0x55, 0x68, 0x76, 0x3a,
-	0xd8, 0xf6, 0x34, 0x16, 0x0b, 0x71, 0x0a, 0xab, 0x14, 0xe7, 0x4a, 0x06, 0x14, 0x9a, 0xc1, 0x7e,
-	0xf4, 0xff, 0xb0, 0xd8, 0x37, 0xf5, 0xb6, 0xa7, 0x4f, 0x6b, 0xf5, 0x46, 0xc3, 0x47, 0xc5, 0x39,
-	0xa6, 0xf5, 0x46, 0xc4, 0x34, 0x4d, 0xbd, 0xed, 0x0a, 0x97, 0x29, 0xd4, 0xd7, 0xbc, 0xd0, 0x9f,
-	0x1c, 0x43, 0x1a, 0x2c, 0xe9, 0x96, 0xd5, 0x3f, 0x9e, 0x54, 0x3f, 0xcf, 0xd4, 0xdf, 0x9c, 0x56,
-	0x5f, 0xa2, 0xe8, 0x18, 0xfd, 0x48, 0x9f, 0x1a, 0x44, 0xf7, 0x41, 0xb6, 0x6c, 0x6c, 0xe9, 0x36,
-	0xd6, 0x2c, 0xdb, 0xb4, 0x4c, 0x47, 0xef, 0x17, 0x65, 0xa6, 0xfc, 0xfa, 0xb4, 0xf2, 0x1a, 0x47,
-	0xd6, 0x04, 0xd0, 0xd7, 0x3c, 0x6f, 0x85, 0x47, 0xb8, 0x5a, 0xb3, 0x85, 0x1d, 0xc7, 0x57, 0xbb,
-	0x10, 0xaf, 0x96, 0x21, 0x23, 0xd5, 0x86, 0x46, 0xd0, 0x36, 0xe4, 0xf0, 0x98, 0xe0, 0x61, 0x5b,
-	0x3b, 0x32, 0x09, 0x2e, 0x22, 0xa6, 0xf1, 0x4a, 0xc4, 0x75, 0x65, 0xa0, 0x43, 0x93, 0x60, 0x5f,
-	0x19, 0x60, 0xaf, 0x13, 0x35, 0x61, 0xf9, 0x08, 0xdb, 0x46, 0xe7, 0x98, 0xe9, 0xd1, 0xd8, 0x88,
-	0x63, 0x98, 0xc3, 0xe2, 0x22, 0xd3, 0xf8, 0xfc, 0xb4, 0xc6, 0x43, 0x06, 0xa7, 0xc2, 0x15, 0x17,
-	0xec, 0xab, 0x5e, 0x3c, 0x9a, 0x1e, 0xa5, 0x96, 0xd6, 0x31, 0x86, 0x7a, 0xdf, 0xf8, 0x0e, 0xd6,
-	0x9a, 0x7d, 0xb3, 0xf5, 0xa8, 0xb8, 0x14, 0x67, 0x69, 0xdb, 0x02, 0xb7, 0x45, 0x61, 0x01, 0x4b,
-	0xeb, 0x04, 0xfb, 0xb7, 0x66, 0x21, 0x7d, 0xa4, 0xf7, 0x47, 0x78, 0x2f, 0x95, 0x49, 0xc9, 0xe9,
-	0xbd, 0x54, 0x66, 0x56, 0xce, 0xec, 0xa5, 0x32, 0x59, 0x19, 0xf6, 0x52, 0x19, 0x90, 0x73, 0xca,
-	0x35, 0xc8, 0x05, 0xfc, 0x14, 0x2a, 0xc2, 0xec, 0x00, 0x3b, 0x8e, 0xde, 0xc5, 0xcc, 0xaf, 0x65,
-	0x55, 0xb7, 0xa9, 0xcc, 0x41, 0x3e, 0xe8, 0x9a, 0x94, 0x4f, 0x24, 0xc8, 0x05, 0x9c, 0x0e, 0x95,
-	0x3c, 0xc2, 0x36, 0xdb, 0x10, 0x21, 0x29, 0x9a, 0xe8, 0x0a, 0x14, 0xd8, 0x5a, 0x34, 0x77, 0x9c,
-	0xfa, 0xbe, 0x94, 0x9a, 0x67, 0x9d, 0x87, 0x02, 0xb4, 0x06, 0x39, 0xeb, 0xb6, 0xe5, 0x41, 0x92,
-	0x0c, 0x02, 0xd6, 0x6d, 0xcb, 0x05, 0x5c, 0x86, 0x3c, 0x5d, 0xba, 0x87, 0x48, 0xb1, 0x8f, 0xe4,
-	0x68, 0x9f, 0x80, 0x28, 0x7f, 0x4c, 0x80, 0x3c, 0xe9, 0xcc, 0xd0, 0xab, 0x90, 0xa2, 0x5e, 0x5e,
-	0xb8, 0xe9, 0x95, 0x0d, 0xee, 0xe1, 0x37, 0x5c, 0x0f, 0xbf, 0xd1, 0x70, 0x43, 0xc0, 0x56, 0xe6,
-	0xd3, 0xcf, 0xd7, 0xce, 0x7d, 0xf2, 0x97, 0x35, 0x49, 0x65, 0x12, 0xe8, 0x22, 0xf5, 0x60, 0xba,
-	0x31, 0xd4, 0x8c, 0x36, 0x9b, 0x72, 0x96, 0x7a, 0x27, 0xdd, 0x18, 0xee, 0xb6, 0xd1, 0x3d, 0x90,
-	0x5b, 0xe6, 0xd0, 0xc1, 0x43, 0x67, 0xe4, 0x68, 0x3c, 0x36, 0x09, 0xd7, 0x1c, 0xf0, 0xaf, 0x3c,
-	0x08, 0x32, 0x47, 0x25, 0xa0, 0x35, 0x86, 0x54, 0xe7, 0x5b, 0xe1, 0x0e, 0xf4, 0x36, 0x80, 0x17,
-	0xc0, 0x9c, 0x62, 0x6a, 0x3d, 0x79, 0x3d, 0x77, 0xfb, 0x72, 0x84, 0x3d, 0xb9, 0x98, 0xfb, 0x56,
-	0x5b, 0x27, 0x78, 0x2b, 0x45, 0x27, 0xac, 0x06, 0x44, 0xd1, 0xb3, 0x30, 0xaf, 0x5b, 0x96, 0xe6,
-	0x10, 0x9d, 0x60, 0xad, 0x79, 0x4c, 0xb0, 0xc3, 0xdc, 0x7e, 0x5e, 0x2d, 0xe8, 0x96, 0x55, 0xa7,
-	0xbd, 0x5b, 0xb4, 0x13, 0x5d, 0x85, 0x39, 0xea, 0xe1, 0x0d, 0xbd, 0xaf, 0xf5, 0xb0, 0xd1, 0xed,
-	0x11, 0xe6, 0xdd, 0x93, 0x6a, 0x41, 0xf4, 0xee, 0xb0, 0x4e, 0xa5, 0x0d, 0xf9, 0xa0, 0x73, 0x47,
-	0x08, 0x52, 0x6d, 0x9d, 0xe8, 0x6c, 0x2f, 0xf3, 0x2a, 0xfb, 0x4d, 0xfb, 0x2c, 0x9d, 0xf4, 0xc4,
-	0x0e, 0xb1, 0xdf, 0xe8, 0x3c, 0xcc, 0x08, 0xb5, 0x49, 0xa6, 0x56, 0xb4, 0xd0, 0x12, 0xa4, 0x2d,
-	0xdb, 0x3c, 0xc2, 0xec, 0xf0, 0x32, 0x2a, 0x6f, 0x28, 0x0f, 0x60, 0x2e, 0x1c, 0x07, 0xd0, 0x1c,
-	0x24, 0xc8, 0x58, 0x7c, 0x25, 0x41, 0xc6, 0xe8, 0x16, 0xa4, 0xe8, 0x66, 0x32, 0x6d, 0x73, 0x51,
-	0xd1, 0x4f, 0xc8, 0x37, 0x8e, 0x2d, 0xac, 0x32, 0xe8, 0x5e, 0x2a, 0x93, 0x90, 0x93, 0xca, 0x3c,
-	0x14, 0x42, 0x51, 0x42, 0x39, 0x0f, 0x4b, 0x51, 0x3e, 0x5f, 0x31, 0x60, 0x29, 0xca, 0x75, 0xa3,
-	0x97, 0x21, 0xe3, 0x39, 0x7d, 0xd7, 0x82, 0xa6, 0xbe, 0xee, 0x09, 0x79, 0x58, 0x6a, 0x3b, 0xf4,
-	0x20, 0x7a, 0xba, 0x08, 0xf5, 0x79, 0x75, 0x56, 0xb7, 0xac, 0x1d, 0xdd, 0xe9, 0x29, 0xef, 0x41,
-	0x31, 0xce, 0x9f, 0x07, 0x36, 0x4e, 0x62, 0x17, 0xc0, 0xdd, 0xb8, 0xf3, 0x30, 0xd3, 0x31, 0xed,
-	0x81, 0x4e, 0x98, 0xb2, 0x82, 0x2a, 0x5a, 0x74, 0x43, 0xb9, 0x6f, 0x4f, 0xb2, 0x6e, 0xde, 0x50,
-	0x34, 0xb8, 0x18, 0xeb, 0xd2, 0xa9, 0x88, 0x31, 0x6c, 0x63, 0xbe, 0xbd, 0x05, 0x95, 0x37, 0x7c,
-	0x45, 0x7c, 0xb2, 0xbc, 0x41, 0x3f, 0xeb, 0xe0, 0x61, 0x1b, 0xdb, 0x4c, 0x7f, 0x56, 0x15, 0x2d,
-	0xe5, 0xa7, 0x49, 0x38, 0x1f, 0xed, 0xd7, 0xd1, 0x3a, 0xe4, 0x07, 0xfa, 0x58, 0x23, 0x63, 0x61,
-	0x7e, 0x12, 0x33, 0x00, 0x18, 0xe8, 0xe3, 0xc6, 0x98, 0xdb, 0x9e, 0x0c, 0x49, 0x32, 0x76, 0x8a,
-	0x89, 0xf5, 0xe4, 0xf5, 0xbc, 0x4a, 0x7f, 0xa2, 0x43, 0x58, 0xe8, 0x9b, 0x2d, 0xbd, 0xaf, 0xf5,
-	0x75, 0x87, 0x68, 0x22, 0xec, 0xf3, 0xeb, 0xf4, 0x4c, 0x9c, 0x9f, 0xc6, 0x6d, 0x7e, 0xb0, 0xd4,
-	0x05, 0x89, 0x8b, 0x30, 0xcf, 0x94, 0xec, 0xeb, 0x0e, 0xe1, 0x43, 0xa8, 0x02, 0xb9, 0x81, 0xe1,
-	0x34, 0x71, 0x4f, 0x3f, 0x32, 0x4c, 0x5b, 0xdc, 0xab, 0x08, 0xeb, 0xb9, 0xe7, 0x83, 0x84, 0xaa,
-	0xa0, 0x5c, 0xe0, 0x50, 0xd2, 0x21, 0x6b, 0x76, 0x3d, 0xcb, 0xcc, 0x63, 0x7b, 0x96, 0xff, 0x82,
-	0xa5, 0x21, 0x1e, 0x13, 0xcd, 0xbf, 0xb9, 0xdc, 0x52, 0x66, 0xd9, 0xe6, 0x23, 0x3a, 0xe6, 0xdd,
-	0x75, 0x87, 0x1a, 0x0d, 0x7a, 0x8e, 0xc5, 0x46, 0xcb, 0x74, 0xb0, 0xad, 0xe9, 0xed, 0xb6, 0x8d,
-	0x1d, 0x87, 0x65, 0x55, 0x79, 0x16, 0xef, 0x58, 0x7f, 0x89, 0x77, 0x2b, 0x1f, 0xb3, 0xc3, 0x89,
-	0x8a, 0x8e, 0xee, 0xd6, 0x4b, 0xfe, 0xd6, 0x37, 0x60, 0x49, 0xc8, 0xb7, 0x43, 0xbb, 0xcf, 0xd3,
-	0xd3, 0x4b, 0x71, 0x49, 0x57, 0x60, 0xd7, 0x91, 0x2b, 0x1f, 0xbf, 0xf1, 0xc9, 0x27, 0xdc, 0x78,
-	0x04, 0x29, 0xb6, 0x2d, 0x29, 0xee, 0x6e, 0xe8, 0xef, 0x7f, 0xb5, 0xc3, 0xf8, 0x28, 0x09, 0x0b,
-	0x53, 0x89, 0x85, 0xb7, 0x30, 0x29, 0x72, 0x61, 0x89, 0xc8, 0x85, 0x25, 0x1f, 0x7b, 0x61, 0xe2,
-	0xb4, 0x53, 0xa7, 0x9f, 0x76, 0xfa, 0x9b, 0x3c, 0xed, 0x99, 0x27, 0x3c, 0xed, 0x7f, 0xea, 0x39,
-	0xfc, 0x4c, 0x82, 0x95, 0xf8, 0x74, 0x2c, 0xf2, 0x40, 0x6e, 0xc2, 0x82, 0x37, 0x15, 0x4f, 0x3d,
-	0x77, 0x8f, 0xb2, 0x37, 0x20, 0xf4, 0xc7, 0x46, 0xbc, 0xab, 0x30, 0x37, 0x91, 0x2d, 0x72, 0x63,
-	0x2e, 0x1c, 0x05, 0xa7, 0xa1, 0xfc, 0x36, 0x09, 0x4b, 0x51, 0x09, 0x5d, 0xc4, 0x8d, 0x55, 0x61,
-	0xb1, 0x8d, 0x5b, 0x46, 0xfb, 0x89, 0x2f, 0xec, 0x82, 0x10, 0xff, 0xcf, 0x7d, 0x9d, 0xb6, 0x13,
-	0x74, 0x03, 0x16, 0x9c, 0xe3, 0x61, 0xcb, 0x18, 0x76, 0x35, 0x62, 0xba, 0xb9, 0x51, 0x96, 0xcd,
-	0x7c, 0x5e, 0x0c, 0x34, 0x4c, 0x91, 0x1d, 0xfd, 0x0a, 0x20, 0xa3, 0x62, 0xc7, 0xa2, 0xc9, 0x1c,
-	0x2a, 0x43, 0x16, 0x8f, 0x5b, 0xd8, 0x22, 0x6e, 0x02, 0x1c, 0x53, 0x63, 0x08, 0x88, 0x2b, 0x47,
-	0x6b, 0x6d, 0x4f, 0x0e, 0xbd, 0x24, 0x28, 0x85, 0x58, 0x72, 0x80, 0xa7, 0xea, 0x9e, 0x28, 0xe7,
-	0x14, 0x5e, 0x71, 0x39, 0x85, 0x64, 0x5c, 0xa5, 0x2c, 0x12, 0x77, 0x4f, 0x4e, 0x90, 0x0a, 0x2f,
-	0x09, 0x52, 0x21, 0x15, 0xf7, 0x39, 0x9e, 0xdf, 0xfb, 0x9f, 0x63, 0xac, 0xc2, 0x9d, 0x10, 0xab,
-	0x30, 0x13, 0xb7, 0xd4, 0x40, 0x22, 0xee, 0x2f, 0xd5, 0xa7, 0x15, 0x5e, 0x71, 0x69, 0x85, 0xd9,
-	0xb8, 0x49, 0x8b, 0xcc, 0xd3, 0x9f, 0x34, 0xe7, 0x15, 0xde, 0x0c, 0xf0, 0x0a, 0x59, 0x26, 0x7b,
-	0xf9, 0x04, 0x5e, 0xc1, 0x93, 0xf6, 0x88, 0x85, 0xff, 0xf1, 0x88, 0x85, 0x7c, 0x2c, 0x2b, 0x21,
-	0x52, 0x46, 0x4f, 0xd8, 0x65, 0x16, 0x6a, 0x53, 0xcc, 0x02, 0x27, 0x02, 0xae, 0x9d, 0xca, 0x2c,
-	0x78, 0xaa, 0x26, 0xa8, 0x85, 0xda, 0x14, 0xb5, 0x30, 0x17, 0xa7, 0x71, 0x22, 0x3f, 0xf5, 0x35,
-	0x86, 0xb9, 0x85, 0x6f, 0x45, 0x73, 0x0b, 0xb1, 0xc5, 0x7f, 0x44, 0x2e, 0xea, 0xa9, 0x8e, 0x20,
-	0x17, 0xde, 0x8b, 0x21, 0x17, 0xe4, 0xb8, 0x22, 0x38, 0x2a, 0x13, 0xf5, 0x3e, 0x10, 0xc5, 0x2e,
-	0x1c, 0x46, 0xb0, 0x0b, 0x9c, 0x06, 0x78, 0xee, 0x0c, 0xec, 0x82, 0xa7, 0x7a, 0x8a, 0x5e, 0x38,
-	0x8c, 0xa0, 0x17, 0x50, 0xbc, 0xde, 0x89, 0x04, 0x2a, 0xa8, 0x37, 0xcc, 0x2f, 0xbc, 0x1d, 0xe6,
-	0x17, 0x16, 0x4f, 0xce, 0x5b, 0x79, 0x1a, 0xe0, 0x69, 0x0b, 0x12, 0x0c, 0xad, 0x38, 0x82, 0x81,
-	0x73, 0x00, 0x2f, 0x9c, 0x91, 0x60, 0xf0, 0x74, 0x47, 0x32, 0x0c, 0xb5, 0x29, 0x86, 0x61, 0x39,
-	0xce, 0xe0, 0x26, 0x02, 0x92, 0x6f, 0x70, 0xb1, 0x14, 0x43, 0x5a, 0x9e, 0xd9, 0x4b, 0x65, 0x32,
-	0x72, 0x96, 0x93, 0x0b, 0x7b, 0xa9, 0x4c, 0x4e, 0xce, 0x2b, 0xcf, 0xd1, 0x14, 0x68, 0xc2, 0xef,
-	0xd1, 0x82, 0x03, 0xdb, 0xb6, 0x69, 0x0b, 0xb2, 0x80, 0x37, 0x94, 0xeb, 0x90, 0x0f, 0xba, 0xb8,
-	0x13, 0xe8, 0x88, 0x79, 0x28, 0x84, 0xbc, 0x9a, 0xf2, 0x3b, 0x09, 0xf2, 0x41, 0x7f, 0x15, 0x2a,
-	0x56, 0xb3, 0xa2, 0x58, 0x0d, 0x90, 0x14, 0x89, 0x30, 0x49, 0xb1, 0x06, 0x39, 0x5a, 0xb0, 0x4d,
-	0xf0, 0x0f, 0xba, 0xe5, 0xf1, 0x0f, 0x37, 0x60, 0x81, 0xc5, 0x5b, 0x4e, 0x65, 0x88, 0xc8, 0x90,
-	0xe2, 0x91, 0x81, 0x0e, 0xb0, 0xcd, 0xe0, 0x91, 0x01, 0xbd, 0x00, 0x8b, 0x01, 0xac, 0x57, 0x08,
-	0xf2, 0x52, 0x5c, 0xf6, 0xd0, 0x25, 0x51, 0x11, 0xfe, 0x41, 0x82, 0x85, 0x29, 0x77, 0x19, 0xc9,
-	0x31, 0x48, 0xdf, 0x14, 0xc7, 0x90, 0x78, 0x72, 0x8e, 0x21, 0x58, 0xda, 0x26, 0xc3, 0xa5, 0xed,
-	0xdf, 0x25, 0x28, 0x84, 0xdc, 0x36, 0x3d, 0x84, 0x96, 0xd9, 0xc6, 0xa2, 0xd8, 0x64, 0xbf, 0x69,
-	0x4e, 0xd3, 0x37, 0xbb, 0xa2, 0xa4, 0xa4, 0x3f, 0x29, 0xca, 0x0b, 0x44, 0x59, 0x11, 0x66, 0xbc,
-	0x3a, 0x95, 0xe7, 0x0d, 0xa2, 0x4e, 0x95, 0x21, 0xf9, 0x08, 0x73, 0x2e, 0x3a, 0xaf, 0xd2, 0x9f,
-	0x14, 0xc7, 0xcc, 0x4f, 0xc4, 0x7f, 0xde, 0x40, 0xaf, 0x41, 0x96, 0xbd, 0x28, 0x68, 0xa6, 0xe5,
-	0x08, 0xfa, 0x39, 0x90, 0x1b, 0xf1, 0x67, 0x07, 0x71, 0xcf, 0xcd, 0x4e, 0xd5, 0x72, 0xd4, 0x8c,
-	0x25, 0x7e, 0x05, 0x32, 0x96, 0x6c, 0x28, 0x63, 0xb9, 0x04, 0x59, 0x3a, 0x7d, 0xc7, 0xd2, 0x5b,
-	0xb8, 0x08, 0x6c, 0xa6, 0x7e, 0x87, 0xf2, 0xeb, 0x04, 0xcc, 0x4f, 0x44, 0x9d, 0xc8, 0xc5, 0xbb,
-	0x56, 0x99, 0x08, 0x50, 0x28, 0x67, 0xdb, 0x90, 0x55, 0x80, 0xae, 0xee, 0x68, 0x1f, 0xea, 0x43,
-	0x82, 0xdb, 0x62, 0x57, 0x02, 0x3d, 0x68, 0x05, 0x32, 0xb4, 0x35, 0x72, 0x70, 0x5b, 0xb0, 0x39,
-	0x5e, 0x1b, 0xed, 0xc2, 0x0c, 0x3e, 0xc2, 0x43, 0xe2, 0x14, 0x67, 0xd9, 0xc1, 0x5f, 0x88, 0x70,
-	0x4f, 0x74, 0x7c, 0xab, 0x48, 0x8f, 0xfb, 0x6f, 0x9f, 0xaf, 0xc9, 0x1c, 0xfe, 0xbc, 0x39, 0x30,
-	0x08, 0x1e, 0x58, 0xe4, 0x58, 0x15, 0x0a, 0xc2, 0xdb, 0x90, 0x99, 0xd8, 0x06, 0x46, 0x2d, 0xe6,
-	0x5d, 0x9e, 0x80, 0x6e, 0xaa, 0x61, 0xda, 0x06, 0x39, 0x56, 0x0b, 0x03, 0x3c, 0xb0, 0x4c, 0xb3,
-	0xaf, 0xf1, 0x7b, 0x5e, 0x82, 0xb9, 0x70, 0x90, 0x45, 0x57, 0xa0, 0x60, 0x63, 0xa2, 0x1b, 0x43,
-	0x2d, 0x94, 0x47, 0xe7, 0x79, 0x27, 0xbf, 0x57, 0x7b, 0xa9, 0x8c, 0x24, 0x27, 0x04, 0xb5, 0xf3,
-	0x0e, 0x2c, 0x47, 0xc6, 0x58, 0xf4, 0x2a, 0x64, 0xfd, 0xf8, 0x2c, 0xb1, 0xe5, 0x9e, 0xc4, 0xd9,
-	0xf8, 0x60, 0xe5, 0x10, 0x96, 0x23, 0x83, 0x2c, 0x7a, 0x03, 0x66, 0x6c, 0xec, 0x8c, 0xfa, 0x9c,
-	0x96, 0x99, 0xbb, 0x7d, 0xf5, 0xf4, 0xe8, 0x3c, 0xea, 0x13, 0x55, 0x08, 0x29, 0xb7, 0xe0, 0x62,
-	0x6c, 0x94, 0xf5, 0x99, 0x17, 0x29, 0xc0, 0xbc, 0x28, 0xbf, 0x91, 0x60, 0x25, 0x3e, 0x72, 0xa2,
-	0xad, 0x89, 0x09, 0xdd, 0x38, 0x63, 0xdc, 0x0d, 0xcc, 0x8a, 0x96, 0x26, 0x36, 0xee, 0x60, 0xd2,
-	0xea, 0xf1, 0x10, 0xce, 0x9d, 0x42, 0x41, 0x2d, 0x88, 0x5e, 0x26, 0xe3, 0x70, 0xd8, 0xfb, 0xb8,
-	0x45, 0x34, 0x7e, 0xa8, 0x0e, 0x2b, 0x0f, 0xb2, 0x14, 0x46, 0x7b, 0xeb, 0xbc, 0x53, 0xb9, 0x09,
-	0x17, 0x62, 0x62, 0xf1, 0x74, 0x0d, 0xa3, 0x3c, 0xa4, 0xe0, 0xc8, 0x00, 0x8b, 0xde, 0x82, 0x19,
-	0x87, 0xe8, 0x64, 0xe4, 0x88, 0x95, 0x5d, 0x3b, 0x35, 0x36, 0xd7, 0x19, 0x5c, 0x15, 0x62, 0xca,
-	0xeb, 0x80, 0xa6, 0x23, 0x6d, 0x44, 0x1d, 0x26, 0x45, 0xd5, 0x61, 0x4d, 0x78, 0xea, 0x84, 0x98,
-	0x8a, 0xca, 0x13, 0x93, 0xbb, 0x79, 0xa6, 0x90, 0x3c, 0x31, 0xc1, 0xdf, 0x27, 0x61, 0x39, 0x32,
-	0xb4, 0x06, 0x6e, 0xa9, 0xf4, 0x75, 0x6f, 0xe9, 0x1b, 0x00, 0x64, 0xac, 0xf1, 0x93, 0x76, 0xbd,
-	0x7d, 0x54, 0x3d, 0x31, 0xc6, 0x2d, 0xe6, 0xb0, 0xa8, 0x61, 0x64, 0x89, 0xf8, 0xe5, 0xa0, 0x46,
-	0xb0, 0xf6, 0x1d, 0xb1, 0x48, 0xe0, 0x88, 0xb2, 0xf0, 0xcc, 0x31, 0xc3, 0x2f, 0x92, 0x79, 0xb7,
-	0x83, 0x1e, 0xc2, 0x85, 0x89, 0x88, 0xe6, 0xe9, 0x4e, 0x9d, 0x39, 0xb0, 0x2d, 0x87, 0x03, 0x9b,
-	0xab, 0x3b, 0x18, 0x95, 0xd2, 0xa1, 0xa8, 0x44, 0x03, 0x29, 0x2b, 0x18, 0x79, 0x34, 0x6e, 0xe3,
-	0xbe, 0xee, 0x3e, 0x66, 0x5e, 0x9c, 0x2a, 0x3b, 0xef, 0x88, 0xf7, 0x5e, 0x5e, 0x75, 0xfe, 0x84,
-	0x56, 0x9d, 0x73, 0x54, 0x98, 0x1d, 0xd4, 0x1d, 0x2a, 0xaa, 0x3c, 0x04, 0xf0, 0x6b, 0x6a, 0x7a,
-	0x7d, 0x6d, 0x73, 0x34, 0x6c, 0x33, 0x8b, 0x48, 0xab, 0xbc, 0x81, 0x5e, 0x86, 0x34, 0x35, 0x2c,
-	0x77, 0xe7, 0x23, 0xfc, 0x0f, 0xb5, 0x90, 0x40, 0x51, 0xce, 0xe1, 0xca, 0xfb, 0xae, 0xf1, 0x06,
-	0xe9, 0xcd, 0x98, 0x6f, 0xbc, 0x19, 0xfe, 0x86, 0x12, 0xcf, 0x94, 0x46, 0x7f, 0xeb, 0xbb, 0x90,
-	0x66, 0xd6, 0x44, 0x83, 0x0d, 0x63, 0xd7, 0x45, 0xa2, 0x44, 0x7f, 0xa3, 0x6f, 0x03, 0xe8, 0x84,
-	0xd8, 0x46, 0x73, 0xe4, 0x7f, 0x61, 0x3d, 0xc6, 0x1c, 0x4b, 0x2e, 0x70, 0xeb, 0x92, 0xb0, 0xcb,
-	0x25, 0x5f, 0x36, 0x60, 0x9b, 0x01, 0x8d, 0xca, 0x01, 0xcc, 0x85, 0x65, 0xdd, 0xc8, 0xce, 0x27,
-	0x11, 0x8e, 0xec, 0x3c, 0x55, 0x13, 0x91, 0xdd, 0xcb, 0x0b, 0x92, 0xfc, 0x0d, 0x81, 0x35, 0x94,
-	0xef, 0x25, 0x20, 0x1f, 0x34, 0xe6, 0x7f, 0xc3, 0xd8, 0xab, 0xfc, 0x50, 0x82, 0x8c, 0xb7, 0xfe,
-	0xf0, 0x4b, 0x42, 0xe8, 0x09, 0x86, 0x6f, 0x5f, 0x22, 0x48, 0xff, 0xf3, 0x07, 0x97, 0xa4, 0xf7,
-	0xe0, 0xf2, 0xbf, 0x5e, 0x7c, 0x89, 0xe5, 0x06, 0x82, 0xbb, 0x2d, 0x0c, 0xcb, 0x8d, 0x77, 0xaf,
-	0x43, 0xd6, 0x73, 0x09, 0x34, 0xe5, 0x76, 0x39, 0x17, 0x49, 0xdc, 0x4b, 0xc1, 0xb5, 0x2c, 0x41,
-	0xda, 0x32, 0x3f, 0x14, 0x8f, 0x0b, 0x49, 0x95, 0x37, 0x14, 0x07, 0xe6, 0x27, 0xfc, 0x89, 0x0f,
-	0x4c, 0x04, 0x80, 0x48, 0x81, 0x82, 0x35, 0x6a, 0x6a, 0x8f, 0xf0, 0xb1, 0x78, 0x6a, 0xe0, 0xd3,
-	0xcf, 0x59, 0xa3, 0xe6, 0x5d, 0x7c, 0xcc, 0xdf, 0x1a, 0xd6, 0x21, 0xef, 0x62, 0x98, 0x89, 0xf3,
-	0x33, 0x05, 0x0e, 0x69, 0xf0, 0x77, 0x22, 0x49, 0x4e, 0x28, 0x3f, 0x96, 0x20, 0xe3, 0xde, 0x12,
-	0xf4, 0x16, 0x64, 0x3d, 0xd7, 0x25, 0x32, 0xee, 0xa7, 0x4e, 0x70, 0x7a, 0x62, 0xf1, 0xbe, 0x0c,
-	0xda, 0x72, 0x1f, 0x3c, 0x8d, 0xb6, 0xd6, 0xe9, 0xeb, 0x5d, 0xf1, 0x6e, 0xb5, 0x1a, 0xe1, 0xdd,
-	0x98, 0x5f, 0xd9, 0xbd, 0xb3, 0xdd, 0xd7, 0xbb, 0x6a, 0x8e, 0x09, 0xed, 0xb6, 0x69, 0x43, 0x24,
-	0x39, 0x5f, 0x49, 0x20, 0x4f, 0xde, 0xe2, 0xaf, 0x3f, 0xbf, 0xe9, 0x60, 0x98, 0x8c, 0x08, 0x86,
-	0x68, 0x13, 0x16, 0x3d, 0x84, 0xe6, 0x18, 0xdd, 0xa1, 0x4e, 0x46, 0x36, 0x16, 0xec, 0x1e, 0xf2,
-	0x86, 0xea, 0xee, 0xc8, 0xf4, 0xba, 0xd3, 0x4f, 0xba, 0xee, 0x8f, 0x12, 0x90, 0x0b, 0x90, 0x8d,
-	0xe8, 0xbf, 0x03, 0x2e, 0x6a, 0x2e, 0x2a, 0x04, 0x05, 0xc0, 0xfe, 0x23, 0x60, 0x78, 0xa7, 0x12,
-	0x4f, 0xb0, 0x53, 0x71, 0xb4, 0xae, 0xcb, 0x5e, 0xa6, 0x1e, 0x9b, 0xbd, 0x7c, 0x1e, 0x10, 0x31,
-	0x89, 0xde, 0xa7, 0x35, 0xbe, 0x31, 0xec, 0x6a, 0xdc, 0xb0, 0xb9, 0x47, 0x91, 0xd9, 0xc8, 0x21,
-	0x1b, 0xa8, 0xb1, 0xcb, 0xf0, 0x7d, 0x09, 0x32, 0x1e, 0xb3, 0xf3, 0xb8, 0x8f, 0x83, 0xe7, 0x61,
-	0x46, 0x24, 0x76, 0xfc, 0x75, 0x50, 0xb4, 0x22, 0x69, 0xda, 0x15, 0xc8, 0x0c, 0x30, 0xd1, 0x99,
-	0x7b, 0xe4, 0xe1, 0xd3, 0x6b, 0xdf, 0x68, 0x42, 0x2e, 0xf0, 0xbe, 0x8a, 0x2e, 0xc2, 0x72, 0x79,
-	0xa7, 0x52, 0xbe, 0xab, 0x35, 0xde, 0xd5, 0x1a, 0x0f, 0x6a, 0x15, 0xed, 0xfe, 0xc1, 0xdd, 0x83,
-	0xea, 0xff, 0x1d, 0xc8, 0xe7, 0xa6, 0x87, 0xd4, 0x0a, 0x6b, 0xcb, 0x12, 0xba, 0x00, 0x8b, 0xe1,
-	0x21, 0x3e, 0x90, 0x58, 0x49, 0xfd, 0xe8, 0x97, 0xab, 0xe7, 0x6e, 0x7c, 0x25, 0xc1, 0x62, 0x44,
-	0x0a, 0x8d, 0x2e, 0xc3, 0xd3, 0xd5, 0xed, 0xed, 0x8a, 0xaa, 0xd5, 0x0f, 0x4a, 0xb5, 0xfa, 0x4e,
-	0xb5, 0xa1, 0xa9, 0x95, 0xfa, 0xfd, 0xfd, 0x46, 0xe0, 0xa3, 0xeb, 0x70, 0x29, 0x1a, 0x52, 0x2a,
-	0x97, 0x2b, 0xb5, 0x86, 0x2c, 0xa1, 0x35, 0x78, 0x2a, 0x06, 0xb1, 0x55, 0x55, 0x1b, 0x72, 0x22,
-	0x5e, 0x85, 0x5a, 0xd9, 0xab, 0x94, 0x1b, 0x72, 0x12, 0x5d, 0x83, 0x2b, 0x27, 0x21, 0xb4, 0xed,
-	0xaa, 0x7a, 0xaf, 0xd4, 0x90, 0x53, 0xa7, 0x02, 0xeb, 0x95, 0x83, 0x3b, 0x15, 0x55, 0x4e, 0x8b,
-	0x75, 0xff, 0x22, 0x01, 0xc5, 0xb8, 0x4c, 0x9d, 0xea, 0x2a, 0xd5, 0x6a, 0xfb, 0x0f, 0x7c, 0x5d,
-	0xe5, 0x9d, 0xfb, 0x07, 0x77, 0xa7, 0xb7, 0xe0, 0x59, 0x50, 0x4e, 0x02, 0x7a, 0x1b, 0x71, 0x15,
-	0x2e, 0x9f, 0x88, 0x13, 0xdb, 0x71, 0x0a, 0x4c, 0xad, 0x34, 0xd4, 0x07, 0x72, 0x12, 0x6d, 0xc0,
-	0x8d, 0x53, 0x61, 0xde, 0x98, 0x9c, 0x42, 0x9b, 0x70, 0xf3, 0x64, 0x3c, 0xdf, 0x20, 0x57, 0xc0,
-	0xdd, 0xa2, 0x8f, 0x25, 0x58, 0x8e, 0x4c, 0xf9, 0xd1, 0x15, 0x58, 0xab, 0xa9, 0xd5, 0x72, 0xa5,
-	0x5e, 0xd7, 0x6a, 0x6a, 0xb5, 0x56, 0xad, 0x97, 0xf6, 0xb5, 0x7a, 0xa3, 0xd4, 0xb8, 0x5f, 0x0f,
-	0xec, 0x8d, 0x02, 0xab, 0x71, 0x20, 0x6f, 0x5f, 0x4e, 0xc0, 0x08, 0x0b, 0x70, 0xed, 0xf4, 0xe7,
-	0x12, 0x5c, 0x8c, 0x4d, 0xf1, 0xd1, 0x75, 0x78, 0xe6, 0xb0, 0xa2, 0xee, 0x6e, 0x3f, 0xd0, 0x0e,
-	0xab, 0x8d, 0x8a, 0x56, 0x79, 0xb7, 0x51, 0x39, 0xa8, 0xef, 0x56, 0x0f, 0xa6, 0x67, 0x75, 0x0d,
-	0xae, 0x9c, 0x88, 0xf4, 0xa6, 0x76, 0x1a, 0x70, 0x62, 0x7e, 0x3f, 0x90, 0x60, 0x7e, 0xc2, 0x17,
-	0xa2, 0x4b, 0x50, 0xbc, 0xb7, 0x5b, 0xdf, 0xaa, 0xec, 0x94, 0x0e, 0x77, 0xab, 0xea, 0xe4, 0x9d,
-	0xbd, 0x02, 0x6b, 0x53, 0xa3, 0x77, 0xee, 0xd7, 0xf6, 0x77, 0xcb, 0xa5, 0x46, 0x85, 0x7d, 0x54,
-	0x96, 0xe8, 0xc2, 0xa6, 0x40, 0xfb, 0xbb, 0x6f, 0xef, 0x34, 0xb4, 0xf2, 0xfe, 0x6e, 0xe5, 0xa0,
-	0xa1, 0x95, 0x1a, 0x8d, 0x92, 0x7f, 0x9d, 0xb7, 0xee, 0x7e, 0xfa, 0xc5, 0xaa, 0xf4, 0xd9, 0x17,
-	0xab, 0xd2, 0x5f, 0xbf, 0x58, 0x95, 0x3e, 0xf9, 0x72, 0xf5, 0xdc, 0x67, 0x5f, 0xae, 0x9e, 0xfb,
-	0xf3, 0x97, 0xab, 0xe7, 0x1e, 0xde, 0xea, 0x1a, 0xa4, 0x37, 0x6a, 0x52, 0x2f, 0xbc, 0xe9, 0xff,
-	0x0d, 0xd4, 0xfb, 0xff, 0xa8, 0x65, 0x6c, 0x4e, 0xfe, 0x99, 0xb4, 0x39, 0xc3, 0xdc, 0xea, 0x8b,
-	0xff, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x5c, 0x57, 0x37, 0x67, 0x2a, 0x00, 0x00,
+	// 3250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xf7, 0x92, 0x14, 0x45, 0x3e, 0x92, 0xd2, 0x6a, 0x24, 0xd9, 0xb4, 0xe2, 0x48, 0xf2, 0x3a,
+	0x8e, 0x1d, 0x3b, 0x91, 0x3e, 0x3b, 0xdf, 0x97, 0x3f, 0x5f, 0x9a, 0x04, 0x14, 0x4d, 0x45, 0x92,
+	0x65, 0x89, 0x59, 0x52, 0x6a, 0x6c, 0xb4, 0xdd, 0x2c, 0xc9, 0xa1, 0xb8, 0x31, 0xc9, 0xdd, 0xec,
+	0x0e, 0x15, 0xaa, 0x3d, 0xb5, 0x68, 0x8a, 0x22, 0xa7, 0x5c, 0x0a, 0x14, 0x45, 0x0b, 0x14, 0x28,
+	0x7a, 0xed, 0xa1, 0xf7, 0x5e, 0x8b, 0x9c, 0xda, 0x9c, 0x8a, 0x9e, 0xd2, 0x22, 0xb9, 0xb5, 0xe7,
+	0x00, 0x3d, 0x16, 0xf3, 0x67, 0xff, 0x71, 0x77, 0x25, 0xdb, 0x49, 0x0f, 0x45, 0x7b, 0xe3, 0xcc,
+	0xfb, 0xbd, 0x37, 0xb3, 0x33, 0x6f, 0xde, 0x7b, 0xf3, 0x1b, 0xc2, 0xa5, 0xb6, 0x39, 0xc0, 0xa4,
+	0xd5, 0x25, 0xeb, 0x7a, 0xab, 0x6d, 0xac, 0x1f, 0xdf, 0x5a, 0x27, 0x27, 0x16, 0x76, 0xd6, 0x2c,
+	0xdb, 0x24, 0x26, 0x92, 0x5d, 0xe9, 0x1a, 0x95, 0xae, 0x1d, 0xdf, 0x5a, 0x5a, 0xf6, 0xf0, 0x6d,
+	0xfb, 0xc4, 0x22, 0x26, 0xd5, 0xb0, 0x6c, 0xd3, 0xec, 0x72, 0x8d, 0x80, 0x9c, 0xd9, 0x61, 0x62,
+	0xdd, 0xd6, 0x07, 0xc2, 0xe2, 0xd2, 0xe5, 0xa8, 0xfc, 0x58, 0xef, 0x1b, 0x1d, 0x9d, 0x98, 0xb6,
+	0x80, 0x2c, 0x1c, 0x99, 0x47, 0x26, 0xfb, 0xb9, 0x4e, 0x7f, 0x89, 0xde, 0x95, 0x23, 0xd3, 0x3c,
+	0xea, 0xe3, 0x75, 0xd6, 0x6a, 0x8d, 0xba, 0xeb, 0xc4, 0x18, 0x60, 0x87, 0xe8, 0x03, 0xcb, 0x1d,
+	0x79, 0x12, 0xd0, 0x19, 0xd9, 0x3a, 0x31, 0xcc, 0x21, 0x97, 0x2b, 0x7f, 0xcc, 0xc3, 0xb4, 0x8a,
+	0xdf, 0x1f, 0x61, 0x87, 0xa0, 0x17, 0x21, 0x83, 0xdb, 0x3d, 0xb3, 0x2c, 0xad, 0x4a, 0xd7, 0x0b,
+	0xb7, 0x9f, 0x5e, 0x9b, 0xfc, 0xcc, 0xb5, 0x5a, 0xbb, 0x67, 0x0a, 0xf0, 0xd6, 0x39, 0x95, 0x81,
+	0xd1, 0x4b, 0x30, 0xd5, 0xed, 0x8f, 0x9c, 0x5e, 0x39, 0xc5, 0xb4, 0x96, 0xa3, 0x5a, 0x9b, 0x54,
+	0xec, 0xab, 0x71, 0x38, 0x1d, 0xcc, 0x18, 0x76, 0xcd, 0x72, 0x3a, 0x69, 0xb0, 0xed, 0x61, 0x37,
+	0x38, 0x18, 0x05, 0xa3, 0x2a, 0x80, 0x31, 0x34, 0x88, 0xd6, 0xee, 0xe9, 0xc6, 0xb0, 0x3c, 0xc5,
+	0x54, 0x95, 0x38, 0x55, 0x83, 0x54, 0x29, 0xc4, 0xd7, 0xcf, 0x1b, 0x6e, 0x1f, 0x9d, 0xf1, 0xfb,
+	0x23, 0x6c, 0x9f, 0x94, 0xb3, 0x49, 0x33, 0x7e, 0x9b, 0x8a, 0x03, 0x33, 0x66, 0x70, 0xf4, 0x3a,
+	0xe4, 0xda, 0x3d, 0xdc, 0x7e, 0xa8, 0x91, 0x71, 0x39, 0xc7, 0x54, 0x57, 0xa3, 0xaa, 0x55, 0x8a,
+	0x68, 0x8e, 0x7d, 0xe5, 0xe9, 0x36, 0xef, 0x41, 0xaf, 0x42, 0xb6, 0x6d, 0x0e, 0x06, 0x06, 0x29,
+	0x17, 0x98, 0xf2, 0x4a, 0x8c, 0x32, 0x93, 0xfb, 0xba, 0x42, 0x01, 0xed, 0xc3, 0x4c, 0xdf, 0x70,
+	0x88, 0xe6, 0x0c, 0x75, 0xcb, 0xe9, 0x99, 0xc4, 0x29, 0x17, 0x99, 0x89, 0x67, 0xa3, 0x26, 0x76,
+	0x0d, 0x87, 0x34, 0x5c, 0x98, 0x6f, 0xa9, 0xd4, 0x0f, 0xf6, 0x53, 0x83, 0x66, 0xb7, 0x8b, 0x6d,
+	0xcf, 0x62, 0xb9, 0x94, 0x64, 0x70, 0x9f, 0xe2, 0x5c, 0xcd, 0x80, 0x41, 0x33, 0xd8, 0x8f, 0xbe,
+	0x05, 0xf3, 0x7d, 0x53, 0xef, 0x78, 0xf6, 0xb4, 0x76, 0x6f, 0x34, 0x7c, 0x58, 0x9e, 0x61, 0x56,
+	0x6f, 0xc4, 0x4c, 0xd3, 0xd4, 0x3b, 0xae, 0x72, 0x95, 0x42, 0x7d, 0xcb, 0x73, 0xfd, 0x49, 0x19,
+	0xd2, 0x60, 0x41, 0xb7, 0xac, 0xfe, 0xc9, 0xa4, 0xf9, 0x59, 0x66, 0xfe, 0x66, 0xd4, 0x7c, 0x85,
+	0xa2, 0x13, 0xec, 0x23, 0x3d, 0x22, 0x44, 0x07, 0x20, 0x5b, 0x36, 0xb6, 0x74, 0x1b, 0x6b, 0x96,
+	0x6d, 0x5a, 0xa6, 0xa3, 0xf7, 0xcb, 0x32, 0x33, 0x7e, 0x3d, 0x6a, 0xbc, 0xce, 0x91, 0x75, 0x01,
+	0xf4, 0x2d, 0xcf, 0x5a, 0x61, 0x09, 0x37, 0x6b, 0xb6, 0xb1, 0xe3, 0xf8, 0x66, 0xe7, 0x92, 0xcd,
+	0x32, 0x64, 0xac, 0xd9, 0x90, 0x04, 0x6d, 0x42, 0x01, 0x8f, 0x09, 0x1e, 0x76, 0xb4, 0x63, 0x93,
+	0xe0, 0x32, 0x62, 0x16, 0xaf, 0xc4, 0x1c, 0x57, 0x06, 0x3a, 0x34, 0x09, 0xf6, 0x8d, 0x01, 0xf6,
+	0x3a, 0x51, 0x0b, 0x16, 0x8f, 0xb1, 0x6d, 0x74, 0x4f, 0x98, 0x1d, 0x8d, 0x49, 0x1c, 0xc3, 0x1c,
+	0x96, 0xe7, 0x99, 0xc5, 0xe7, 0xa3, 0x16, 0x0f, 0x19, 0x9c, 0x2a, 0xd7, 0x5c, 0xb0, 0x6f, 0x7a,
+	0xfe, 0x38, 0x2a, 0xa5, 0x9e, 0xd6, 0x35, 0x86, 0x7a, 0xdf, 0xf8, 0x2e, 0xd6, 0x5a, 0x7d, 0xb3,
+	0xfd, 0xb0, 0xbc, 0x90, 0xe4, 0x69, 0x9b, 0x02, 0xb7, 0x41, 0x61, 0x01, 0x4f, 0xeb, 0x06, 0xfb,
+	0x37, 0xa6, 0x61, 0xea, 0x58, 0xef, 0x8f, 0xf0, 0x4e, 0x26, 0x97, 0x91, 0xa7, 0x76, 0x32, 0xb9,
+	0x69, 0x39, 0xb7, 0x93, 0xc9, 0xe5, 0x65, 0xd8, 0xc9, 0xe4, 0x40, 0x2e, 0x28, 0xd7, 0xa0, 0x10,
+	0x88, 0x53, 0xa8, 0x0c, 0xd3, 0x03, 0xec, 0x38, 0xfa, 0x11, 0x66, 0x71, 0x2d, 0xaf, 0xba, 0x4d,
+	0x65, 0x06, 0x8a, 0xc1, 0xd0, 0xa4, 0x7c, 0x2c, 0x41, 0x21, 0x10, 0x74, 0xa8, 0xe6, 0x31, 0xb6,
+	0xd9, 0x82, 0x08, 0x4d, 0xd1, 0x44, 0x57, 0xa0, 0xc4, 0xbe, 0x45, 0x73, 0xe5, 0x34, 0xf6, 0x65,
+	0xd4, 0x22, 0xeb, 0x3c, 0x14, 0xa0, 0x15, 0x28, 0x58, 0xb7, 0x2d, 0x0f, 0x92, 0x66, 0x10, 0xb0,
+	0x6e, 0x5b, 0x2e, 0xe0, 0x32, 0x14, 0xe9, 0xa7, 0x7b, 0x88, 0x0c, 0x1b, 0xa4, 0x40, 0xfb, 0x04,
+	0x44, 0xf9, 0x43, 0x0a, 0xe4, 0xc9, 0x60, 0x86, 0x5e, 0x81, 0x0c, 0x8d, 0xf2, 0x22, 0x4c, 0x2f,
+	0xad, 0xf1, 0x08, 0xbf, 0xe6, 0x46, 0xf8, 0xb5, 0xa6, 0x9b, 0x02, 0x36, 0x72, 0x9f, 0x7c, 0xb6,
+	0x72, 0xee, 0xe3, 0xbf, 0xac, 0x48, 0x2a, 0xd3, 0x40, 0x17, 0x69, 0x04, 0xd3, 0x8d, 0xa1, 0x66,
+	0x74, 0xd8, 0x94, 0xf3, 0x34, 0x3a, 0xe9, 0xc6, 0x70, 0xbb, 0x83, 0xee, 0x81, 0xdc, 0x36, 0x87,
+	0x0e, 0x1e, 0x3a, 0x23, 0x47, 0xe3, 0xb9, 0xa9, 0x9c, 0x9e, 0x8c, 0xaf, 0x3c, 0x09, 0xb2, 0x40,
+	0x25, 0xa0, 0x75, 0x86, 0x54, 0x67, 0xdb, 0xe1, 0x0e, 0xf4, 0x16, 0x80, 0x97, 0xc0, 0x9c, 0x72,
+	0x66, 0x35, 0x7d, 0xbd, 0x70, 0xfb, 0x72, 0x8c, 0x3f, 0xb9, 0x98, 0x03, 0xab, 0xa3, 0x13, 0xbc,
+	0x91, 0xa1, 0x13, 0x56, 0x03, 0xaa, 0xe8, 0x59, 0x98, 0xd5, 0x2d, 0x4b, 0x73, 0x88, 0x4e, 0xb0,
+	0xd6, 0x3a, 0x21, 0xd8, 0x61, 0x61, 0xbf, 0xa8, 0x96, 0x74, 0xcb, 0x6a, 0xd0, 0xde, 0x0d, 0xda,
+	0x89, 0xae, 0xc2, 0x0c, 0x8d, 0xf0, 0x86, 0xde, 0xd7, 0x7a, 0xd8, 0x38, 0xea, 0x11, 0x16, 0xdd,
+	0xd3, 0x6a, 0x49, 0xf4, 0x6e, 0xb1, 0x4e, 0xa5, 0x03, 0xc5, 0x60, 0x70, 0x47, 0x08, 0x32, 0x1d,
+	0x9d, 0xe8, 0x6c, 0x2d, 0x8b, 0x2a, 0xfb, 0x4d, 0xfb, 0x2c, 0x9d, 0xf4, 0xc4, 0x0a, 0xb1, 0xdf,
+	0xe8, 0x3c, 0x64, 0x85, 0xd9, 0x34, 0x33, 0x2b, 0x5a, 0x68, 0x01, 0xa6, 0x2c, 0xdb, 0x3c, 0xc6,
+	0x6c, 0xf3, 0x72, 0x2a, 0x6f, 0x28, 0xf7, 0x61, 0x26, 0x9c, 0x07, 0xd0, 0x0c, 0xa4, 0xc8, 0x58,
+	0x8c, 0x92, 0x22, 0x63, 0x74, 0x0b, 0x32, 0x74, 0x31, 0x99, 0xb5, 0x99, 0xb8, 0xec, 0x27, 0xf4,
+	0x9b, 0x27, 0x16, 0x56, 0x19, 0x74, 0x27, 0x93, 0x4b, 0xc9, 0x69, 0x65, 0x16, 0x4a, 0xa1, 0x2c,
+	0xa1, 0x9c, 0x87, 0x85, 0xb8, 0x98, 0xaf, 0x18, 0xb0, 0x10, 0x17, 0xba, 0xd1, 0x4b, 0x90, 0xf3,
+	0x82, 0xbe, 0xeb, 0x41, 0x91, 0xd1, 0x3d, 0x25, 0x0f, 0x4b, 0x7d, 0x87, 0x6e, 0x44, 0x4f, 0x17,
+	0xa9, 0xbe, 0xa8, 0x4e, 0xeb, 0x96, 0xb5, 0xa5, 0x3b, 0x3d, 0xe5, 0x5d, 0x28, 0x27, 0xc5, 0xf3,
+	0xc0, 0xc2, 0x49, 0xec, 0x00, 0xb8, 0x0b, 0x77, 0x1e, 0xb2, 0x5d, 0xd3, 0x1e, 0xe8, 0x84, 0x19,
+	0x2b, 0xa9, 0xa2, 0x45, 0x17, 0x94, 0xc7, 0xf6, 0x34, 0xeb, 0xe6, 0x0d, 0x45, 0x83, 0x8b, 0x89,
+	0x21, 0x9d, 0xaa, 0x18, 0xc3, 0x0e, 0xe6, 0xcb, 0x5b, 0x52, 0x79, 0xc3, 0x37, 0xc4, 0x27, 0xcb,
+	0x1b, 0x74, 0x58, 0x07, 0x0f, 0x3b, 0xd8, 0x66, 0xf6, 0xf3, 0xaa, 0x68, 0x29, 0x3f, 0x4b, 0xc3,
+	0xf9, 0xf8, 0xb8, 0x8e, 0x56, 0xa1, 0x38, 0xd0, 0xc7, 0x1a, 0x19, 0x0b, 0xf7, 0x93, 0x98, 0x03,
+	0xc0, 0x40, 0x1f, 0x37, 0xc7, 0xdc, 0xf7, 0x64, 0x48, 0x93, 0xb1, 0x53, 0x4e, 0xad, 0xa6, 0xaf,
+	0x17, 0x55, 0xfa, 0x13, 0x1d, 0xc2, 0x5c, 0xdf, 0x6c, 0xeb, 0x7d, 0xad, 0xaf, 0x3b, 0x44, 0x13,
+	0x69, 0x9f, 0x1f, 0xa7, 0x67, 0x92, 0xe2, 0x34, 0xee, 0xf0, 0x8d, 0xa5, 0x21, 0x48, 0x1c, 0x84,
+	0x59, 0x66, 0x64, 0x57, 0x77, 0x08, 0x17, 0xa1, 0x1a, 0x14, 0x06, 0x86, 0xd3, 0xc2, 0x3d, 0xfd,
+	0xd8, 0x30, 0x6d, 0x71, 0xae, 0x62, 0xbc, 0xe7, 0x9e, 0x0f, 0x12, 0xa6, 0x82, 0x7a, 0x81, 0x4d,
+	0x99, 0x0a, 0x79, 0xb3, 0x1b, 0x59, 0xb2, 0x8f, 0x1d, 0x59, 0xfe, 0x07, 0x16, 0x86, 0x78, 0x4c,
+	0x34, 0xff, 0xe4, 0x72, 0x4f, 0x99, 0x66, 0x8b, 0x8f, 0xa8, 0xcc, 0x3b, 0xeb, 0x0e, 0x75, 0x1a,
+	0xf4, 0x1c, 0xcb, 0x8d, 0x96, 0xe9, 0x60, 0x5b, 0xd3, 0x3b, 0x1d, 0x1b, 0x3b, 0x0e, 0xab, 0xaa,
+	0x8a, 0xea, 0xac, 0xdb, 0x5f, 0xe1, 0xdd, 0xca, 0x47, 0x6c, 0x73, 0xe2, 0xb2, 0xa3, 0xbb, 0xf4,
+	0x92, 0xbf, 0xf4, 0x4d, 0x58, 0x10, 0xfa, 0x9d, 0xd0, 0xea, 0xf3, 0xf2, 0xf4, 0x52, 0x52, 0xd1,
+	0x15, 0x58, 0x75, 0xe4, 0xea, 0x27, 0x2f, 0x7c, 0xfa, 0x09, 0x17, 0x1e, 0x41, 0x86, 0x2d, 0x4b,
+	0x86, 0x87, 0x1b, 0xfa, 0xfb, 0xdf, 0x6d, 0x33, 0x3e, 0x4c, 0xc3, 0x5c, 0xa4, 0xb0, 0xf0, 0x3e,
+	0x4c, 0x8a, 0xfd, 0xb0, 0x54, 0xec, 0x87, 0xa5, 0x1f, 0xfb, 0xc3, 0xc4, 0x6e, 0x67, 0xce, 0xde,
+	0xed, 0xa9, 0xaf, 0x73, 0xb7, 0xb3, 0x4f, 0xb8, 0xdb, 0xff, 0xd2, 0x7d, 0xf8, 0xb9, 0x04, 0x4b,
+	0xc9, 0xe5, 0x58, 0xec, 0x86, 0xdc, 0x84, 0x39, 0x6f, 0x2a, 0x9e, 0x79, 0x1e, 0x1e, 0x65, 0x4f,
+	0x20, 0xec, 0x27, 0x66, 0xbc, 0xab, 0x30, 0x33, 0x51, 0x2d, 0x72, 0x67, 0x2e, 0x1d, 0x07, 0xa7,
+	0xa1, 0xfc, 0x36, 0x0d, 0x0b, 0x71, 0x05, 0x5d, 0xcc, 0x89, 0x55, 0x61, 0xbe, 0x83, 0xdb, 0x46,
+	0xe7, 0x89, 0x0f, 0xec, 0x9c, 0x50, 0xff, 0xef, 0x79, 0x8d, 0xfa, 0x09, 0xba, 0x01, 0x73, 0xce,
+	0xc9, 0xb0, 0x6d, 0x0c, 0x8f, 0x34, 0x62, 0xba, 0xb5, 0x51, 0x9e, 0xcd, 0x7c, 0x56, 0x08, 0x9a,
+	0xa6, 0xa8, 0x8e, 0x7e, 0x0d, 0x90, 0x53, 0xb1, 0x63, 0x99, 0x43, 0x07, 0xa3, 0x2a, 0xe4, 0xf1,
+	0xb8, 0x8d, 0x2d, 0xe2, 0x16, 0xc0, 0x09, 0x77, 0x0c, 0x01, 0x71, 0xf5, 0xe8, 0x5d, 0xdb, 0xd3,
+	0x43, 0xff, 0x2b, 0x28, 0x85, 0x44, 0x72, 0x80, 0x97, 0xea, 0x9e, 0x2a, 0x43, 0xa3, 0x97, 0x5d,
+	0x4e, 0x21, 0x9d, 0x74, 0x53, 0x16, 0x85, 0xbb, 0xa7, 0xc7, 0xf1, 0x74, 0x38, 0x46, 0x2a, 0x64,
+	0x92, 0x86, 0xe3, 0xf5, 0xbd, 0x3f, 0x1c, 0x45, 0xa3, 0x3b, 0x21, 0x56, 0x21, 0x9b, 0xf4, 0xa9,
+	0x81, 0x42, 0xdc, 0xff, 0x54, 0x9f, 0x56, 0x78, 0xd9, 0xa5, 0x15, 0xa6, 0x93, 0x26, 0x2d, 0x2a,
+	0x4f, 0x7f, 0xd2, 0x0c, 0x8f, 0xde, 0x08, 0xf0, 0x0a, 0xf9, 0x55, 0x29, 0xbe, 0x52, 0xf6, 0xea,
+	0x49, 0x4f, 0xdb, 0x23, 0x16, 0xfe, 0xdf, 0x23, 0x16, 0x8a, 0x89, 0xac, 0x84, 0x28, 0x19, 0x3d,
+	0x65, 0xa1, 0x81, 0xea, 0x11, 0x66, 0x81, 0x13, 0x01, 0xd7, 0xce, 0x64, 0x16, 0x3c, 0x53, 0x13,
+	0xd4, 0x42, 0x3d, 0x42, 0x2d, 0xcc, 0x24, 0x59, 0x9c, 0xa8, 0x4f, 0x7d, 0x8b, 0x61, 0x6e, 0xe1,
+	0xdb, 0xf1, 0xdc, 0x42, 0xe2, 0xe5, 0x3f, 0xa6, 0x16, 0xf5, 0x4c, 0xc7, 0x90, 0x0b, 0xef, 0x26,
+	0x90, 0x0b, 0x72, 0xd2, 0x25, 0x38, 0xae, 0x12, 0xf5, 0x06, 0x88, 0x63, 0x17, 0x0e, 0x63, 0xd8,
+	0x05, 0x4e, 0x03, 0x3c, 0xf7, 0x08, 0xec, 0x82, 0x67, 0x3a, 0x42, 0x2f, 0x1c, 0xc6, 0xd0, 0x0b,
+	0x28, 0xd9, 0xee, 0x44, 0x01, 0x15, 0xb4, 0x1b, 0x12, 0xa1, 0xb7, 0xc2, 0xfc, 0xc2, 0xfc, 0xe9,
+	0x75, 0x2b, 0x2f, 0x03, 0x3c, 0x6b, 0x41, 0x82, 0xa1, 0x9d, 0x44, 0x30, 0x70, 0x0e, 0xe0, 0x85,
+	0x47, 0x24, 0x18, 0x3c, 0xdb, 0xb1, 0x0c, 0x43, 0x3d, 0xc2, 0x30, 0x2c, 0x26, 0x39, 0xdc, 0x44,
+	0x42, 0xf2, 0x1d, 0x2e, 0x91, 0x62, 0x98, 0x92, 0xb3, 0x3b, 0x99, 0x5c, 0x4e, 0xce, 0x73, 0x72,
+	0x61, 0x27, 0x93, 0x2b, 0xc8, 0x45, 0xe5, 0x39, 0x5a, 0x02, 0x4d, 0xc4, 0x3d, 0x7a, 0xe1, 0xc0,
+	0xb6, 0x6d, 0xda, 0x82, 0x2c, 0xe0, 0x0d, 0xe5, 0x3a, 0x14, 0x83, 0x21, 0xee, 0x14, 0x3a, 0x62,
+	0x16, 0x4a, 0xa1, 0xa8, 0xa6, 0xfc, 0x5d, 0x82, 0x62, 0x30, 0x5e, 0x85, 0x2e, 0xab, 0x79, 0x71,
+	0x59, 0x0d, 0x90, 0x14, 0xa9, 0x30, 0x49, 0xb1, 0x02, 0x05, 0x7a, 0x61, 0x9b, 0xe0, 0x1f, 0x74,
+	0xcb, 0xe3, 0x1f, 0x6e, 0xc0, 0x1c, 0xcb, 0xb7, 0x9c, 0xca, 0x10, 0x99, 0x21, 0xc3, 0x33, 0x03,
+	0x15, 0xb0, 0xc5, 0xe0, 0x99, 0x01, 0xbd, 0x00, 0xf3, 0x01, 0xac, 0x77, 0x11, 0xe4, 0x57, 0x71,
+	0xd9, 0x43, 0x57, 0xf8, 0x8d, 0x10, 0xdd, 0x82, 0x05, 0xd3, 0x22, 0xc6, 0xc0, 0x70, 0x88, 0xd1,
+	0xd6, 0xf0, 0x18, 0xb7, 0x47, 0x2c, 0x8d, 0x64, 0xd9, 0x2d, 0x79, 0xde, 0x97, 0xd5, 0x5c, 0x91,
+	0xf2, 0x7b, 0x09, 0xe6, 0x22, 0x11, 0x36, 0x96, 0x96, 0x90, 0xbe, 0x2e, 0x5a, 0x22, 0xf5, 0xe4,
+	0xb4, 0x44, 0xf0, 0x36, 0x9c, 0x0e, 0xdf, 0x86, 0xff, 0x21, 0x41, 0x29, 0x14, 0xe9, 0xe9, 0xbe,
+	0xb5, 0xcd, 0x0e, 0x16, 0xf7, 0x53, 0xf6, 0x9b, 0x96, 0x41, 0x7d, 0xf3, 0x48, 0xdc, 0x42, 0xe9,
+	0x4f, 0x8a, 0xf2, 0x72, 0x57, 0x5e, 0x64, 0x26, 0xef, 0x6a, 0xcb, 0x4b, 0x0d, 0xde, 0xa0, 0xba,
+	0x0f, 0x31, 0xa7, 0xaf, 0x8b, 0x2a, 0xfd, 0x89, 0x16, 0x84, 0xc7, 0x8a, 0x92, 0x81, 0x37, 0xd0,
+	0xab, 0x90, 0x67, 0x8f, 0x10, 0x9a, 0x69, 0x39, 0xe5, 0xdc, 0x64, 0x39, 0xc5, 0x5f, 0x2a, 0x44,
+	0x68, 0x30, 0xbb, 0xfb, 0x96, 0xa3, 0xe6, 0x2c, 0xf1, 0x2b, 0x50, 0xe4, 0xe4, 0x43, 0x45, 0xce,
+	0x25, 0xc8, 0xd3, 0xe9, 0x3b, 0x96, 0xde, 0xc6, 0x65, 0x60, 0x33, 0xf5, 0x3b, 0x94, 0x3f, 0xa5,
+	0x60, 0x76, 0x22, 0x51, 0xc5, 0x7e, 0xbc, 0xeb, 0xc8, 0xa9, 0x00, 0xeb, 0xf2, 0x68, 0x0b, 0xb2,
+	0x0c, 0x70, 0xa4, 0x3b, 0xda, 0x07, 0xfa, 0x90, 0xe0, 0x8e, 0x58, 0x95, 0x40, 0x0f, 0x5a, 0x82,
+	0x1c, 0x6d, 0x8d, 0x1c, 0xdc, 0x11, 0x04, 0x90, 0xd7, 0x46, 0xdb, 0x90, 0xc5, 0xc7, 0x78, 0x48,
+	0x9c, 0xf2, 0x34, 0xdb, 0xf8, 0x0b, 0x31, 0x11, 0x8d, 0xca, 0x37, 0xca, 0x74, 0xbb, 0xff, 0xf6,
+	0xd9, 0x8a, 0xcc, 0xe1, 0xcf, 0x9b, 0x03, 0x83, 0xe0, 0x81, 0x45, 0x4e, 0x54, 0x61, 0x20, 0xbc,
+	0x0c, 0xb9, 0x89, 0x65, 0xa0, 0x93, 0xb0, 0x6c, 0xc3, 0xb4, 0x0d, 0x72, 0xc2, 0x52, 0x72, 0x5a,
+	0xf5, 0xda, 0xe8, 0x02, 0x4c, 0xf7, 0xf5, 0x21, 0xa6, 0x0c, 0x5c, 0x89, 0xe9, 0x65, 0x69, 0x73,
+	0xbb, 0xc3, 0x28, 0xcc, 0xa2, 0xcb, 0x47, 0xa8, 0xa5, 0x01, 0x1e, 0x58, 0xa6, 0xd9, 0xd7, 0x78,
+	0x14, 0xa9, 0xc0, 0x4c, 0x38, 0x85, 0x53, 0x0a, 0xd2, 0xc6, 0x84, 0x72, 0x79, 0xa1, 0x2a, 0xbd,
+	0xc8, 0x3b, 0xf9, 0xa9, 0xdd, 0xc9, 0xe4, 0x24, 0x39, 0x25, 0x88, 0xa3, 0xb7, 0x61, 0x31, 0x36,
+	0x83, 0xa3, 0x57, 0x20, 0xef, 0x67, 0x7f, 0x69, 0x35, 0x7d, 0x06, 0x23, 0xe4, 0x83, 0x95, 0x43,
+	0x58, 0x8c, 0x4d, 0xe1, 0xe8, 0x75, 0xc8, 0xda, 0xd8, 0x19, 0xf5, 0x39, 0xe9, 0x33, 0x73, 0xfb,
+	0xea, 0xd9, 0xb9, 0x7f, 0xd4, 0x27, 0xaa, 0x50, 0x52, 0x6e, 0xc1, 0xc5, 0xc4, 0x1c, 0xee, 0xf3,
+	0x3a, 0x52, 0x80, 0xd7, 0x51, 0x7e, 0x23, 0xc1, 0x52, 0x72, 0x5e, 0x46, 0x1b, 0x13, 0x13, 0xba,
+	0xf1, 0x88, 0x59, 0x3d, 0x30, 0x2b, 0x7a, 0xf1, 0xb1, 0x71, 0x17, 0x93, 0x76, 0x8f, 0x17, 0x08,
+	0x3c, 0x7e, 0x94, 0xd4, 0x92, 0xe8, 0x65, 0x3a, 0x0e, 0x87, 0xbd, 0x87, 0xdb, 0x44, 0xe3, 0x5b,
+	0xe9, 0xb0, 0xcb, 0x47, 0x5e, 0x2d, 0xf1, 0xde, 0x06, 0xef, 0x54, 0x6e, 0xc2, 0x85, 0x84, 0x4c,
+	0x1f, 0xbd, 0x21, 0x29, 0x0f, 0x28, 0x38, 0x36, 0x7d, 0xa3, 0x37, 0x21, 0xeb, 0x10, 0x9d, 0x8c,
+	0x1c, 0xf1, 0x65, 0xd7, 0xce, 0xcc, 0xfc, 0x0d, 0x06, 0x57, 0x85, 0x9a, 0xf2, 0x1a, 0xa0, 0x68,
+	0x1e, 0x8f, 0xb9, 0xe5, 0x49, 0x71, 0xb7, 0xbc, 0x16, 0x3c, 0x75, 0x4a, 0xc6, 0x46, 0xd5, 0x89,
+	0xc9, 0xdd, 0x7c, 0xa4, 0x84, 0x3f, 0x31, 0xc1, 0xdf, 0xa5, 0x61, 0x31, 0x36, 0x71, 0x07, 0x0e,
+	0xb4, 0xf4, 0x55, 0x0f, 0xf4, 0xeb, 0x00, 0x64, 0xac, 0xf1, 0x9d, 0x76, 0x13, 0x43, 0xdc, 0x6d,
+	0x65, 0x8c, 0xdb, 0xcd, 0xb1, 0x70, 0x8c, 0x3c, 0x11, 0xbf, 0x28, 0x0d, 0x11, 0xb8, 0x59, 0x8f,
+	0x58, 0xd2, 0x70, 0xca, 0xe9, 0xc7, 0x4b, 0x2f, 0xf2, 0x71, 0xb8, 0xdb, 0x41, 0x0f, 0xe0, 0xc2,
+	0x44, 0xf2, 0xf3, 0x6c, 0x67, 0x1e, 0x39, 0x07, 0x2e, 0x86, 0x73, 0xa0, 0x6b, 0x3b, 0x98, 0xc0,
+	0xa6, 0x42, 0x09, 0x8c, 0xe6, 0x5c, 0x76, 0x1d, 0xe5, 0xb9, 0xbe, 0x83, 0xfb, 0xba, 0xfb, 0x54,
+	0x7a, 0x31, 0x72, 0xa9, 0xbd, 0x23, 0x5e, 0x93, 0xf9, 0x9d, 0xf6, 0xa7, 0xf4, 0x4e, 0x3b, 0x43,
+	0x95, 0xd9, 0x46, 0xdd, 0xa1, 0xaa, 0xca, 0x03, 0x00, 0xff, 0xc6, 0x4e, 0x8f, 0xaf, 0x6d, 0x8e,
+	0x86, 0x1d, 0xe6, 0x11, 0x53, 0x2a, 0x6f, 0xd0, 0x27, 0x59, 0xea, 0x58, 0xee, 0xca, 0xc7, 0xc4,
+	0x1f, 0xea, 0x21, 0x81, 0x2b, 0x3f, 0x87, 0x2b, 0xef, 0x01, 0x8a, 0x92, 0xa7, 0x09, 0x63, 0xbc,
+	0x11, 0x1e, 0x43, 0x49, 0xe6, 0x61, 0xe3, 0xc7, 0xfa, 0x1e, 0x4c, 0x31, 0x6f, 0xa2, 0x79, 0x89,
+	0x71, 0xf7, 0xa2, 0x0c, 0xa3, 0xbf, 0xd1, 0x77, 0x00, 0x74, 0x42, 0x6c, 0xa3, 0x35, 0xf2, 0x47,
+	0x58, 0x4d, 0x70, 0xc7, 0x8a, 0x0b, 0xdc, 0xb8, 0x24, 0xfc, 0x72, 0xc1, 0xd7, 0x0d, 0xf8, 0x66,
+	0xc0, 0xa2, 0xb2, 0x07, 0x33, 0x61, 0x5d, 0xb7, 0x08, 0xe0, 0x93, 0x08, 0x17, 0x01, 0xbc, 0x10,
+	0xe4, 0x0d, 0xbf, 0x84, 0x48, 0xf3, 0x17, 0x0a, 0xd6, 0x50, 0xbe, 0x9f, 0x82, 0x62, 0xd0, 0x99,
+	0xff, 0x03, 0xd3, 0xb4, 0xf2, 0x23, 0x09, 0x72, 0xde, 0xf7, 0x87, 0xdf, 0x29, 0x42, 0x0f, 0x3c,
+	0x7c, 0xf9, 0x52, 0xc1, 0xc7, 0x05, 0xfe, 0x9c, 0x93, 0xf6, 0x9e, 0x73, 0xbe, 0xe1, 0xe5, 0x97,
+	0x44, 0xe6, 0x21, 0xb8, 0xda, 0xc2, 0xb1, 0xdc, 0x7c, 0xf7, 0x1a, 0xe4, 0xbd, 0x90, 0x40, 0x0b,
+	0x7a, 0x97, 0xd1, 0x91, 0xc4, 0xb9, 0xe4, 0x4d, 0x3a, 0x15, 0xcb, 0xfc, 0x40, 0x3c, 0x5d, 0xa4,
+	0x55, 0xde, 0x50, 0x1c, 0x98, 0x9d, 0x88, 0x27, 0x3e, 0x30, 0x15, 0x00, 0x22, 0x05, 0x4a, 0xd6,
+	0xa8, 0xa5, 0x3d, 0xc4, 0x27, 0xe2, 0x21, 0x83, 0x4f, 0xbf, 0x60, 0x8d, 0x5a, 0x77, 0xf1, 0x09,
+	0x7f, 0xc9, 0x58, 0x85, 0xa2, 0x8b, 0x61, 0x2e, 0xce, 0xf7, 0x14, 0x38, 0xa4, 0xc9, 0x5f, 0xa1,
+	0x24, 0x39, 0xa5, 0xfc, 0x44, 0x82, 0x9c, 0x7b, 0x4a, 0xd0, 0x9b, 0x90, 0xf7, 0x42, 0x97, 0x28,
+	0xce, 0x9f, 0x3a, 0x25, 0xe8, 0x89, 0x8f, 0xf7, 0x75, 0xd0, 0x86, 0xfb, 0x9c, 0x6a, 0x74, 0xb4,
+	0x6e, 0x5f, 0x3f, 0x12, 0xaf, 0x62, 0xcb, 0x31, 0xd1, 0x8d, 0xc5, 0x95, 0xed, 0x3b, 0x9b, 0x7d,
+	0xfd, 0x48, 0x2d, 0x30, 0xa5, 0xed, 0x0e, 0x6d, 0x88, 0x22, 0xe7, 0x4b, 0x09, 0xe4, 0xc9, 0x53,
+	0xfc, 0xd5, 0xe7, 0x17, 0x4d, 0x86, 0xe9, 0x98, 0x64, 0x88, 0xd6, 0x61, 0xde, 0x43, 0x68, 0x8e,
+	0x71, 0x34, 0xd4, 0xc9, 0xc8, 0xc6, 0x82, 0x3b, 0x44, 0x9e, 0xa8, 0xe1, 0x4a, 0xa2, 0xdf, 0x3d,
+	0xf5, 0xa4, 0xdf, 0xfd, 0x61, 0x0a, 0x0a, 0x01, 0x2a, 0x13, 0xfd, 0x5f, 0x20, 0x44, 0xcd, 0xc4,
+	0xa5, 0xa0, 0x00, 0xd8, 0x7f, 0x62, 0x0c, 0xaf, 0x54, 0xea, 0x09, 0x56, 0x2a, 0x89, 0x34, 0x76,
+	0xb9, 0xd1, 0xcc, 0x63, 0x73, 0xa3, 0xcf, 0x03, 0x22, 0x26, 0xd1, 0xfb, 0x94, 0x41, 0xa0, 0x1c,
+	0x26, 0x77, 0x6c, 0x1e, 0x51, 0x64, 0x26, 0x39, 0x64, 0x82, 0x3a, 0x3b, 0x0c, 0x3f, 0x90, 0x20,
+	0xe7, 0xf1, 0x46, 0x8f, 0xfb, 0xf4, 0x78, 0x1e, 0xb2, 0xa2, 0xb0, 0xe3, 0x6f, 0x8f, 0xa2, 0x15,
+	0x4b, 0x02, 0x2f, 0x41, 0x6e, 0x80, 0x89, 0xce, 0xc2, 0x23, 0x4f, 0x9f, 0x5e, 0xfb, 0x46, 0x0b,
+	0x0a, 0x81, 0xd7, 0x5b, 0x74, 0x11, 0x16, 0xab, 0x5b, 0xb5, 0xea, 0x5d, 0xad, 0xf9, 0x8e, 0xd6,
+	0xbc, 0x5f, 0xaf, 0x69, 0x07, 0x7b, 0x77, 0xf7, 0xf6, 0xbf, 0xb9, 0x27, 0x9f, 0x8b, 0x8a, 0xd4,
+	0x1a, 0x6b, 0xcb, 0x12, 0xba, 0x00, 0xf3, 0x61, 0x11, 0x17, 0xa4, 0x96, 0x32, 0x3f, 0xfe, 0xd5,
+	0xf2, 0xb9, 0x1b, 0x5f, 0x4a, 0x30, 0x1f, 0x53, 0x42, 0xa3, 0xcb, 0xf0, 0xf4, 0xfe, 0xe6, 0x66,
+	0x4d, 0xd5, 0x1a, 0x7b, 0x95, 0x7a, 0x63, 0x6b, 0xbf, 0xa9, 0xa9, 0xb5, 0xc6, 0xc1, 0x6e, 0x33,
+	0x30, 0xe8, 0x2a, 0x5c, 0x8a, 0x87, 0x54, 0xaa, 0xd5, 0x5a, 0xbd, 0x29, 0x4b, 0x68, 0x05, 0x9e,
+	0x4a, 0x40, 0x6c, 0xec, 0xab, 0x4d, 0x39, 0x95, 0x6c, 0x42, 0xad, 0xed, 0xd4, 0xaa, 0x4d, 0x39,
+	0x8d, 0xae, 0xc1, 0x95, 0xd3, 0x10, 0xda, 0xe6, 0xbe, 0x7a, 0xaf, 0xd2, 0x94, 0x33, 0x67, 0x02,
+	0x1b, 0xb5, 0xbd, 0x3b, 0x35, 0x55, 0x9e, 0x12, 0xdf, 0xfd, 0xcb, 0x14, 0x94, 0x93, 0x2a, 0x75,
+	0x6a, 0xab, 0x52, 0xaf, 0xef, 0xde, 0xf7, 0x6d, 0x55, 0xb7, 0x0e, 0xf6, 0xee, 0x46, 0x97, 0xe0,
+	0x59, 0x50, 0x4e, 0x03, 0x7a, 0x0b, 0x71, 0x15, 0x2e, 0x9f, 0x8a, 0x13, 0xcb, 0x71, 0x06, 0x4c,
+	0xad, 0x35, 0xd5, 0xfb, 0x72, 0x1a, 0xad, 0xc1, 0x8d, 0x33, 0x61, 0x9e, 0x4c, 0xce, 0xa0, 0x75,
+	0xb8, 0x79, 0x3a, 0x9e, 0x2f, 0x90, 0xab, 0xe0, 0x2e, 0xd1, 0x47, 0x12, 0x2c, 0xc6, 0x96, 0xfc,
+	0xe8, 0x0a, 0xac, 0xd4, 0xd5, 0xfd, 0x6a, 0xad, 0xd1, 0xd0, 0xea, 0xea, 0x7e, 0x7d, 0xbf, 0x51,
+	0xd9, 0xd5, 0x1a, 0xcd, 0x4a, 0xf3, 0xa0, 0x11, 0x58, 0x1b, 0x05, 0x96, 0x93, 0x40, 0xde, 0xba,
+	0x9c, 0x82, 0x11, 0x1e, 0xe0, 0xfa, 0xe9, 0x2f, 0x24, 0xb8, 0x98, 0x58, 0xe2, 0xa3, 0xeb, 0xf0,
+	0xcc, 0x61, 0x4d, 0xdd, 0xde, 0xbc, 0xaf, 0x1d, 0xee, 0x37, 0x6b, 0x5a, 0xed, 0x9d, 0x66, 0x6d,
+	0xaf, 0xb1, 0xbd, 0xbf, 0x17, 0x9d, 0xd5, 0x35, 0xb8, 0x72, 0x2a, 0xd2, 0x9b, 0xda, 0x59, 0xc0,
+	0x89, 0xf9, 0xfd, 0x50, 0x82, 0xd9, 0x89, 0x58, 0x88, 0x2e, 0x41, 0xf9, 0xde, 0x76, 0x63, 0xa3,
+	0xb6, 0x55, 0x39, 0xdc, 0xde, 0x57, 0x27, 0xcf, 0xec, 0x15, 0x58, 0x89, 0x48, 0xef, 0x1c, 0xd4,
+	0x77, 0xb7, 0xab, 0x95, 0x66, 0x8d, 0x0d, 0x2a, 0x4b, 0xf4, 0xc3, 0x22, 0xa0, 0xdd, 0xed, 0xb7,
+	0xb6, 0x9a, 0x5a, 0x75, 0x77, 0xbb, 0xb6, 0xd7, 0xd4, 0x2a, 0xcd, 0x66, 0xc5, 0x3f, 0xce, 0x1b,
+	0x77, 0x3f, 0xf9, 0x7c, 0x59, 0xfa, 0xf4, 0xf3, 0x65, 0xe9, 0xaf, 0x9f, 0x2f, 0x4b, 0x1f, 0x7f,
+	0xb1, 0x7c, 0xee, 0xd3, 0x2f, 0x96, 0xcf, 0xfd, 0xf9, 0x8b, 0xe5, 0x73, 0x0f, 0x6e, 0x1d, 0x19,
+	0xa4, 0x37, 0x6a, 0xd1, 0x28, 0xbc, 0xee, 0xff, 0xc9, 0xd4, 0xfd, 0xa1, 0x5b, 0xc6, 0xfa, 0xe4,
+	0x5f, 0x55, 0x5b, 0x59, 0x16, 0x56, 0x5f, 0xfc, 0xe7, 0x00, 0x12, 0xfa, 0x90, 0xbc, 0xc5, 0x2a,
+	0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -5704,6 +5739,16 @@ func (m *InfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OptimisticExecution {
+		i--
+		if m.OptimisticExecution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.LastBlockAppHash) > 0 {
 		i -= len(m.LastBlockAppHash)
 		copy(dAtA[i:], m.LastBlockAppHash)
@@ -5899,6 +5944,18 @@ func (m *CheckTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LaneId) > 0 {
+		i -= len(m.LaneId)
+		copy(dAtA[i:], m.LaneId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LaneId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -7792,6 +7849,9 @@ func (m *InfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.OptimisticExecution {
+		n += 2
+	}
 	return n
 }
 
@@ -7897,6 +7957,13 @@ func (m *CheckTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Priority != 0 {
+		n += 1 + sovTypes(uint64(m.Priority))
+	}
+	l = len(m.LaneId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -12591,6 +12658,26 @@ func (m *InfoResponse) Unmarshal(dAtA []byte) error {
 				m.LastBlockAppHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptimisticExecution = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13323,6 +13410,57 @@ func (m *CheckTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
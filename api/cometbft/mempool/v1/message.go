@@ -13,6 +13,20 @@ func (m *Txs) Wrap() proto.Message {
 	return mm
 }
 
+// Wrap implements the p2p Wrapper interface and wraps a mempool message.
+func (m *HaveTxs) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_HaveTxs{HaveTxs: m}
+	return mm
+}
+
+// Wrap implements the p2p Wrapper interface and wraps a mempool message.
+func (m *WantTxs) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_WantTxs{WantTxs: m}
+	return mm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped mempool
 // message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -20,6 +34,12 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_Txs:
 		return m.GetTxs(), nil
 
+	case *Message_HaveTxs:
+		return m.GetHaveTxs(), nil
+
+	case *Message_WantTxs:
+		return m.GetWantTxs(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
@@ -156,6 +156,9 @@ type DefaultNodeInfo struct {
 	Channels        []byte               `protobuf:"bytes,6,opt,name=channels,proto3" json:"channels,omitempty"`
 	Moniker         string               `protobuf:"bytes,7,opt,name=moniker,proto3" json:"moniker,omitempty"`
 	Other           DefaultNodeInfoOther `protobuf:"bytes,8,opt,name=other,proto3" json:"other"`
+	// The transports the node accepts connections over, e.g. "tcp" and "quic".
+	// Empty for nodes that only support TCP.
+	Transports []string `protobuf:"bytes,9,rep,name=transports,proto3" json:"transports,omitempty"`
 }
 
 func (m *DefaultNodeInfo) Reset()         { *m = DefaultNodeInfo{} }
@@ -247,6 +250,13 @@ func (m *DefaultNodeInfo) GetOther() DefaultNodeInfoOther {
 	return DefaultNodeInfoOther{}
 }
 
+func (m *DefaultNodeInfo) GetTransports() []string {
+	if m != nil {
+		return m.Transports
+	}
+	return nil
+}
+
 // DefaultNodeInfoOther is the misc. application specific data.
 type DefaultNodeInfoOther struct {
 	TxIndex    string `protobuf:"bytes,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
@@ -310,38 +320,39 @@ func init() {
 func init() { proto.RegisterFile("cometbft/p2p/v1/types.proto", fileDescriptor_b87302e2cbe06eca) }
 
 var fileDescriptor_b87302e2cbe06eca = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x8f, 0xda, 0x30,
-	0x10, 0x25, 0x21, 0x7c, 0xec, 0x50, 0xca, 0xd6, 0x42, 0x55, 0x76, 0x2b, 0x25, 0x08, 0xa9, 0x12,
-	0x27, 0xd2, 0xa5, 0xa7, 0x1e, 0x97, 0x72, 0xa1, 0x87, 0x6d, 0x6a, 0x55, 0x3d, 0xf4, 0x82, 0x20,
-	0x36, 0x60, 0xc1, 0xc6, 0x96, 0xe3, 0xa5, 0xf4, 0x5f, 0xf4, 0x67, 0xed, 0x71, 0x8f, 0x3d, 0x45,
-	0x55, 0x38, 0xf7, 0x3f, 0x54, 0x76, 0x02, 0x42, 0xe9, 0xde, 0xe6, 0xcd, 0xf3, 0xcc, 0xbc, 0x79,
-	0x1e, 0x78, 0x13, 0xf1, 0x7b, 0xaa, 0x16, 0x4b, 0x15, 0x88, 0x91, 0x08, 0x76, 0x37, 0x81, 0xfa,
-	0x29, 0x68, 0x32, 0x14, 0x92, 0x2b, 0x8e, 0x3a, 0x47, 0x72, 0x28, 0x46, 0x62, 0xb8, 0xbb, 0xb9,
-	0xee, 0xae, 0xf8, 0x8a, 0x1b, 0x2e, 0xd0, 0x51, 0xfe, 0xac, 0x1f, 0x02, 0xdc, 0x51, 0x75, 0x4b,
-	0x88, 0xa4, 0x49, 0x82, 0x5e, 0x83, 0xcd, 0x88, 0x6b, 0xf5, 0xac, 0xc1, 0xc5, 0xb8, 0x9e, 0xa5,
-	0xbe, 0x3d, 0x9d, 0x60, 0x9b, 0x11, 0x93, 0x17, 0xae, 0x7d, 0x96, 0x0f, 0xb1, 0xcd, 0x04, 0x42,
-	0xe0, 0x08, 0x2e, 0x95, 0x5b, 0xed, 0x59, 0x83, 0x36, 0x36, 0x71, 0xff, 0x2b, 0x74, 0x42, 0xdd,
-	0x3a, 0xe2, 0xdb, 0x6f, 0x54, 0x26, 0x8c, 0xc7, 0xe8, 0x0a, 0xaa, 0x62, 0x24, 0x4c, 0x5f, 0x67,
-	0xdc, 0xc8, 0x52, 0xbf, 0x1a, 0x8e, 0x42, 0xac, 0x73, 0xa8, 0x0b, 0xb5, 0xc5, 0x96, 0x47, 0x1b,
-	0xd3, 0xdc, 0xc1, 0x39, 0x40, 0x97, 0x50, 0x9d, 0x0b, 0x61, 0xda, 0x3a, 0x58, 0x87, 0xfd, 0xbf,
-	0x36, 0x74, 0x26, 0x74, 0x39, 0x7f, 0xd8, 0xaa, 0x3b, 0x4e, 0xe8, 0x34, 0x5e, 0x72, 0xf4, 0x05,
-	0x2e, 0x45, 0x31, 0x69, 0xb6, 0xcb, 0x47, 0x99, 0x19, 0xad, 0x51, 0x6f, 0x58, 0xda, 0x7e, 0x58,
-	0x92, 0x34, 0x76, 0x1e, 0x53, 0xbf, 0x82, 0x3b, 0xa2, 0xa4, 0xf4, 0x03, 0x74, 0x48, 0x3e, 0x65,
-	0x16, 0x73, 0x42, 0x67, 0x8c, 0x14, 0x5b, 0xbf, 0xca, 0x52, 0xbf, 0x7d, 0x2e, 0x60, 0x82, 0xdb,
-	0xe4, 0x0c, 0x12, 0xe4, 0x43, 0x6b, 0xcb, 0x12, 0x45, 0xe3, 0xd9, 0x9c, 0x10, 0x69, 0xb4, 0x5f,
-	0x60, 0xc8, 0x53, 0xda, 0x5f, 0xe4, 0x42, 0x23, 0xa6, 0xea, 0x07, 0x97, 0x1b, 0xd7, 0x31, 0xe4,
-	0x11, 0x6a, 0xe6, 0xa8, 0xbf, 0x96, 0x33, 0x05, 0x44, 0xd7, 0xd0, 0x8c, 0xd6, 0xf3, 0x38, 0xa6,
-	0xdb, 0xc4, 0xad, 0xf7, 0xac, 0xc1, 0x0b, 0x7c, 0xc2, 0xba, 0xea, 0x9e, 0xc7, 0x6c, 0x43, 0xa5,
-	0xdb, 0xc8, 0xab, 0x0a, 0x88, 0x6e, 0xa1, 0xc6, 0xd5, 0x9a, 0x4a, 0xb7, 0x69, 0xdc, 0x78, 0xfb,
-	0x9f, 0x1b, 0x25, 0x27, 0x3f, 0xeb, 0xc7, 0x85, 0x25, 0x79, 0x65, 0x7f, 0x01, 0xdd, 0xe7, 0x1e,
-	0xa1, 0x2b, 0x68, 0xaa, 0xfd, 0x8c, 0xc5, 0x84, 0xee, 0xf3, 0x3b, 0xc1, 0x0d, 0xb5, 0x9f, 0x6a,
-	0x88, 0x02, 0x68, 0x49, 0x11, 0x99, 0xed, 0x69, 0x92, 0x14, 0xbe, 0xbd, 0xcc, 0x52, 0x1f, 0x70,
-	0xf8, 0xb1, 0xb8, 0x30, 0x0c, 0x52, 0x44, 0x45, 0x3c, 0xfe, 0xf4, 0x98, 0x79, 0xd6, 0x53, 0xe6,
-	0x59, 0x7f, 0x32, 0xcf, 0xfa, 0x75, 0xf0, 0x2a, 0x4f, 0x07, 0xaf, 0xf2, 0xfb, 0xe0, 0x55, 0xbe,
-	0xbf, 0x5b, 0x31, 0xb5, 0x7e, 0x58, 0x68, 0xdd, 0xc1, 0xe9, 0xc8, 0x4f, 0xc1, 0x5c, 0xb0, 0xa0,
-	0x74, 0xfa, 0x8b, 0xba, 0xf9, 0xc9, 0xf7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x50, 0x06, 0xfb,
-	0xcd, 0x14, 0x03, 0x00, 0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x8f, 0xda, 0x30,
+	0x10, 0x25, 0x24, 0xcb, 0xc7, 0x50, 0xca, 0xd6, 0x42, 0x55, 0x76, 0x2b, 0x25, 0x08, 0xa9, 0x12,
+	0x27, 0xd2, 0xa5, 0xa7, 0x1e, 0x97, 0x72, 0xa1, 0x87, 0x6d, 0x6a, 0x55, 0x3d, 0xf4, 0x82, 0x42,
+	0x6c, 0x20, 0x82, 0x8d, 0x2d, 0xdb, 0x4b, 0xe9, 0x8f, 0xa8, 0xd4, 0x9f, 0xb5, 0xc7, 0x3d, 0xf6,
+	0x84, 0xaa, 0xf0, 0x47, 0x2a, 0x3b, 0x81, 0xa2, 0xb4, 0xb7, 0x79, 0x33, 0x9e, 0x37, 0x6f, 0x9e,
+	0x6d, 0x78, 0x15, 0xb3, 0x7b, 0xaa, 0xe6, 0x0b, 0x15, 0xf0, 0x11, 0x0f, 0xb6, 0x37, 0x81, 0xfa,
+	0xce, 0xa9, 0x1c, 0x72, 0xc1, 0x14, 0x43, 0x9d, 0x63, 0x71, 0xc8, 0x47, 0x7c, 0xb8, 0xbd, 0xb9,
+	0xee, 0x2e, 0xd9, 0x92, 0x99, 0x5a, 0xa0, 0xa3, 0xfc, 0x58, 0x3f, 0x04, 0xb8, 0xa3, 0xea, 0x96,
+	0x10, 0x41, 0xa5, 0x44, 0x2f, 0xa1, 0x9a, 0x10, 0xd7, 0xea, 0x59, 0x83, 0xe6, 0xb8, 0x96, 0xed,
+	0xfd, 0xea, 0x74, 0x82, 0xab, 0x09, 0x31, 0x79, 0xee, 0x56, 0xcf, 0xf2, 0x21, 0xae, 0x26, 0x1c,
+	0x21, 0x70, 0x38, 0x13, 0xca, 0xb5, 0x7b, 0xd6, 0xa0, 0x8d, 0x4d, 0xdc, 0xff, 0x0c, 0x9d, 0x50,
+	0x53, 0xc7, 0x6c, 0xf3, 0x85, 0x0a, 0x99, 0xb0, 0x14, 0x5d, 0x81, 0xcd, 0x47, 0xdc, 0xf0, 0x3a,
+	0xe3, 0x7a, 0xb6, 0xf7, 0xed, 0x70, 0x14, 0x62, 0x9d, 0x43, 0x5d, 0xb8, 0x98, 0x6f, 0x58, 0xbc,
+	0x36, 0xe4, 0x0e, 0xce, 0x01, 0xba, 0x04, 0x3b, 0xe2, 0xdc, 0xd0, 0x3a, 0x58, 0x87, 0xfd, 0x1f,
+	0x36, 0x74, 0x26, 0x74, 0x11, 0x3d, 0x6c, 0xd4, 0x1d, 0x23, 0x74, 0x9a, 0x2e, 0x18, 0xfa, 0x04,
+	0x97, 0xbc, 0x98, 0x34, 0xdb, 0xe6, 0xa3, 0xcc, 0x8c, 0xd6, 0xa8, 0x37, 0x2c, 0x6d, 0x3f, 0x2c,
+	0x49, 0x1a, 0x3b, 0x8f, 0x7b, 0xbf, 0x82, 0x3b, 0xbc, 0xa4, 0xf4, 0x1d, 0x74, 0x48, 0x3e, 0x65,
+	0x96, 0x32, 0x42, 0x67, 0x09, 0x29, 0xb6, 0x7e, 0x91, 0xed, 0xfd, 0xf6, 0xb9, 0x80, 0x09, 0x6e,
+	0x93, 0x33, 0x48, 0x90, 0x0f, 0xad, 0x4d, 0x22, 0x15, 0x4d, 0x67, 0x11, 0x21, 0xc2, 0x68, 0x6f,
+	0x62, 0xc8, 0x53, 0xda, 0x5f, 0xe4, 0x42, 0x3d, 0xa5, 0xea, 0x1b, 0x13, 0x6b, 0xd7, 0x31, 0xc5,
+	0x23, 0xd4, 0x95, 0xa3, 0xfe, 0x8b, 0xbc, 0x52, 0x40, 0x74, 0x0d, 0x8d, 0x78, 0x15, 0xa5, 0x29,
+	0xdd, 0x48, 0xb7, 0xd6, 0xb3, 0x06, 0xcf, 0xf0, 0x09, 0xeb, 0xae, 0x7b, 0x96, 0x26, 0x6b, 0x2a,
+	0xdc, 0x7a, 0xde, 0x55, 0x40, 0x74, 0x0b, 0x17, 0x4c, 0xad, 0xa8, 0x70, 0x1b, 0xc6, 0x8d, 0xd7,
+	0xff, 0xb8, 0x51, 0x72, 0xf2, 0xa3, 0x3e, 0x5c, 0x58, 0x92, 0x77, 0x22, 0x0f, 0x40, 0x89, 0x28,
+	0x95, 0xfa, 0x4a, 0xa5, 0xdb, 0xec, 0xd9, 0x7a, 0x99, 0xbf, 0x99, 0xfe, 0x1c, 0xba, 0xff, 0x23,
+	0x41, 0x57, 0xd0, 0x50, 0xbb, 0x59, 0x92, 0x12, 0xba, 0xcb, 0xdf, 0x11, 0xae, 0xab, 0xdd, 0x54,
+	0x43, 0x14, 0x40, 0x4b, 0xf0, 0xd8, 0xb8, 0x43, 0xa5, 0x2c, 0x7c, 0x7d, 0x9e, 0xed, 0x7d, 0xc0,
+	0xe1, 0xfb, 0xe2, 0x05, 0x62, 0x10, 0x3c, 0x2e, 0xe2, 0xf1, 0x87, 0xc7, 0xcc, 0xb3, 0x9e, 0x32,
+	0xcf, 0xfa, 0x9d, 0x79, 0xd6, 0xcf, 0x83, 0x57, 0x79, 0x3a, 0x78, 0x95, 0x5f, 0x07, 0xaf, 0xf2,
+	0xf5, 0xcd, 0x32, 0x51, 0xab, 0x87, 0xb9, 0xde, 0x2b, 0x38, 0x7d, 0x82, 0x53, 0x10, 0xf1, 0x24,
+	0x28, 0x7d, 0x8d, 0x79, 0xcd, 0xdc, 0xf4, 0xdb, 0x3f, 0x03, 0x00, 0x3a, 0xc8, 0xe7, 0x36, 0x34,
+	0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -444,6 +455,15 @@ func (m *DefaultNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Transports) > 0 {
+		for iNdEx := len(m.Transports) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transports[iNdEx])
+			copy(dAtA[i:], m.Transports[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Transports[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.Other.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -629,6 +649,12 @@ func (m *DefaultNodeInfo) Size() (n int) {
 	}
 	l = m.Other.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Transports) > 0 {
+		for _, s := range m.Transports {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1184,6 +1210,38 @@ func (m *DefaultNodeInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transports", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transports = append(m.Transports, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
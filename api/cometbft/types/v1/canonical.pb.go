@@ -87,6 +87,9 @@ func (m *CanonicalBlockID) GetPartSetHeader() CanonicalPartSetHeader {
 type CanonicalPartSetHeader struct {
 	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of parity parts, among the total, of an erasure-coded part set, or
+	// 0 if the part set is not erasure-coded.
+	Parity uint32 `protobuf:"varint,3,opt,name=parity,proto3" json:"parity,omitempty"`
 }
 
 func (m *CanonicalPartSetHeader) Reset()         { *m = CanonicalPartSetHeader{} }
@@ -136,6 +139,13 @@ func (m *CanonicalPartSetHeader) GetHash() []byte {
 	return nil
 }
 
+func (m *CanonicalPartSetHeader) GetParity() uint32 {
+	if m != nil {
+		return m.Parity
+	}
+	return 0
+}
+
 // CanonicalProposal is a canonical representation of a Proposal, which gets
 // serialized and signed.
 type CanonicalProposal struct {
@@ -397,40 +407,41 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v1/canonical.proto", fileDescriptor_bd60568638662265) }
 
 var fileDescriptor_bd60568638662265 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xdf, 0x6a, 0xd3, 0x50,
-	0x1c, 0xc7, 0x9b, 0x2e, 0x6d, 0x93, 0xb3, 0x55, 0xb7, 0xc3, 0x18, 0xb1, 0x68, 0x12, 0x2b, 0x48,
-	0x77, 0x93, 0xb0, 0xea, 0x13, 0x64, 0x0a, 0x16, 0x27, 0x8e, 0x6c, 0x28, 0x78, 0x53, 0x4e, 0x92,
-	0xb3, 0x24, 0x98, 0xe6, 0x1c, 0x92, 0xd3, 0xe1, 0xae, 0xf6, 0x0a, 0x7b, 0x10, 0x1f, 0x64, 0x97,
-	0xbb, 0x14, 0x84, 0x2a, 0xe9, 0x8b, 0xc8, 0x39, 0x69, 0xd2, 0x42, 0x47, 0x41, 0x94, 0xdd, 0xfd,
-	0xfe, 0xff, 0xbe, 0x7c, 0x7e, 0xc9, 0x01, 0xcf, 0x7d, 0x32, 0xc1, 0xcc, 0xbb, 0x60, 0x36, 0xbb,
-	0xa2, 0x38, 0xb7, 0x2f, 0x8f, 0x6c, 0x1f, 0xa5, 0x24, 0x8d, 0x7d, 0x94, 0x58, 0x34, 0x23, 0x8c,
-	0xc0, 0xbd, 0xaa, 0xc4, 0x12, 0x25, 0xd6, 0xe5, 0x51, 0x6f, 0x3f, 0x24, 0x21, 0x11, 0x59, 0x9b,
-	0x5b, 0x65, 0x61, 0xef, 0xd9, 0xfa, 0xac, 0xb2, 0xa3, 0x4c, 0x1b, 0x21, 0x21, 0x61, 0x82, 0x6d,
-	0xe1, 0x79, 0xd3, 0x0b, 0x9b, 0xc5, 0x13, 0x9c, 0x33, 0x34, 0xa1, 0x65, 0x41, 0xff, 0x1a, 0xec,
-	0x1e, 0x57, 0xbb, 0x9d, 0x84, 0xf8, 0x5f, 0x47, 0x6f, 0x20, 0x04, 0x72, 0x84, 0xf2, 0x48, 0x93,
-	0x4c, 0x69, 0xb0, 0xe3, 0x0a, 0x1b, 0x7e, 0x06, 0x8f, 0x29, 0xca, 0xd8, 0x38, 0xc7, 0x6c, 0x1c,
-	0x61, 0x14, 0xe0, 0x4c, 0x6b, 0x9a, 0xd2, 0x60, 0x7b, 0x78, 0x68, 0xad, 0x49, 0xb5, 0xea, 0x89,
-	0xa7, 0x28, 0x63, 0x67, 0x98, 0xbd, 0x13, 0x0d, 0x8e, 0x7c, 0x3b, 0x33, 0x1a, 0x6e, 0x97, 0xae,
-	0x06, 0xfb, 0x0e, 0x38, 0xb8, 0xbf, 0x1c, 0xee, 0x83, 0x16, 0x23, 0x0c, 0x25, 0x42, 0x47, 0xd7,
-	0x2d, 0x9d, 0x5a, 0x5c, 0x73, 0x29, 0xae, 0xff, 0xb3, 0x09, 0xf6, 0x96, 0x43, 0x32, 0x42, 0x49,
-	0x8e, 0x12, 0xf8, 0x1a, 0xc8, 0x5c, 0x91, 0x68, 0x7f, 0x34, 0x34, 0xef, 0xd1, 0x79, 0x16, 0x87,
-	0x29, 0x0e, 0x3e, 0xe4, 0xe1, 0xf9, 0x15, 0xc5, 0xae, 0xa8, 0x86, 0x07, 0xa0, 0x1d, 0xe1, 0x38,
-	0x8c, 0x98, 0xd8, 0xb0, 0xeb, 0x2e, 0x3c, 0xae, 0x26, 0x23, 0xd3, 0x34, 0xd0, 0xb6, 0x44, 0xb8,
-	0x74, 0xe0, 0x21, 0x50, 0x29, 0x49, 0xc6, 0x65, 0x46, 0x36, 0xa5, 0xc1, 0x96, 0xb3, 0x53, 0xcc,
-	0x0c, 0xe5, 0xf4, 0xe3, 0x89, 0xcb, 0x63, 0xae, 0x42, 0x49, 0x22, 0x2c, 0xf8, 0x1e, 0x28, 0x1e,
-	0x07, 0x3c, 0x8e, 0x03, 0xad, 0x25, 0xd0, 0xbd, 0xd8, 0x84, 0x6e, 0x71, 0x0c, 0x67, 0xbb, 0x98,
-	0x19, 0x9d, 0x85, 0xe3, 0x76, 0xc4, 0x84, 0x51, 0x00, 0x1d, 0xa0, 0xd6, 0x97, 0xd4, 0xda, 0x62,
-	0x5a, 0xcf, 0x2a, 0x6f, 0x6d, 0x55, 0xb7, 0xb6, 0xce, 0xab, 0x0a, 0x47, 0xe1, 0xe4, 0x6f, 0x7e,
-	0x19, 0x92, 0xbb, 0x6c, 0x83, 0x2f, 0x81, 0xe2, 0x47, 0x28, 0x4e, 0xb9, 0xa0, 0x8e, 0x29, 0x0d,
-	0xd4, 0x72, 0xd7, 0x31, 0x8f, 0xf1, 0x5d, 0x22, 0x39, 0x0a, 0xfa, 0xdf, 0x9b, 0xa0, 0x5b, 0xcb,
-	0xfa, 0x44, 0x18, 0x7e, 0x10, 0xb2, 0xab, 0xb8, 0xe4, 0xff, 0x8a, 0xab, 0xf5, 0xef, 0xb8, 0xda,
-	0x1b, 0x70, 0x5d, 0xaf, 0x7c, 0xd0, 0x9c, 0xd6, 0xdb, 0x6f, 0x0c, 0xa7, 0x79, 0x4c, 0x52, 0xf8,
-	0x14, 0xa8, 0xb8, 0x72, 0x16, 0x3f, 0xd7, 0x32, 0xf0, 0x97, 0x78, 0x9e, 0xac, 0xa8, 0xe1, 0x78,
-	0xd4, 0x5a, 0x80, 0x73, 0x72, 0x5b, 0xe8, 0xd2, 0x5d, 0xa1, 0x4b, 0xbf, 0x0b, 0x5d, 0xba, 0x99,
-	0xeb, 0x8d, 0xbb, 0xb9, 0xde, 0xf8, 0x31, 0xd7, 0x1b, 0x5f, 0x86, 0x61, 0xcc, 0xa2, 0xa9, 0xc7,
-	0x39, 0xda, 0xf5, 0xbb, 0x51, 0x1b, 0x88, 0xc6, 0xf6, 0xda, 0x6b, 0xe2, 0xb5, 0x05, 0x9f, 0x57,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x35, 0xdb, 0x57, 0x1c, 0xb5, 0x04, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xdd, 0x6a, 0xdb, 0x3c,
+	0x18, 0xc7, 0xe3, 0x34, 0x9f, 0x6a, 0xf3, 0xbe, 0xa9, 0x28, 0x21, 0x0b, 0x9b, 0x93, 0x65, 0x30,
+	0xd2, 0x13, 0x9b, 0x66, 0xbb, 0x02, 0x77, 0x83, 0x85, 0x75, 0xac, 0xa8, 0x65, 0x83, 0x9e, 0x04,
+	0xd9, 0x56, 0x6d, 0x31, 0xc7, 0x12, 0xb6, 0x52, 0x96, 0xa3, 0xde, 0x42, 0x2f, 0x64, 0x17, 0xd2,
+	0xc3, 0x1e, 0x0e, 0x06, 0xd9, 0x70, 0x6e, 0x64, 0x48, 0xfe, 0x48, 0x46, 0x4a, 0x61, 0x6c, 0xec,
+	0xec, 0xf9, 0xf8, 0xeb, 0x79, 0xfe, 0xfc, 0x64, 0x0b, 0x3c, 0x75, 0xd8, 0x8c, 0x08, 0xfb, 0x52,
+	0x98, 0x62, 0xc1, 0x49, 0x6c, 0x5e, 0x1d, 0x99, 0x0e, 0x0e, 0x59, 0x48, 0x1d, 0x1c, 0x18, 0x3c,
+	0x62, 0x82, 0xc1, 0xfd, 0x5c, 0x62, 0x28, 0x89, 0x71, 0x75, 0xd4, 0x3b, 0xf0, 0x98, 0xc7, 0x54,
+	0xd7, 0x94, 0x51, 0x2a, 0xec, 0x3d, 0xd9, 0x9e, 0x95, 0x9e, 0x48, 0xdb, 0x7d, 0x8f, 0x31, 0x2f,
+	0x20, 0xa6, 0xca, 0xec, 0xf9, 0xa5, 0x29, 0xe8, 0x8c, 0xc4, 0x02, 0xcf, 0x78, 0x2a, 0x18, 0x5e,
+	0x83, 0xf6, 0x71, 0xbe, 0xdb, 0x0a, 0x98, 0xf3, 0x69, 0xf2, 0x0a, 0x42, 0x50, 0xf1, 0x71, 0xec,
+	0x77, 0xb5, 0x81, 0x36, 0xda, 0x43, 0x2a, 0x86, 0x1f, 0xc1, 0xff, 0x1c, 0x47, 0x62, 0x1a, 0x13,
+	0x31, 0xf5, 0x09, 0x76, 0x49, 0xd4, 0x2d, 0x0f, 0xb4, 0xd1, 0xee, 0xf8, 0xd0, 0xd8, 0xb2, 0x6a,
+	0x14, 0x13, 0x4f, 0x71, 0x24, 0xce, 0x88, 0x78, 0xa3, 0x0e, 0x58, 0x95, 0xdb, 0x65, 0xbf, 0x84,
+	0x5a, 0x7c, 0xb3, 0x38, 0xbc, 0x00, 0x9d, 0xfb, 0xe5, 0xf0, 0x00, 0x54, 0x05, 0x13, 0x38, 0x50,
+	0x3e, 0x5a, 0x28, 0x4d, 0x0a, 0x73, 0xe5, 0x0d, 0x73, 0x1d, 0x50, 0xe3, 0x38, 0xa2, 0x62, 0xd1,
+	0xdd, 0x51, 0xd2, 0x2c, 0x1b, 0x7e, 0x2b, 0x83, 0xfd, 0xf5, 0xf0, 0x88, 0x71, 0x16, 0xe3, 0x00,
+	0xbe, 0x04, 0x15, 0xe9, 0x54, 0x8d, 0xfd, 0x6f, 0x3c, 0xb8, 0xc7, 0xff, 0x19, 0xf5, 0x42, 0xe2,
+	0xbe, 0x8b, 0xbd, 0xf3, 0x05, 0x27, 0x48, 0xa9, 0xe5, 0x0e, 0x9f, 0x50, 0xcf, 0x17, 0x6a, 0x73,
+	0x1b, 0x65, 0x99, 0x74, 0x19, 0xb1, 0x79, 0xe8, 0xaa, 0xd5, 0x6d, 0x94, 0x26, 0xf0, 0x10, 0x34,
+	0x39, 0x0b, 0xa6, 0x69, 0xa7, 0x32, 0xd0, 0x46, 0x3b, 0xd6, 0x5e, 0xb2, 0xec, 0x37, 0x4e, 0xdf,
+	0x9f, 0x20, 0x59, 0x43, 0x0d, 0xce, 0x02, 0x15, 0xc1, 0xb7, 0xa0, 0x61, 0x4b, 0xf0, 0x53, 0xea,
+	0x76, 0xab, 0x0a, 0xe9, 0xb3, 0x87, 0x90, 0x66, 0x97, 0x64, 0xed, 0x26, 0xcb, 0x7e, 0x3d, 0x4b,
+	0x50, 0x5d, 0x4d, 0x98, 0xb8, 0xd0, 0x02, 0xcd, 0xe2, 0x86, 0xbb, 0x35, 0x35, 0xad, 0x67, 0xa4,
+	0xdf, 0x80, 0x91, 0x7f, 0x03, 0xc6, 0x79, 0xae, 0xb0, 0x1a, 0xf2, 0x46, 0x6e, 0xbe, 0xf7, 0x35,
+	0xb4, 0x3e, 0x06, 0x9f, 0x83, 0x86, 0xe3, 0x63, 0x1a, 0x4a, 0x43, 0xf5, 0x81, 0x36, 0x6a, 0xa6,
+	0xbb, 0x8e, 0x65, 0x4d, 0xee, 0x52, 0xcd, 0x89, 0x3b, 0xfc, 0x52, 0x06, 0xad, 0xc2, 0xd6, 0x07,
+	0x26, 0xc8, 0x3f, 0x21, 0xbb, 0x89, 0xab, 0xf2, 0x57, 0x71, 0x55, 0xff, 0x1c, 0x57, 0xed, 0x01,
+	0x5c, 0xd7, 0xa0, 0xf3, 0x0b, 0xad, 0xd7, 0x9f, 0x05, 0x09, 0x63, 0xca, 0x42, 0xf8, 0x18, 0x34,
+	0x49, 0x9e, 0x64, 0x3f, 0xdd, 0xba, 0xf0, 0x9b, 0x78, 0x1e, 0x6d, 0xb8, 0x91, 0x78, 0x9a, 0x85,
+	0x01, 0xeb, 0xe4, 0x36, 0xd1, 0xb5, 0xbb, 0x44, 0xd7, 0x7e, 0x24, 0xba, 0x76, 0xb3, 0xd2, 0x4b,
+	0x77, 0x2b, 0xbd, 0xf4, 0x75, 0xa5, 0x97, 0x2e, 0xc6, 0x1e, 0x15, 0xfe, 0xdc, 0x96, 0x1c, 0xcd,
+	0xe2, 0x3d, 0x29, 0x02, 0xcc, 0xa9, 0xb9, 0xf5, 0xca, 0xd8, 0x35, 0xc5, 0xe7, 0xc5, 0xcf, 0x01,
+	0x00, 0x37, 0x6f, 0xbe, 0xf1, 0xcd, 0x04, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -493,6 +504,11 @@ func (m *CanonicalPartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Parity != 0 {
+		i = encodeVarintCanonical(dAtA, i, uint64(m.Parity))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -735,6 +751,9 @@ func (m *CanonicalPartSetHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCanonical(uint64(l))
 	}
+	if m.Parity != 0 {
+		n += 1 + sovCanonical(uint64(m.Parity))
+	}
 	return n
 }
 
@@ -1025,6 +1044,25 @@ func (m *CanonicalPartSetHeader) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			m.Parity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCanonical(dAtA[iNdEx:])
@@ -28,6 +28,40 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProposerSelection is an algorithm selecting the proposer of each round
+// among the validators of a height.
+type ProposerSelection int32
+
+const (
+	// Weighted round-robin: the proposer is the validator with the highest
+	// proposer priority, the priority of each validator being incremented by
+	// its voting power every round, and decremented by the total voting power
+	// when it is selected.
+	ProposerSelectionWeightedRoundRobin ProposerSelection = 0
+	// Stake-weighted random: the proposer is drawn with a probability
+	// proportional to its voting power, the draw being seeded from the hash of
+	// the previous block, the height and the round.
+	ProposerSelectionStakeWeightedRandom ProposerSelection = 1
+)
+
+var ProposerSelection_name = map[int32]string{
+	0: "PROPOSER_SELECTION_WEIGHTED_ROUND_ROBIN",
+	1: "PROPOSER_SELECTION_STAKE_WEIGHTED_RANDOM",
+}
+
+var ProposerSelection_value = map[string]int32{
+	"PROPOSER_SELECTION_WEIGHTED_ROUND_ROBIN":  0,
+	"PROPOSER_SELECTION_STAKE_WEIGHTED_RANDOM": 1,
+}
+
+func (x ProposerSelection) String() string {
+	return proto.EnumName(ProposerSelection_name, int32(x))
+}
+
+func (ProposerSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8c2f6d19461b2fe7, []int{0}
+}
+
 // ConsensusParams contains consensus critical parameters that determine the
 // validity of blocks.
 type ConsensusParams struct {
@@ -264,6 +298,11 @@ func (m *EvidenceParams) GetMaxBytes() int64 {
 // NOTE: uses ABCI public keys naming, not Amino names.
 type ValidatorParams struct {
 	PubKeyTypes []string `protobuf:"bytes,1,rep,name=pub_key_types,json=pubKeyTypes,proto3" json:"pub_key_types,omitempty"`
+	// Algorithm selecting the proposer of each round among the validators.
+	//
+	// It defaults to the weighted round-robin. Updates leaving it unset keep
+	// the current algorithm.
+	ProposerSelection *ProposerSelectionValue `protobuf:"bytes,2,opt,name=proposer_selection,json=proposerSelection,proto3" json:"proposer_selection,omitempty"`
 }
 
 func (m *ValidatorParams) Reset()         { *m = ValidatorParams{} }
@@ -306,6 +345,59 @@ func (m *ValidatorParams) GetPubKeyTypes() []string {
 	return nil
 }
 
+func (m *ValidatorParams) GetProposerSelection() *ProposerSelectionValue {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return nil
+}
+
+// ProposerSelectionValue wraps a ProposerSelection, so that it can be left
+// unset in updates of the consensus parameters.
+type ProposerSelectionValue struct {
+	Value ProposerSelection `protobuf:"varint,1,opt,name=value,proto3,enum=cometbft.types.v1.ProposerSelection" json:"value,omitempty"`
+}
+
+func (m *ProposerSelectionValue) Reset()         { *m = ProposerSelectionValue{} }
+func (m *ProposerSelectionValue) String() string { return proto.CompactTextString(m) }
+func (*ProposerSelectionValue) ProtoMessage()    {}
+func (*ProposerSelectionValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c2f6d19461b2fe7, []int{4}
+}
+func (m *ProposerSelectionValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSelectionValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSelectionValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSelectionValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSelectionValue.Merge(m, src)
+}
+func (m *ProposerSelectionValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSelectionValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSelectionValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSelectionValue proto.InternalMessageInfo
+
+func (m *ProposerSelectionValue) GetValue() ProposerSelection {
+	if m != nil {
+		return m.Value
+	}
+	return ProposerSelectionWeightedRoundRobin
+}
+
 // VersionParams contain the version of specific components of CometBFT.
 type VersionParams struct {
 	// The ABCI application version.
@@ -318,7 +410,7 @@ func (m *VersionParams) Reset()         { *m = VersionParams{} }
 func (m *VersionParams) String() string { return proto.CompactTextString(m) }
 func (*VersionParams) ProtoMessage()    {}
 func (*VersionParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c2f6d19461b2fe7, []int{4}
+	return fileDescriptor_8c2f6d19461b2fe7, []int{5}
 }
 func (m *VersionParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,15 +450,16 @@ func (m *VersionParams) GetApp() uint64 {
 //
 // It is hashed into the Header.ConsensusHash.
 type HashedParams struct {
-	BlockMaxBytes int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
-	BlockMaxGas   int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	BlockMaxBytes     int64             `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
+	BlockMaxGas       int64             `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	ProposerSelection ProposerSelection `protobuf:"varint,3,opt,name=proposer_selection,json=proposerSelection,proto3,enum=cometbft.types.v1.ProposerSelection" json:"proposer_selection,omitempty"`
 }
 
 func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c2f6d19461b2fe7, []int{5}
+	return fileDescriptor_8c2f6d19461b2fe7, []int{6}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,6 +502,13 @@ func (m *HashedParams) GetBlockMaxGas() int64 {
 	return 0
 }
 
+func (m *HashedParams) GetProposerSelection() ProposerSelection {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return ProposerSelectionWeightedRoundRobin
+}
+
 // SynchronyParams determine the validity of block timestamps.
 //
 // These parameters are part of the Proposer-Based Timestamps (PBTS) algorithm.
@@ -428,7 +528,7 @@ func (m *SynchronyParams) Reset()         { *m = SynchronyParams{} }
 func (m *SynchronyParams) String() string { return proto.CompactTextString(m) }
 func (*SynchronyParams) ProtoMessage()    {}
 func (*SynchronyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c2f6d19461b2fe7, []int{6}
+	return fileDescriptor_8c2f6d19461b2fe7, []int{7}
 }
 func (m *SynchronyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +601,7 @@ func (m *FeatureParams) Reset()         { *m = FeatureParams{} }
 func (m *FeatureParams) String() string { return proto.CompactTextString(m) }
 func (*FeatureParams) ProtoMessage()    {}
 func (*FeatureParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c2f6d19461b2fe7, []int{7}
+	return fileDescriptor_8c2f6d19461b2fe7, []int{8}
 }
 func (m *FeatureParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +657,7 @@ func (m *ABCIParams) Reset()         { *m = ABCIParams{} }
 func (m *ABCIParams) String() string { return proto.CompactTextString(m) }
 func (*ABCIParams) ProtoMessage()    {}
 func (*ABCIParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c2f6d19461b2fe7, []int{8}
+	return fileDescriptor_8c2f6d19461b2fe7, []int{9}
 }
 func (m *ABCIParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -594,10 +694,12 @@ func (m *ABCIParams) GetVoteExtensionsEnableHeight() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("cometbft.types.v1.ProposerSelection", ProposerSelection_name, ProposerSelection_value)
 	proto.RegisterType((*ConsensusParams)(nil), "cometbft.types.v1.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "cometbft.types.v1.BlockParams")
 	proto.RegisterType((*EvidenceParams)(nil), "cometbft.types.v1.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "cometbft.types.v1.ValidatorParams")
+	proto.RegisterType((*ProposerSelectionValue)(nil), "cometbft.types.v1.ProposerSelectionValue")
 	proto.RegisterType((*VersionParams)(nil), "cometbft.types.v1.VersionParams")
 	proto.RegisterType((*HashedParams)(nil), "cometbft.types.v1.HashedParams")
 	proto.RegisterType((*SynchronyParams)(nil), "cometbft.types.v1.SynchronyParams")
@@ -608,53 +710,65 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v1/params.proto", fileDescriptor_8c2f6d19461b2fe7) }
 
 var fileDescriptor_8c2f6d19461b2fe7 = []byte{
-	// 729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbf, 0x4e, 0xdb, 0x50,
-	0x14, 0xc6, 0x73, 0xe3, 0x00, 0xc9, 0x09, 0x21, 0xe9, 0x55, 0xa5, 0xba, 0x20, 0x1c, 0xea, 0xa1,
-	0x42, 0x42, 0xb2, 0x05, 0xa5, 0x1d, 0x90, 0x50, 0x4b, 0x80, 0x02, 0xad, 0x68, 0x91, 0xa9, 0x18,
-	0x58, 0xac, 0xeb, 0xe4, 0xe2, 0x58, 0xc4, 0x7f, 0xe4, 0x6b, 0xa7, 0xc9, 0x5b, 0x74, 0xaa, 0x3a,
-	0x32, 0xb6, 0x6f, 0xd0, 0xbe, 0x40, 0xc5, 0xc8, 0xd8, 0x89, 0x56, 0x61, 0xe9, 0x63, 0x54, 0xbe,
-	0xb6, 0x13, 0x12, 0x42, 0x61, 0xb3, 0x7d, 0xbe, 0xdf, 0xe7, 0xef, 0x9e, 0x73, 0x6c, 0x90, 0xea,
-	0xae, 0x4d, 0x03, 0xe3, 0x24, 0x50, 0x83, 0xae, 0x47, 0x99, 0xda, 0x5e, 0x56, 0x3d, 0xe2, 0x13,
-	0x9b, 0x29, 0x9e, 0xef, 0x06, 0x2e, 0x7e, 0x90, 0xd6, 0x15, 0x5e, 0x57, 0xda, 0xcb, 0xb3, 0x0f,
-	0x4d, 0xd7, 0x74, 0x79, 0x55, 0x8d, 0xae, 0x62, 0xe1, 0xac, 0x64, 0xba, 0xae, 0xd9, 0xa2, 0x2a,
-	0xbf, 0x33, 0xc2, 0x13, 0xb5, 0x11, 0xfa, 0x24, 0xb0, 0x5c, 0xe7, 0xb6, 0xfa, 0x47, 0x9f, 0x78,
-	0x1e, 0xf5, 0x93, 0x17, 0xc9, 0x3f, 0x04, 0x28, 0x6f, 0xba, 0x0e, 0xa3, 0x0e, 0x0b, 0xd9, 0x01,
-	0x8f, 0x80, 0x57, 0x61, 0xc2, 0x68, 0xb9, 0xf5, 0x53, 0x11, 0x2d, 0xa0, 0xc5, 0xe2, 0x8a, 0xa4,
-	0xdc, 0x08, 0xa3, 0xd4, 0xa2, 0x7a, 0x2c, 0xd7, 0x62, 0x31, 0x5e, 0x87, 0x3c, 0x6d, 0x5b, 0x0d,
-	0xea, 0xd4, 0xa9, 0x98, 0xe5, 0xe0, 0x93, 0x31, 0xe0, 0x76, 0x22, 0x49, 0xd8, 0x3e, 0x82, 0x5f,
-	0x41, 0xa1, 0x4d, 0x5a, 0x56, 0x83, 0x04, 0xae, 0x2f, 0x0a, 0x9c, 0x97, 0xc7, 0xf0, 0x47, 0xa9,
-	0x26, 0x31, 0x18, 0x40, 0x78, 0x0d, 0xa6, 0xda, 0xd4, 0x67, 0x96, 0xeb, 0x88, 0x39, 0xce, 0x2f,
-	0x8c, 0xe3, 0x63, 0x45, 0x42, 0xa7, 0x00, 0x7e, 0x0e, 0x39, 0x62, 0xd4, 0x2d, 0x71, 0x82, 0x83,
-	0xf3, 0x63, 0xc0, 0x8d, 0xda, 0xe6, 0x5e, 0x4c, 0xd5, 0xb2, 0x22, 0xd2, 0xb8, 0x3c, 0x0a, 0xcd,
-	0xba, 0x4e, 0xbd, 0xe9, 0xbb, 0x4e, 0x57, 0x9c, 0xbc, 0x35, 0xf4, 0x61, 0xaa, 0x49, 0x43, 0xf7,
-	0xa1, 0x28, 0xf4, 0x09, 0x25, 0x41, 0xe8, 0x53, 0x71, 0xea, 0xd6, 0xd0, 0xaf, 0x63, 0x45, 0x1a,
-	0x3a, 0x01, 0xe4, 0x3d, 0x28, 0x5e, 0x9b, 0x03, 0x9e, 0x83, 0x82, 0x4d, 0x3a, 0xba, 0xd1, 0x0d,
-	0x28, 0xe3, 0xa3, 0x13, 0xb4, 0xbc, 0x4d, 0x3a, 0xb5, 0xe8, 0x1e, 0x3f, 0x82, 0xa9, 0xa8, 0x68,
-	0x12, 0xc6, 0x87, 0x23, 0x68, 0x93, 0x36, 0xe9, 0xec, 0x10, 0xf6, 0x26, 0x97, 0x17, 0x2a, 0x39,
-	0xf9, 0x1b, 0x82, 0x99, 0xe1, 0xd1, 0xe0, 0x25, 0xc0, 0x11, 0x41, 0x4c, 0xaa, 0x3b, 0xa1, 0xad,
-	0xf3, 0x21, 0xa7, 0xbe, 0x65, 0x9b, 0x74, 0x36, 0x4c, 0xfa, 0x2e, 0xb4, 0x79, 0x00, 0x86, 0xf7,
-	0xa1, 0x92, 0x8a, 0xd3, 0x05, 0x4c, 0x96, 0xe0, 0xb1, 0x12, 0x6f, 0xa0, 0x92, 0x6e, 0xa0, 0xb2,
-	0x95, 0x08, 0x6a, 0xf9, 0xf3, 0xcb, 0x6a, 0xe6, 0xcb, 0xef, 0x2a, 0xd2, 0x66, 0x62, 0xbf, 0xb4,
-	0x32, 0x7c, 0x14, 0x61, 0xf8, 0x28, 0xf2, 0x4b, 0x28, 0x8f, 0x6c, 0x01, 0x96, 0xa1, 0xe4, 0x85,
-	0x86, 0x7e, 0x4a, 0xbb, 0x3a, 0x6f, 0x9a, 0x88, 0x16, 0x84, 0xc5, 0x82, 0x56, 0xf4, 0x42, 0xe3,
-	0x2d, 0xed, 0x7e, 0x88, 0x1e, 0xad, 0xe5, 0xbf, 0x9f, 0x55, 0xd1, 0xdf, 0xb3, 0x2a, 0x92, 0x97,
-	0xa0, 0x34, 0xb4, 0x06, 0xb8, 0x02, 0x02, 0xf1, 0x3c, 0x7e, 0xb6, 0x9c, 0x16, 0x5d, 0x5e, 0x13,
-	0x1f, 0xc3, 0xf4, 0x2e, 0x61, 0x4d, 0xda, 0x48, 0xb4, 0x4f, 0xa1, 0xcc, 0x5b, 0xa1, 0x8f, 0xf6,
-	0xba, 0xc4, 0x1f, 0xef, 0xa7, 0x0d, 0x97, 0xa1, 0x34, 0xd0, 0x0d, 0xda, 0x5e, 0x4c, 0x55, 0x3b,
-	0x84, 0xc9, 0x9f, 0x11, 0x94, 0x47, 0x76, 0x03, 0xaf, 0x43, 0xc1, 0xf3, 0x69, 0xdd, 0xe2, 0x7b,
-	0x8c, 0xee, 0x6a, 0x61, 0x8e, 0xb7, 0x6f, 0x40, 0xe0, 0x2d, 0x28, 0xd9, 0x94, 0x31, 0x3e, 0x08,
-	0xda, 0x22, 0xdd, 0xbb, 0xa7, 0x10, 0x5b, 0x4c, 0x27, 0xd4, 0x56, 0x04, 0xc9, 0x3f, 0x11, 0x94,
-	0x86, 0x96, 0x0e, 0x37, 0x60, 0xbe, 0xed, 0x06, 0x54, 0xa7, 0x9d, 0x80, 0x3a, 0xd1, 0x9b, 0x98,
-	0x4e, 0x1d, 0x62, 0xb4, 0xa8, 0xde, 0xa4, 0x96, 0xd9, 0x0c, 0x92, 0xa8, 0x73, 0x37, 0xde, 0xb3,
-	0xe7, 0x04, 0x2f, 0x56, 0x8f, 0x48, 0x2b, 0xa4, 0xb5, 0xdc, 0xf9, 0x65, 0x15, 0x69, 0xb3, 0x91,
-	0xcf, 0x76, 0xdf, 0x66, 0x9b, 0xbb, 0xec, 0x72, 0x13, 0xfc, 0x1e, 0xb0, 0x67, 0x04, 0xa3, 0xd6,
-	0xd9, 0xfb, 0x5a, 0x57, 0x22, 0xf8, 0xba, 0xa1, 0x7c, 0x08, 0x30, 0xf8, 0x70, 0xf1, 0xc6, 0x7d,
-	0x0e, 0x21, 0xfc, 0x2f, 0xe1, 0x5a, 0x56, 0x44, 0xb5, 0x83, 0xaf, 0x3d, 0x09, 0x9d, 0xf7, 0x24,
-	0x74, 0xd1, 0x93, 0xd0, 0x9f, 0x9e, 0x84, 0x3e, 0x5d, 0x49, 0x99, 0x8b, 0x2b, 0x29, 0xf3, 0xeb,
-	0x4a, 0xca, 0x1c, 0xaf, 0x98, 0x56, 0xd0, 0x0c, 0x8d, 0xe8, 0x33, 0x56, 0xfb, 0x7f, 0xf9, 0xfe,
-	0x05, 0xf1, 0x2c, 0xf5, 0xc6, 0xbf, 0xdf, 0x98, 0xe4, 0x67, 0x7a, 0xf6, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0x46, 0x5b, 0x10, 0x17, 0x06, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0xcd, 0xc4, 0x69, 0x9b, 0x4e, 0x37, 0x4d, 0x3a, 0x42, 0x60, 0xb2, 0x5a, 0x27, 0x98, 0x15,
+	0x5b, 0x58, 0x29, 0xd1, 0x96, 0x85, 0x43, 0xa5, 0x95, 0x48, 0x1a, 0xd3, 0x86, 0xa5, 0x49, 0xe4,
+	0x84, 0x2e, 0xe2, 0x80, 0x35, 0x4e, 0xa6, 0x8e, 0xd5, 0xd8, 0x63, 0x79, 0xec, 0x90, 0x7c, 0x03,
+	0xb4, 0x27, 0x4e, 0x08, 0x0e, 0x2b, 0xad, 0x04, 0x87, 0xe5, 0xc4, 0x15, 0xbe, 0x00, 0xaa, 0xc4,
+	0x65, 0x8f, 0x9c, 0x16, 0xd4, 0x5e, 0xf8, 0x18, 0xc8, 0x63, 0x3b, 0xff, 0x0b, 0xe1, 0x12, 0x8d,
+	0xfd, 0x7b, 0xef, 0xcd, 0x9b, 0xdf, 0xef, 0x4d, 0x0c, 0xa5, 0x2e, 0xb5, 0x88, 0xa7, 0x9f, 0x7b,
+	0x65, 0x6f, 0xec, 0x10, 0x56, 0x1e, 0x3e, 0x28, 0x3b, 0xd8, 0xc5, 0x16, 0x2b, 0x39, 0x2e, 0xf5,
+	0x28, 0xda, 0x8b, 0xeb, 0x25, 0x5e, 0x2f, 0x0d, 0x1f, 0xe4, 0x5f, 0x33, 0xa8, 0x41, 0x79, 0xb5,
+	0x1c, 0xac, 0x42, 0x60, 0x5e, 0x32, 0x28, 0x35, 0x06, 0xa4, 0xcc, 0x9f, 0x74, 0xff, 0xbc, 0xdc,
+	0xf3, 0x5d, 0xec, 0x99, 0xd4, 0xbe, 0xa9, 0xfe, 0x95, 0x8b, 0x1d, 0x87, 0xb8, 0xd1, 0x46, 0xf2,
+	0xaf, 0x02, 0xcc, 0x1e, 0x51, 0x9b, 0x11, 0x9b, 0xf9, 0xac, 0xc5, 0x2d, 0xa0, 0x87, 0x70, 0x43,
+	0x1f, 0xd0, 0xee, 0x85, 0x08, 0x8a, 0x60, 0x7f, 0xe7, 0x40, 0x2a, 0x2d, 0x99, 0x29, 0x55, 0x83,
+	0x7a, 0x08, 0x57, 0x43, 0x30, 0x7a, 0x04, 0xd3, 0x64, 0x68, 0xf6, 0x88, 0xdd, 0x25, 0x62, 0x92,
+	0x13, 0xdf, 0x5a, 0x41, 0x54, 0x22, 0x48, 0xc4, 0x9d, 0x50, 0xd0, 0x47, 0x70, 0x7b, 0x88, 0x07,
+	0x66, 0x0f, 0x7b, 0xd4, 0x15, 0x05, 0xce, 0x97, 0x57, 0xf0, 0xcf, 0x62, 0x4c, 0x24, 0x30, 0x25,
+	0xa1, 0x43, 0xb8, 0x35, 0x24, 0x2e, 0x33, 0xa9, 0x2d, 0xa6, 0x38, 0xbf, 0xb8, 0x8a, 0x1f, 0x22,
+	0x22, 0x76, 0x4c, 0x40, 0x1f, 0xc0, 0x14, 0xd6, 0xbb, 0xa6, 0xb8, 0xc1, 0x89, 0x77, 0x56, 0x10,
+	0x2b, 0xd5, 0xa3, 0x7a, 0xc8, 0xaa, 0x26, 0x45, 0xa0, 0x72, 0x78, 0x60, 0x9a, 0x8d, 0xed, 0x6e,
+	0xdf, 0xa5, 0xf6, 0x58, 0xdc, 0xbc, 0xd1, 0x74, 0x3b, 0xc6, 0xc4, 0xa6, 0x27, 0xa4, 0xc0, 0xf4,
+	0x39, 0xc1, 0x9e, 0xef, 0x12, 0x71, 0xeb, 0x46, 0xd3, 0x1f, 0x87, 0x88, 0xd8, 0x74, 0x44, 0x90,
+	0xeb, 0x70, 0x67, 0x66, 0x0e, 0xe8, 0x36, 0xdc, 0xb6, 0xf0, 0x48, 0xd3, 0xc7, 0x1e, 0x61, 0x7c,
+	0x74, 0x82, 0x9a, 0xb6, 0xf0, 0xa8, 0x1a, 0x3c, 0xa3, 0x37, 0xe0, 0x56, 0x50, 0x34, 0x30, 0xe3,
+	0xc3, 0x11, 0xd4, 0x4d, 0x0b, 0x8f, 0x8e, 0x31, 0xfb, 0x24, 0x95, 0x16, 0x72, 0x29, 0xf9, 0x27,
+	0x00, 0x77, 0xe7, 0x47, 0x83, 0xee, 0x43, 0x14, 0x30, 0xb0, 0x41, 0x34, 0xdb, 0xb7, 0x34, 0x3e,
+	0xe4, 0x58, 0x37, 0x6b, 0xe1, 0x51, 0xc5, 0x20, 0x0d, 0xdf, 0xe2, 0x06, 0x18, 0x3a, 0x85, 0xb9,
+	0x18, 0x1c, 0x07, 0x30, 0x0a, 0xc1, 0x9b, 0xa5, 0x30, 0x81, 0xa5, 0x38, 0x81, 0xa5, 0x5a, 0x04,
+	0xa8, 0xa6, 0x2f, 0x5f, 0x15, 0x12, 0xdf, 0xfd, 0x59, 0x00, 0xea, 0x6e, 0xa8, 0x17, 0x57, 0xe6,
+	0x8f, 0x22, 0xcc, 0x1f, 0x45, 0xfe, 0x1e, 0xc0, 0xec, 0x42, 0x0c, 0x90, 0x0c, 0x33, 0x8e, 0xaf,
+	0x6b, 0x17, 0x64, 0xac, 0xf1, 0xae, 0x89, 0xa0, 0x28, 0xec, 0x6f, 0xab, 0x3b, 0x8e, 0xaf, 0x3f,
+	0x26, 0xe3, 0x4e, 0xf0, 0x0a, 0x7d, 0x0e, 0x91, 0xe3, 0x52, 0x87, 0x32, 0xe2, 0x6a, 0x8c, 0x0c,
+	0x48, 0x77, 0xc6, 0xe5, 0xbb, 0x2b, 0xba, 0xde, 0x8a, 0xc0, 0xed, 0x18, 0x7b, 0x86, 0x07, 0x3e,
+	0x51, 0xf7, 0x9c, 0xc5, 0xf7, 0x87, 0xe9, 0x5f, 0x9e, 0x17, 0xc0, 0xdf, 0xcf, 0x0b, 0x40, 0xfe,
+	0x12, 0xbe, 0xbe, 0x9a, 0x86, 0x0e, 0xe1, 0xc6, 0x30, 0x58, 0xf0, 0x0e, 0xee, 0x1e, 0xdc, 0x5d,
+	0x67, 0x43, 0x35, 0xa4, 0xcc, 0xe8, 0xdf, 0x87, 0x99, 0xb9, 0x04, 0xa3, 0x1c, 0x14, 0xb0, 0xe3,
+	0x70, 0xd1, 0x94, 0x1a, 0x2c, 0x67, 0xc0, 0x3f, 0x03, 0x78, 0xeb, 0x04, 0xb3, 0x3e, 0xe9, 0x45,
+	0xe0, 0x77, 0x60, 0x96, 0x8f, 0x51, 0x5b, 0xcc, 0x49, 0x86, 0xbf, 0x3e, 0x8d, 0xc3, 0x22, 0xc3,
+	0xcc, 0x14, 0x37, 0x8d, 0xcc, 0x4e, 0x8c, 0x3a, 0xc6, 0x0c, 0xb5, 0x57, 0x76, 0x53, 0xf8, 0x1f,
+	0x87, 0x5b, 0x6e, 0xa4, 0xfc, 0x2d, 0x80, 0xd9, 0x85, 0xcb, 0x82, 0x1e, 0xc1, 0x6d, 0xc7, 0x25,
+	0x5d, 0x93, 0x5f, 0x6c, 0xf0, 0x5f, 0x99, 0x4a, 0xf1, 0x3c, 0x4d, 0x19, 0xa8, 0x06, 0x33, 0x16,
+	0x61, 0x8c, 0x27, 0x93, 0x0c, 0xf0, 0x58, 0x4c, 0xae, 0x27, 0x71, 0x2b, 0x62, 0xd5, 0x02, 0x92,
+	0xfc, 0x1b, 0x80, 0x99, 0xb9, 0x5b, 0x88, 0x7a, 0xf0, 0xce, 0x90, 0x7a, 0x44, 0x23, 0x23, 0x8f,
+	0xd8, 0xc1, 0x4e, 0x4c, 0x23, 0x36, 0xd6, 0x07, 0x44, 0xeb, 0x13, 0xd3, 0xe8, 0x7b, 0x91, 0xd5,
+	0xdb, 0x4b, 0xfb, 0xd4, 0x6d, 0xef, 0xc3, 0x87, 0x3c, 0x13, 0xd5, 0xd4, 0xe5, 0xab, 0x02, 0x50,
+	0xf3, 0x81, 0x8e, 0x32, 0x91, 0x51, 0xb8, 0xca, 0x09, 0x17, 0x41, 0x4d, 0x88, 0x1c, 0xdd, 0x5b,
+	0x94, 0x4e, 0xae, 0x2b, 0x9d, 0x0b, 0xc8, 0xb3, 0x82, 0x72, 0x1b, 0xc2, 0xe9, 0x3f, 0x19, 0xaa,
+	0xac, 0x73, 0x08, 0xe1, 0xdf, 0x1c, 0x1e, 0x26, 0x45, 0xf0, 0xde, 0xef, 0x00, 0xee, 0x2d, 0xcd,
+	0x17, 0x75, 0xe0, 0xbd, 0x96, 0xda, 0x6c, 0x35, 0xdb, 0x8a, 0xaa, 0xb5, 0x95, 0x4f, 0x95, 0xa3,
+	0x4e, 0xbd, 0xd9, 0xd0, 0x9e, 0x28, 0xf5, 0xe3, 0x93, 0x8e, 0x52, 0xd3, 0xd4, 0xe6, 0x67, 0x8d,
+	0xe0, 0xb7, 0x5a, 0x6f, 0xe4, 0x12, 0xf9, 0x7b, 0x4f, 0x9f, 0x15, 0xdf, 0x5e, 0xd2, 0x78, 0xc2,
+	0xf7, 0x20, 0x3d, 0x95, 0xfa, 0x76, 0x4f, 0xa5, 0xba, 0x69, 0xa3, 0x33, 0xb8, 0xbf, 0x42, 0xb5,
+	0xdd, 0xa9, 0x3c, 0x56, 0x66, 0xb4, 0x2b, 0x8d, 0x5a, 0xf3, 0x34, 0x07, 0xf2, 0xfb, 0x4f, 0x9f,
+	0x15, 0xef, 0x2e, 0xc9, 0xb6, 0x3d, 0x7c, 0x41, 0x26, 0xda, 0xd8, 0xee, 0x51, 0x2b, 0x9f, 0xfe,
+	0xfa, 0x07, 0x29, 0xf1, 0xe2, 0x47, 0x09, 0x54, 0x5b, 0x2f, 0xae, 0x24, 0x70, 0x79, 0x25, 0x81,
+	0x97, 0x57, 0x12, 0xf8, 0xeb, 0x4a, 0x02, 0xdf, 0x5c, 0x4b, 0x89, 0x97, 0xd7, 0x52, 0xe2, 0x8f,
+	0x6b, 0x29, 0xf1, 0xc5, 0x81, 0x61, 0x7a, 0x7d, 0x5f, 0x0f, 0x12, 0x5e, 0x9e, 0x7c, 0xc4, 0x27,
+	0x0b, 0xec, 0x98, 0xe5, 0xa5, 0x4f, 0xbb, 0xbe, 0xc9, 0x27, 0xf4, 0xfe, 0x3f, 0x03, 0x00, 0x96,
+	0x72, 0x7a, 0x26, 0xf6, 0x07, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -783,6 +897,33 @@ func (this *ValidatorParams) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.ProposerSelection.Equal(that1.ProposerSelection) {
+		return false
+	}
+	return true
+}
+func (this *ProposerSelectionValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposerSelectionValue)
+	if !ok {
+		that2, ok := that.(ProposerSelectionValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
 	return true
 }
 func (this *VersionParams) Equal(that interface{}) bool {
@@ -834,6 +975,9 @@ func (this *HashedParams) Equal(that interface{}) bool {
 	if this.BlockMaxGas != that1.BlockMaxGas {
 		return false
 	}
+	if this.ProposerSelection != that1.ProposerSelection {
+		return false
+	}
 	return true
 }
 func (this *SynchronyParams) Equal(that interface{}) bool {
@@ -1127,6 +1271,18 @@ func (m *ValidatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposerSelection != nil {
+		{
+			size, err := m.ProposerSelection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PubKeyTypes) > 0 {
 		for iNdEx := len(m.PubKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PubKeyTypes[iNdEx])
@@ -1139,6 +1295,34 @@ func (m *ValidatorParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposerSelectionValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSelectionValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSelectionValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VersionParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1187,6 +1371,11 @@ func (m *HashedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposerSelection != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProposerSelection))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.BlockMaxGas != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BlockMaxGas))
 		i--
@@ -1221,22 +1410,22 @@ func (m *SynchronyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MessageDelay != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MessageDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MessageDelay):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MessageDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MessageDelay):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintParams(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintParams(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
 	if m.Precision != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Precision, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Precision):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Precision, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Precision):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintParams(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintParams(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1336,6 +1525,17 @@ func NewPopulatedValidatorParams(r randyParams, easy bool) *ValidatorParams {
 	for i := 0; i < v1; i++ {
 		this.PubKeyTypes[i] = string(randStringParams(r))
 	}
+	if r.Intn(5) != 0 {
+		this.ProposerSelection = NewPopulatedProposerSelectionValue(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedProposerSelectionValue(r randyParams, easy bool) *ProposerSelectionValue {
+	this := &ProposerSelectionValue{}
+	this.Value = ProposerSelection([]int32{0, 1}[r.Intn(2)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1502,6 +1702,22 @@ func (m *ValidatorParams) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.ProposerSelection != nil {
+		l = m.ProposerSelection.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposerSelectionValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovParams(uint64(m.Value))
+	}
 	return n
 }
 
@@ -1529,6 +1745,9 @@ func (m *HashedParams) Size() (n int) {
 	if m.BlockMaxGas != 0 {
 		n += 1 + sovParams(uint64(m.BlockMaxGas))
 	}
+	if m.ProposerSelection != 0 {
+		n += 1 + sovParams(uint64(m.ProposerSelection))
+	}
 	return n
 }
 
@@ -2156,6 +2375,111 @@ func (m *ValidatorParams) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKeyTypes = append(m.PubKeyTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposerSelection == nil {
+				m.ProposerSelection = &ProposerSelectionValue{}
+			}
+			if err := m.ProposerSelection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSelectionValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSelectionValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSelectionValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= ProposerSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2313,6 +2637,25 @@ func (m *HashedParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			m.ProposerSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerSelection |= ProposerSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
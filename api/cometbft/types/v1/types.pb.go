@@ -69,6 +69,9 @@ func (SignedMsgType) EnumDescriptor() ([]byte, []int) {
 type PartSetHeader struct {
 	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
 	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Number of parity parts, among the total, of an erasure-coded part set, or
+	// 0 if the part set is not erasure-coded.
+	Parity uint32 `protobuf:"varint,3,opt,name=parity,proto3" json:"parity,omitempty"`
 }
 
 func (m *PartSetHeader) Reset()         { *m = PartSetHeader{} }
@@ -118,6 +121,13 @@ func (m *PartSetHeader) GetHash() []byte {
 	return nil
 }
 
+func (m *PartSetHeader) GetParity() uint32 {
+	if m != nil {
+		return m.Parity
+	}
+	return 0
+}
+
 // Part of the block.
 type Part struct {
 	Index uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
@@ -565,6 +575,10 @@ type Commit struct {
 	Round      int32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	BlockID    BlockID     `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
 	Signatures []CommitSig `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+	// Aggregate of the signatures of all the non-absent commit signatures, which
+	// then have neither a validator address nor a signature. Only set if all the
+	// validators use BLS12-381 keys.
+	AggregatedSignature []byte `protobuf:"bytes,5,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
 }
 
 func (m *Commit) Reset()         { *m = Commit{} }
@@ -628,6 +642,13 @@ func (m *Commit) GetSignatures() []CommitSig {
 	return nil
 }
 
+func (m *Commit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
 // CommitSig is a part of the Vote included in a Commit.
 type CommitSig struct {
 	BlockIdFlag      BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=cometbft.types.v1.BlockIDFlag" json:"block_id_flag,omitempty"`
@@ -1206,90 +1227,91 @@ func init() {
 func init() { proto.RegisterFile("cometbft/types/v1/types.proto", fileDescriptor_8ea20b664d765b5f) }
 
 var fileDescriptor_8ea20b664d765b5f = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xeb, 0x7f, 0xcf, 0x76, 0xe2, 0x2c, 0x11, 0x75, 0xdc, 0xd6, 0x31, 0xe6, 0x5f,
-	0x28, 0xc8, 0x6e, 0x02, 0x08, 0xb8, 0x20, 0xd5, 0x49, 0xda, 0x46, 0x34, 0x89, 0xb5, 0x76, 0x8b,
-	0x80, 0xc3, 0x6a, 0xed, 0x9d, 0xd8, 0xab, 0xda, 0x3b, 0xab, 0xdd, 0xb1, 0x49, 0xfa, 0x09, 0x50,
-	0x4f, 0x3d, 0x72, 0xe9, 0x09, 0x0e, 0x7c, 0x81, 0x1e, 0xb8, 0x22, 0x0e, 0x3d, 0xf6, 0x06, 0xa7,
-	0x82, 0x92, 0x0b, 0x1f, 0x03, 0xcd, 0x9b, 0xd9, 0xdd, 0x38, 0xb6, 0xd5, 0x88, 0x56, 0x20, 0x71,
-	0x9b, 0x79, 0xef, 0xf7, 0xde, 0xbc, 0x7d, 0xbf, 0xdf, 0x8c, 0xde, 0xc2, 0xd5, 0x2e, 0x1d, 0x12,
-	0xd6, 0x39, 0x64, 0x75, 0x76, 0xec, 0x12, 0xbf, 0x3e, 0xde, 0x10, 0x8b, 0x9a, 0xeb, 0x51, 0x46,
-	0xb5, 0xe5, 0xc0, 0x5d, 0x13, 0xd6, 0xf1, 0x46, 0xa9, 0x1c, 0x46, 0x74, 0xbd, 0x63, 0x97, 0x51,
-	0x1e, 0xe2, 0x7a, 0x94, 0x1e, 0x8a, 0x90, 0xd2, 0x1b, 0xd3, 0x19, 0xc7, 0xe6, 0xc0, 0xb6, 0x4c,
-	0x46, 0x3d, 0x09, 0x59, 0x0b, 0x21, 0x63, 0xe2, 0xf9, 0x36, 0x75, 0xce, 0x1d, 0x5b, 0x5a, 0xe9,
-	0xd1, 0x1e, 0xc5, 0x65, 0x9d, 0xaf, 0x82, 0xb0, 0x1e, 0xa5, 0xbd, 0x01, 0xa9, 0xe3, 0xae, 0x33,
-	0x3a, 0xac, 0x33, 0x7b, 0x48, 0x7c, 0x66, 0x0e, 0x5d, 0x01, 0xa8, 0x7e, 0x06, 0xf9, 0xa6, 0xe9,
-	0xb1, 0x16, 0x61, 0xb7, 0x89, 0x69, 0x11, 0x4f, 0x5b, 0x81, 0x04, 0xa3, 0xcc, 0x1c, 0x14, 0x95,
-	0x8a, 0xb2, 0x9e, 0xd7, 0xc5, 0x46, 0xd3, 0x40, 0xed, 0x9b, 0x7e, 0xbf, 0x18, 0xab, 0x28, 0xeb,
-	0x39, 0x1d, 0xd7, 0x55, 0x1b, 0x54, 0x1e, 0xca, 0x23, 0x6c, 0xc7, 0x22, 0x47, 0x41, 0x04, 0x6e,
-	0xb8, 0xb5, 0x73, 0xcc, 0x88, 0x2f, 0x43, 0xc4, 0x46, 0xfb, 0x18, 0x12, 0xf8, 0xe1, 0xc5, 0x78,
-	0x45, 0x59, 0xcf, 0x6e, 0xae, 0xd6, 0xc2, 0x66, 0x89, 0xce, 0xd4, 0xc6, 0x1b, 0xb5, 0x26, 0x07,
-	0x34, 0xd4, 0xa7, 0xcf, 0xd7, 0x16, 0x74, 0x81, 0xae, 0x0e, 0x21, 0xd5, 0x18, 0xd0, 0xee, 0xfd,
-	0xdd, 0xed, 0xb0, 0x12, 0x25, 0xaa, 0x44, 0xdb, 0x87, 0x25, 0xd7, 0xf4, 0x98, 0xe1, 0x13, 0x66,
-	0xf4, 0xf1, 0x33, 0xf0, 0xd4, 0xec, 0x66, 0xa5, 0x36, 0x45, 0x46, 0x6d, 0xe2, 0x73, 0xe5, 0x31,
-	0x79, 0xf7, 0xac, 0xb1, 0xfa, 0x97, 0x0a, 0x49, 0xd9, 0x8e, 0xcf, 0x21, 0x25, 0x1b, 0x8e, 0x27,
-	0x66, 0x37, 0xcb, 0x51, 0x4a, 0xe9, 0xe0, 0x49, 0xb7, 0xa8, 0xe3, 0x13, 0xc7, 0x1f, 0xf9, 0x32,
-	0x61, 0x10, 0xa4, 0xbd, 0x03, 0xe9, 0x6e, 0xdf, 0xb4, 0x1d, 0xc3, 0xb6, 0xb0, 0xa6, 0x4c, 0x23,
-	0x7b, 0xf2, 0x7c, 0x2d, 0xb5, 0xc5, 0x6d, 0xbb, 0xdb, 0x7a, 0x0a, 0x9d, 0xbb, 0x96, 0xf6, 0x3a,
-	0x24, 0xfb, 0xc4, 0xee, 0xf5, 0x19, 0x76, 0x26, 0xae, 0xcb, 0x9d, 0xf6, 0x29, 0xa8, 0x9c, 0xb2,
-	0xa2, 0x8a, 0x87, 0x97, 0x6a, 0x82, 0xcf, 0x5a, 0xc0, 0x67, 0xad, 0x1d, 0xf0, 0xd9, 0x48, 0xf3,
-	0x83, 0x1f, 0xfd, 0xb1, 0xa6, 0xe8, 0x18, 0xa1, 0x6d, 0x43, 0x7e, 0x60, 0xfa, 0xcc, 0xe8, 0xf0,
-	0xc6, 0xf1, 0xe3, 0x13, 0x32, 0xc5, 0x74, 0x4b, 0x64, 0x6f, 0x65, 0xed, 0x59, 0x1e, 0x26, 0x4c,
-	0x96, 0xb6, 0x0e, 0x05, 0xcc, 0xd2, 0xa5, 0xc3, 0xa1, 0xcd, 0x0c, 0x6c, 0x7d, 0x12, 0x5b, 0xbf,
-	0xc8, 0xed, 0x5b, 0x68, 0xbe, 0xcd, 0x49, 0xb8, 0x0c, 0x19, 0xcb, 0x64, 0xa6, 0x80, 0xa4, 0x10,
-	0x92, 0xe6, 0x06, 0x74, 0xbe, 0x0b, 0x4b, 0xa1, 0xa2, 0x7d, 0x01, 0x49, 0x8b, 0x2c, 0x91, 0x19,
-	0x81, 0xd7, 0x61, 0xc5, 0x21, 0x47, 0xcc, 0x38, 0x8f, 0xce, 0x20, 0x5a, 0xe3, 0xbe, 0x7b, 0x93,
-	0x11, 0x6f, 0xc3, 0x62, 0x37, 0xe8, 0xbe, 0xc0, 0x02, 0x62, 0xf3, 0xa1, 0x15, 0x61, 0xab, 0x90,
-	0x36, 0x5d, 0x57, 0x00, 0xb2, 0x08, 0x48, 0x99, 0xae, 0x8b, 0xae, 0x6b, 0xb0, 0x8c, 0xdf, 0xe8,
-	0x11, 0x7f, 0x34, 0x60, 0x32, 0x49, 0x0e, 0x31, 0x4b, 0xdc, 0xa1, 0x0b, 0x3b, 0x62, 0xdf, 0x84,
-	0x3c, 0x19, 0xdb, 0x16, 0x71, 0xba, 0x44, 0xe0, 0xf2, 0x88, 0xcb, 0x05, 0x46, 0x04, 0xbd, 0x07,
-	0x05, 0xd7, 0xa3, 0x2e, 0xf5, 0x89, 0x67, 0x98, 0x96, 0xe5, 0x11, 0xdf, 0x2f, 0x2e, 0x8a, 0x7c,
-	0x81, 0xfd, 0x86, 0x30, 0x57, 0x8b, 0xa0, 0x6e, 0x9b, 0xcc, 0xd4, 0x0a, 0x10, 0x67, 0x47, 0x7e,
-	0x51, 0xa9, 0xc4, 0xd7, 0x73, 0x3a, 0x5f, 0x56, 0x7f, 0x8e, 0x83, 0x7a, 0x8f, 0x32, 0xa2, 0x7d,
-	0x04, 0x2a, 0x67, 0x0a, 0xf5, 0xb7, 0x38, 0x53, 0xd2, 0x2d, 0xbb, 0xe7, 0x10, 0x6b, 0xcf, 0xef,
-	0xb5, 0x8f, 0x5d, 0xa2, 0x23, 0xfa, 0x8c, 0xa0, 0x62, 0x13, 0x82, 0x5a, 0x81, 0x84, 0x47, 0x47,
-	0x8e, 0x85, 0x3a, 0x4b, 0xe8, 0x62, 0xa3, 0xdd, 0x84, 0x74, 0xa8, 0x13, 0xf5, 0x85, 0x3a, 0x59,
-	0xe2, 0x3a, 0xe1, 0x32, 0x96, 0x06, 0x3d, 0xd5, 0x91, 0x72, 0x69, 0x40, 0x26, 0x7c, 0x61, 0x42,
-	0xc1, 0x5d, 0x44, 0xb3, 0x51, 0x98, 0xf6, 0x3e, 0x2c, 0x87, 0xec, 0x87, 0xed, 0x13, 0x9a, 0x2b,
-	0x84, 0x0e, 0xd9, 0xbf, 0x09, 0x61, 0x19, 0xe2, 0x19, 0x4a, 0xe1, 0x87, 0x45, 0xc2, 0xda, 0xc5,
-	0xf7, 0xe8, 0x0a, 0x64, 0x7c, 0xbb, 0xe7, 0x98, 0x6c, 0xe4, 0x11, 0xa9, 0xbd, 0xc8, 0xc0, 0xbd,
-	0xe4, 0x88, 0x11, 0x07, 0x2f, 0xba, 0xd0, 0x5a, 0x64, 0xd0, 0xea, 0xf0, 0x5a, 0xb8, 0x31, 0xa2,
-	0x2c, 0x42, 0x67, 0x5a, 0xe8, 0x6a, 0x05, 0x9e, 0xea, 0x2f, 0x0a, 0x24, 0xc5, 0xd5, 0x38, 0xc3,
-	0x83, 0x32, 0x9b, 0x87, 0xd8, 0x3c, 0x1e, 0xe2, 0x2f, 0xc5, 0x03, 0x84, 0x75, 0xfa, 0x45, 0xb5,
-	0x12, 0x5f, 0xcf, 0x6e, 0x5e, 0x99, 0x91, 0x49, 0x14, 0xd9, 0xb2, 0x7b, 0xf2, 0xee, 0x9f, 0x89,
-	0xaa, 0x3e, 0x57, 0x20, 0x13, 0xfa, 0xb5, 0x06, 0xe4, 0x83, 0xca, 0x8c, 0xc3, 0x81, 0xd9, 0x93,
-	0x72, 0x2c, 0xcf, 0x2f, 0xef, 0xe6, 0xc0, 0xec, 0xe9, 0x59, 0x59, 0x11, 0xdf, 0xcc, 0x66, 0x36,
-	0x36, 0x87, 0xd9, 0x09, 0x29, 0xc5, 0xff, 0x99, 0x94, 0x26, 0x48, 0x57, 0xcf, 0x91, 0x5e, 0x3d,
-	0x55, 0x60, 0x71, 0x87, 0x93, 0x67, 0x11, 0xeb, 0x3f, 0x65, 0xeb, 0x1b, 0xa9, 0x2f, 0x8b, 0x58,
-	0xc6, 0x14, 0x6d, 0x6f, 0xcd, 0x48, 0x39, 0x59, 0x75, 0x44, 0x9f, 0x16, 0xa4, 0x69, 0x45, 0x34,
-	0x3e, 0x89, 0xc1, 0xf2, 0x14, 0xfe, 0x7f, 0x48, 0xe7, 0xe4, 0x1d, 0x4e, 0x5c, 0xf0, 0x0e, 0x27,
-	0xe7, 0xde, 0xe1, 0x27, 0x31, 0x48, 0x37, 0xf1, 0xb5, 0x36, 0x07, 0xff, 0xca, 0x1b, 0x7c, 0x19,
-	0x32, 0x2e, 0x1d, 0x18, 0xc2, 0xa3, 0xa2, 0x27, 0xed, 0xd2, 0x81, 0x3e, 0x25, 0xb5, 0xc4, 0xab,
-	0x7a, 0xa0, 0x93, 0xaf, 0x80, 0x86, 0xd4, 0xf9, 0x5b, 0xc5, 0x20, 0x27, 0x7a, 0x21, 0x27, 0xa8,
-	0x0d, 0xde, 0x04, 0x9c, 0xc9, 0x94, 0xf3, 0x33, 0x5f, 0x58, 0xb7, 0x80, 0xea, 0x12, 0xc8, 0x43,
-	0xc4, 0xbc, 0x21, 0xc7, 0xb8, 0xd5, 0xb9, 0x2f, 0x97, 0x2e, 0x81, 0xd5, 0xef, 0x15, 0x80, 0x3b,
-	0xbc, 0xb9, 0xf8, 0xc5, 0x7c, 0xf8, 0xf1, 0xb1, 0x08, 0x63, 0xe2, 0xec, 0xb5, 0xb9, 0xc4, 0xc9,
-	0x0a, 0x72, 0xfe, 0xd9, 0xd2, 0xb7, 0x21, 0x1f, 0x09, 0xdc, 0x27, 0x41, 0x39, 0xb3, 0xb2, 0x84,
-	0x43, 0x49, 0x8b, 0x30, 0x3d, 0x37, 0x3e, 0xb3, 0xab, 0xfe, 0xaa, 0x40, 0x06, 0xab, 0xda, 0x23,
-	0xcc, 0x9c, 0x20, 0x52, 0x79, 0x09, 0x22, 0xaf, 0x02, 0x88, 0x3c, 0xbe, 0xfd, 0x80, 0x48, 0x7d,
-	0x65, 0xd0, 0xd2, 0xb2, 0x1f, 0x10, 0xed, 0x93, 0xb0, 0xeb, 0xf1, 0x17, 0x74, 0x5d, 0x3e, 0x1d,
-	0x41, 0xef, 0x2f, 0x41, 0xca, 0x19, 0x0d, 0x0d, 0x3e, 0x8c, 0xa8, 0x42, 0xb4, 0xce, 0x68, 0xd8,
-	0x3e, 0xf2, 0xab, 0xf7, 0x21, 0xd5, 0x3e, 0xc2, 0xd9, 0x9c, 0x2b, 0xd5, 0xa3, 0x54, 0x4e, 0x83,
-	0x62, 0x10, 0x4f, 0x73, 0x03, 0x0e, 0x3f, 0x1a, 0xa8, 0x7c, 0xec, 0x0b, 0x7e, 0x15, 0xf8, 0x5a,
-	0xab, 0x5f, 0x74, 0xec, 0x97, 0x03, 0xff, 0xb5, 0xdf, 0x14, 0xc8, 0x4f, 0xdc, 0x28, 0xed, 0x03,
-	0xb8, 0xd4, 0xda, 0xbd, 0xb5, 0xbf, 0xb3, 0x6d, 0xec, 0xb5, 0x6e, 0x19, 0xed, 0xaf, 0x9a, 0x3b,
-	0xc6, 0xdd, 0xfd, 0x2f, 0xf6, 0x0f, 0xbe, 0xdc, 0x2f, 0x2c, 0x94, 0x96, 0x1e, 0x3e, 0xae, 0x64,
-	0xef, 0x3a, 0xf7, 0x1d, 0xfa, 0xad, 0x33, 0x0f, 0xdd, 0xd4, 0x77, 0xee, 0x1d, 0xb4, 0x77, 0x0a,
-	0x8a, 0x40, 0x37, 0x3d, 0x32, 0xa6, 0x8c, 0x20, 0xfa, 0x3a, 0xac, 0xce, 0x40, 0x6f, 0x1d, 0xec,
-	0xed, 0xed, 0xb6, 0x0b, 0xb1, 0xd2, 0xf2, 0xc3, 0xc7, 0x95, 0x7c, 0xd3, 0x23, 0x42, 0x6a, 0x18,
-	0x51, 0x83, 0xe2, 0x74, 0xc4, 0x41, 0xf3, 0xa0, 0x75, 0xe3, 0x4e, 0xa1, 0x52, 0x2a, 0x3c, 0x7c,
-	0x5c, 0xc9, 0x05, 0x6f, 0x07, 0xc7, 0x97, 0xd2, 0xdf, 0xfd, 0x50, 0x5e, 0xf8, 0xe9, 0xc7, 0xb2,
-	0xd2, 0xb8, 0xf3, 0xf4, 0xa4, 0xac, 0x3c, 0x3b, 0x29, 0x2b, 0x7f, 0x9e, 0x94, 0x95, 0x47, 0xa7,
-	0xe5, 0x85, 0x67, 0xa7, 0xe5, 0x85, 0xdf, 0x4f, 0xcb, 0x0b, 0x5f, 0x6f, 0xf6, 0x6c, 0xd6, 0x1f,
-	0x75, 0x78, 0x6f, 0xea, 0xd1, 0x0f, 0x63, 0xb0, 0x30, 0x5d, 0xbb, 0x3e, 0xf5, 0x9b, 0xd8, 0x49,
-	0xe2, 0x9d, 0xfd, 0xf0, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x98, 0x74, 0x84, 0x94, 0x0e,
-	0x00, 0x00,
+	// 1343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x73, 0x1b, 0x45,
+	0x13, 0xf6, 0x4a, 0xab, 0xaf, 0x96, 0x64, 0xcb, 0x1b, 0xd7, 0x1b, 0x45, 0x49, 0x64, 0xbd, 0x7a,
+	0x5f, 0xc0, 0x04, 0x4a, 0x8a, 0x0d, 0x14, 0x9c, 0xa8, 0x8a, 0x6c, 0x27, 0x71, 0x11, 0xdb, 0x62,
+	0xa5, 0x84, 0x02, 0x0e, 0x5b, 0x23, 0xed, 0x78, 0xb5, 0x15, 0x69, 0x67, 0x6b, 0x67, 0x24, 0xec,
+	0xfc, 0x02, 0x2a, 0xa7, 0x1c, 0xb9, 0xe4, 0x04, 0x07, 0xfe, 0x40, 0x0e, 0xdc, 0x39, 0xe4, 0x98,
+	0x1b, 0x9c, 0x02, 0x65, 0x5f, 0xf8, 0x03, 0xdc, 0xa9, 0xf9, 0xd8, 0x95, 0x64, 0x49, 0x95, 0x40,
+	0x52, 0x50, 0xc5, 0x6d, 0xa6, 0xfb, 0xe9, 0x9e, 0x9e, 0x7e, 0x9e, 0x99, 0x9a, 0x81, 0xab, 0x5d,
+	0x32, 0xc0, 0xac, 0x73, 0xc4, 0xea, 0xec, 0xc4, 0xc7, 0xb4, 0x3e, 0xda, 0x94, 0x83, 0x9a, 0x1f,
+	0x10, 0x46, 0x8c, 0xd5, 0xd0, 0x5d, 0x93, 0xd6, 0xd1, 0x66, 0xa9, 0x1c, 0x45, 0x74, 0x83, 0x13,
+	0x9f, 0x11, 0x1e, 0xe2, 0x07, 0x84, 0x1c, 0xc9, 0x90, 0xd2, 0x7f, 0x67, 0x33, 0x8e, 0x50, 0xdf,
+	0xb5, 0x11, 0x23, 0x81, 0x82, 0xac, 0x47, 0x90, 0x11, 0x0e, 0xa8, 0x4b, 0xbc, 0x73, 0xcb, 0x96,
+	0xd6, 0x1c, 0xe2, 0x10, 0x31, 0xac, 0xf3, 0x51, 0x18, 0xe6, 0x10, 0xe2, 0xf4, 0x71, 0x5d, 0xcc,
+	0x3a, 0xc3, 0xa3, 0x3a, 0x73, 0x07, 0x98, 0x32, 0x34, 0xf0, 0x25, 0xa0, 0xfa, 0x29, 0xe4, 0x9b,
+	0x28, 0x60, 0x2d, 0xcc, 0x6e, 0x63, 0x64, 0xe3, 0xc0, 0x58, 0x83, 0x04, 0x23, 0x0c, 0xf5, 0x8b,
+	0x5a, 0x45, 0xdb, 0xc8, 0x9b, 0x72, 0x62, 0x18, 0xa0, 0xf7, 0x10, 0xed, 0x15, 0x63, 0x15, 0x6d,
+	0x23, 0x67, 0x8a, 0xb1, 0xf1, 0x1f, 0x48, 0xfa, 0x28, 0x70, 0xd9, 0x49, 0x31, 0x2e, 0xa0, 0x6a,
+	0x56, 0x75, 0x41, 0xe7, 0x29, 0x79, 0x26, 0xd7, 0xb3, 0xf1, 0x71, 0x98, 0x49, 0x4c, 0xb8, 0xb5,
+	0x73, 0xc2, 0x30, 0x55, 0xa9, 0xe4, 0xc4, 0xf8, 0x00, 0x12, 0xa2, 0x21, 0x22, 0x55, 0x76, 0xeb,
+	0x52, 0x2d, 0x6a, 0xa2, 0xec, 0x58, 0x6d, 0xb4, 0x59, 0x6b, 0x72, 0x40, 0x43, 0x7f, 0xfa, 0x7c,
+	0x7d, 0xc9, 0x94, 0xe8, 0xea, 0x00, 0x52, 0x8d, 0x3e, 0xe9, 0xde, 0xdf, 0xdb, 0x89, 0x2a, 0xd4,
+	0x26, 0x2a, 0x3c, 0x80, 0x15, 0x1f, 0x05, 0xcc, 0xa2, 0x98, 0x59, 0x3d, 0xb1, 0x3d, 0xb1, 0x6a,
+	0x76, 0xab, 0x52, 0x9b, 0x21, 0xa9, 0x36, 0xd5, 0x06, 0xb5, 0x4c, 0xde, 0x9f, 0x34, 0x56, 0x7f,
+	0xd3, 0x21, 0xa9, 0xda, 0xf4, 0x31, 0xa4, 0x14, 0x11, 0x62, 0xc5, 0xec, 0x56, 0x79, 0x9c, 0x52,
+	0x39, 0x78, 0xd2, 0x6d, 0xe2, 0x51, 0xec, 0xd1, 0x21, 0x55, 0x09, 0xc3, 0x20, 0xe3, 0x4d, 0x48,
+	0x77, 0x7b, 0xc8, 0xf5, 0x2c, 0xd7, 0x16, 0x35, 0x65, 0x1a, 0xd9, 0xd3, 0xe7, 0xeb, 0xa9, 0x6d,
+	0x6e, 0xdb, 0xdb, 0x31, 0x53, 0xc2, 0xb9, 0x67, 0xf3, 0x26, 0xf7, 0xb0, 0xeb, 0xf4, 0x98, 0xe8,
+	0x4c, 0xdc, 0x54, 0x33, 0xe3, 0x23, 0xd0, 0x39, 0x95, 0x45, 0x5d, 0x2c, 0x5e, 0xaa, 0x49, 0x9e,
+	0x6b, 0x21, 0xcf, 0xb5, 0x76, 0xc8, 0x73, 0x23, 0xcd, 0x17, 0x7e, 0xf4, 0xcb, 0xba, 0x66, 0x8a,
+	0x08, 0x63, 0x07, 0xf2, 0x7d, 0x44, 0x99, 0xd5, 0xe1, 0x8d, 0xe3, 0xcb, 0x27, 0x54, 0x8a, 0xd9,
+	0x96, 0xa8, 0xde, 0xaa, 0xda, 0xb3, 0x3c, 0x4c, 0x9a, 0x6c, 0x63, 0x03, 0x0a, 0x22, 0x4b, 0x97,
+	0x0c, 0x06, 0x2e, 0xb3, 0x44, 0xeb, 0x93, 0xa2, 0xf5, 0xcb, 0xdc, 0xbe, 0x2d, 0xcc, 0xb7, 0x39,
+	0x09, 0x97, 0x21, 0x63, 0x23, 0x86, 0x24, 0x24, 0x25, 0x20, 0x69, 0x6e, 0x10, 0xce, 0xb7, 0x60,
+	0x25, 0x52, 0x3a, 0x95, 0x90, 0xb4, 0xcc, 0x32, 0x36, 0x0b, 0xe0, 0x75, 0x58, 0xf3, 0xf0, 0x31,
+	0xb3, 0xce, 0xa3, 0x33, 0x02, 0x6d, 0x70, 0xdf, 0xbd, 0xe9, 0x88, 0x37, 0x60, 0xb9, 0x1b, 0x76,
+	0x5f, 0x62, 0x41, 0x60, 0xf3, 0x91, 0x55, 0xc0, 0x2e, 0x41, 0x1a, 0xf9, 0xbe, 0x04, 0x64, 0x05,
+	0x20, 0x85, 0x7c, 0x5f, 0xb8, 0xae, 0xc1, 0xaa, 0xd8, 0x63, 0x80, 0xe9, 0xb0, 0xcf, 0x54, 0x92,
+	0x9c, 0xc0, 0xac, 0x70, 0x87, 0x29, 0xed, 0x02, 0xfb, 0x3f, 0xc8, 0xe3, 0x91, 0x6b, 0x63, 0xaf,
+	0x8b, 0x25, 0x2e, 0x2f, 0x70, 0xb9, 0xd0, 0x28, 0x40, 0x6f, 0x43, 0xc1, 0x0f, 0x88, 0x4f, 0x28,
+	0x0e, 0x2c, 0x64, 0xdb, 0x01, 0xa6, 0xb4, 0xb8, 0x2c, 0xf3, 0x85, 0xf6, 0x1b, 0xd2, 0x5c, 0x2d,
+	0x82, 0xbe, 0x83, 0x18, 0x32, 0x0a, 0x10, 0x67, 0xc7, 0xb4, 0xa8, 0x55, 0xe2, 0x1b, 0x39, 0x93,
+	0x0f, 0xab, 0x3f, 0xc4, 0x41, 0xbf, 0x47, 0x18, 0x36, 0xde, 0x07, 0x9d, 0x33, 0x25, 0xf4, 0xb7,
+	0x3c, 0x57, 0xd2, 0x2d, 0xd7, 0xf1, 0xb0, 0xbd, 0x4f, 0x9d, 0xf6, 0x89, 0x8f, 0x4d, 0x81, 0x9e,
+	0x10, 0x54, 0x6c, 0x4a, 0x50, 0x6b, 0x90, 0x08, 0xc8, 0xd0, 0xb3, 0x85, 0xce, 0x12, 0xa6, 0x9c,
+	0x18, 0x37, 0x21, 0x1d, 0xe9, 0x44, 0x7f, 0xa1, 0x4e, 0x56, 0xb8, 0x4e, 0xb8, 0x8c, 0x95, 0xc1,
+	0x4c, 0x75, 0x94, 0x5c, 0x1a, 0x90, 0x89, 0x6e, 0x9e, 0x62, 0xe2, 0x4f, 0x68, 0x76, 0x1c, 0x66,
+	0xbc, 0x03, 0xab, 0x11, 0xfb, 0x51, 0xfb, 0xa4, 0xe6, 0x0a, 0x91, 0x43, 0xf5, 0x6f, 0x4a, 0x58,
+	0x96, 0xbc, 0x86, 0x52, 0x62, 0x63, 0x63, 0x61, 0xed, 0x71, 0xab, 0x71, 0x05, 0x32, 0xd4, 0x75,
+	0x3c, 0xc4, 0x86, 0x01, 0x56, 0xda, 0x1b, 0x1b, 0xb8, 0x17, 0x1f, 0x33, 0xec, 0x89, 0x83, 0x2e,
+	0xb5, 0x36, 0x36, 0x18, 0x75, 0xb8, 0x10, 0x4d, 0xac, 0x71, 0x16, 0xa9, 0x33, 0x23, 0x72, 0xb5,
+	0x42, 0x4f, 0xf5, 0x77, 0x0d, 0x92, 0xf2, 0x68, 0x4c, 0xf0, 0xa0, 0xcd, 0xe7, 0x21, 0xb6, 0x88,
+	0x87, 0xf8, 0x2b, 0xf1, 0x00, 0x51, 0x9d, 0xb4, 0xa8, 0x57, 0xe2, 0x1b, 0xd9, 0xad, 0x2b, 0x73,
+	0x32, 0xc9, 0x22, 0x5b, 0xae, 0xa3, 0xce, 0xfe, 0x44, 0x94, 0xb1, 0x09, 0x6b, 0xc8, 0x71, 0x02,
+	0xec, 0x20, 0x86, 0xed, 0x89, 0x6d, 0x27, 0xc4, 0xb6, 0x2f, 0x8c, 0x7d, 0xe3, 0x7d, 0x3f, 0xd7,
+	0x20, 0x13, 0xa5, 0x34, 0x1a, 0x90, 0x0f, 0x37, 0x63, 0x1d, 0xf5, 0x91, 0xa3, 0x14, 0x5c, 0x5e,
+	0xbc, 0xa3, 0x9b, 0x7d, 0xe4, 0x98, 0x59, 0xb5, 0x09, 0x3e, 0x99, 0x2f, 0x86, 0xd8, 0x02, 0x31,
+	0x4c, 0xa9, 0x2f, 0xfe, 0xd7, 0xd4, 0x37, 0xa5, 0x13, 0xfd, 0x9c, 0x4e, 0xaa, 0x67, 0x1a, 0x2c,
+	0xef, 0x72, 0xbe, 0x6d, 0x6c, 0xff, 0xa3, 0x04, 0x7f, 0xa9, 0x24, 0x69, 0x4f, 0x52, 0x13, 0x32,
+	0xfd, 0xff, 0x39, 0x29, 0xa7, 0xab, 0x1e, 0x33, 0x6e, 0x84, 0x69, 0x22, 0x16, 0x69, 0xf5, 0x49,
+	0x0c, 0x56, 0x67, 0xf0, 0xff, 0x42, 0x3a, 0xa7, 0x8f, 0x7d, 0xe2, 0x25, 0x8f, 0x7d, 0x72, 0xe1,
+	0xb1, 0x7f, 0x12, 0x83, 0x74, 0x53, 0x5c, 0xf0, 0xa8, 0xff, 0xb7, 0x5c, 0xdb, 0x97, 0x21, 0xe3,
+	0x93, 0xbe, 0x25, 0x3d, 0xba, 0xf0, 0xa4, 0x7d, 0xd2, 0x37, 0x67, 0xa4, 0x96, 0x78, 0x5d, 0x77,
+	0x7a, 0xf2, 0x35, 0xd0, 0x90, 0x3a, 0x7f, 0xaa, 0x18, 0xe4, 0x64, 0x2f, 0xd4, 0xa3, 0x6b, 0x93,
+	0x37, 0x81, 0x8f, 0x8a, 0xda, 0xf9, 0x67, 0x62, 0x54, 0xb7, 0x84, 0x9a, 0xc9, 0x5e, 0x14, 0x22,
+	0x9f, 0x28, 0xc5, 0xd8, 0xc2, 0x10, 0x29, 0x65, 0x53, 0x01, 0xab, 0xdf, 0x68, 0x00, 0x77, 0x78,
+	0x73, 0xc5, 0x8e, 0xf9, 0x7b, 0x89, 0x8a, 0x22, 0xac, 0xa9, 0xb5, 0xd7, 0x17, 0x12, 0xa7, 0x2a,
+	0xc8, 0xd1, 0xc9, 0xd2, 0x77, 0x20, 0x3f, 0x16, 0x38, 0xc5, 0x61, 0x39, 0xf3, 0xb2, 0x44, 0xef,
+	0x98, 0x16, 0x66, 0x66, 0x6e, 0x34, 0x31, 0xab, 0xfe, 0xa8, 0x41, 0x46, 0x54, 0xb5, 0x8f, 0x19,
+	0x9a, 0x22, 0x52, 0x7b, 0x05, 0x22, 0xaf, 0x02, 0xc8, 0x3c, 0xd4, 0x7d, 0x80, 0x95, 0xbe, 0x32,
+	0xc2, 0xd2, 0x72, 0x1f, 0x60, 0xe3, 0xc3, 0xa8, 0xeb, 0xf1, 0x17, 0x74, 0x5d, 0x5d, 0x1d, 0x61,
+	0xef, 0x2f, 0x42, 0xca, 0x1b, 0x0e, 0x2c, 0xfe, 0x7e, 0xd1, 0xa5, 0x68, 0xbd, 0xe1, 0xa0, 0x7d,
+	0x4c, 0xab, 0xf7, 0x21, 0xd5, 0x3e, 0x16, 0xcf, 0x79, 0xae, 0xd4, 0x80, 0x10, 0xf5, 0x80, 0x94,
+	0x6f, 0xf7, 0x34, 0x37, 0x88, 0xf7, 0x92, 0x01, 0x3a, 0x7f, 0x29, 0x86, 0xbf, 0x0e, 0x3e, 0x36,
+	0xea, 0x2f, 0xfb, 0x53, 0x50, 0x7f, 0x84, 0x6b, 0x3f, 0x69, 0x90, 0x9f, 0x3a, 0x51, 0xc6, 0xbb,
+	0x70, 0xb1, 0xb5, 0x77, 0xeb, 0x60, 0x77, 0xc7, 0xda, 0x6f, 0xdd, 0xb2, 0xda, 0x9f, 0x37, 0x77,
+	0xad, 0xbb, 0x07, 0x9f, 0x1c, 0x1c, 0x7e, 0x76, 0x50, 0x58, 0x2a, 0xad, 0x3c, 0x7c, 0x5c, 0xc9,
+	0xde, 0xf5, 0xee, 0x7b, 0xe4, 0x2b, 0x6f, 0x11, 0xba, 0x69, 0xee, 0xde, 0x3b, 0x6c, 0xef, 0x16,
+	0x34, 0x89, 0x6e, 0x06, 0x78, 0x44, 0x18, 0x16, 0xe8, 0xeb, 0x70, 0x69, 0x0e, 0x7a, 0xfb, 0x70,
+	0x7f, 0x7f, 0xaf, 0x5d, 0x88, 0x95, 0x56, 0x1f, 0x3e, 0xae, 0xe4, 0x9b, 0x01, 0x96, 0x52, 0x13,
+	0x11, 0x35, 0x28, 0xce, 0x46, 0x1c, 0x36, 0x0f, 0x5b, 0x37, 0xee, 0x14, 0x2a, 0xa5, 0xc2, 0xc3,
+	0xc7, 0x95, 0x5c, 0x78, 0x77, 0x70, 0x7c, 0x29, 0xfd, 0xf5, 0xb7, 0xe5, 0xa5, 0xef, 0xbf, 0x2b,
+	0x6b, 0x8d, 0x3b, 0x4f, 0x4f, 0xcb, 0xda, 0xb3, 0xd3, 0xb2, 0xf6, 0xeb, 0x69, 0x59, 0x7b, 0x74,
+	0x56, 0x5e, 0x7a, 0x76, 0x56, 0x5e, 0xfa, 0xf9, 0xac, 0xbc, 0xf4, 0xc5, 0x96, 0xe3, 0xb2, 0xde,
+	0xb0, 0xc3, 0x7b, 0x53, 0x1f, 0xff, 0x3d, 0xc3, 0x01, 0xf2, 0xdd, 0xfa, 0xcc, 0x8f, 0xb3, 0x93,
+	0x14, 0x67, 0xf6, 0xbd, 0x3f, 0x06, 0x00, 0x3c, 0x6a, 0x46, 0xe4, 0xdf, 0x0e, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1312,6 +1334,11 @@ func (m *PartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Parity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Parity))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -1679,6 +1706,13 @@ func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2175,6 +2209,9 @@ func (m *PartSetHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Parity != 0 {
+		n += 1 + sovTypes(uint64(m.Parity))
+	}
 	return n
 }
 
@@ -2345,6 +2382,10 @@ func (m *Commit) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2614,6 +2655,25 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			m.Parity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3938,6 +3998,40 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,242 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	cfg "github.com/cometbft/cometbft/config"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
+)
+
+// name of the address book database in the data directory.
+const addrBookDBName = "addrbook.db"
+
+var (
+	pruneNotSeenFor     time.Duration
+	pruneFailedAttempts int
+	pruneNeverConnected bool
+)
+
+func init() {
+	addrBookPruneCmd.Flags().DurationVar(&pruneNotSeenFor, "not-seen-for", 0,
+		"prune the addresses not gossiped nor connected to for this long (e.g. 720h)")
+	addrBookPruneCmd.Flags().IntVar(&pruneFailedAttempts, "failed-attempts", 0,
+		"prune the addresses whose dials failed at least this many times since the last connection")
+	addrBookPruneCmd.Flags().BoolVar(&pruneNeverConnected, "never-connected", false,
+		"prune the addresses that were never connected to")
+
+	AddrBookCmd.AddCommand(addrBookInspectCmd)
+	AddrBookCmd.AddCommand(addrBookExportCmd)
+	AddrBookCmd.AddCommand(addrBookPruneCmd)
+	AddrBookCmd.AddCommand(addrBookImportCmd)
+}
+
+// AddrBookCmd groups the commands managing the address book database.
+var AddrBookCmd = &cobra.Command{
+	Use:   "addrbook",
+	Short: "Inspect, export, prune and import the entries of the address book database",
+	Long: `
+Manage the address book stored in the "addrbook" database of the node, which is
+used when p2p.addr_book_backend is "db". Every entry records the history of an
+address: when it was first and last seen, the peers that gossiped it, the
+connections made with the peer, their uptime, and the latency of the dials.
+
+The node must be stopped.
+`,
+}
+
+var addrBookInspectCmd = &cobra.Command{
+	Use:   "inspect [node-id]",
+	Short: "Print the entries of the address book, or the whole entry of a peer",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return withAddrBook(config, false, func(book *pex.DBAddrBook) error {
+			if len(args) == 1 {
+				entry, ok := book.Entry(p2p.ID(args[0]))
+				if !ok {
+					return fmt.Errorf("peer %s is not in the address book", args[0])
+				}
+				bz, err := json.MarshalIndent(entry, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(bz))
+				return nil
+			}
+			printAddrBookEntries(book.Entries())
+			return nil
+		})
+	},
+}
+
+var addrBookExportCmd = &cobra.Command{
+	Use:   "export [output-file]",
+	Short: "Export the entries of the address book to a JSON file",
+	Long: `
+Export the entries of the address book, with their history, to a JSON file,
+which can be imported into the address book of another node with
+"cometbft addrbook import".
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return withAddrBook(config, false, func(book *pex.DBAddrBook) error {
+			entries := book.Entries()
+			bz, err := json.MarshalIndent(entries, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(args[0], bz, 0o644); err != nil {
+				return err
+			}
+			fmt.Printf("Exported %d entries to %s\n", len(entries), args[0])
+			return nil
+		})
+	},
+}
+
+var addrBookPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove the entries of the address book matching all the given criteria",
+	Example: `
+	cometbft addrbook prune --not-seen-for 720h
+	cometbft addrbook prune --never-connected --failed-attempts 3
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		if pruneNotSeenFor <= 0 && pruneFailedAttempts <= 0 && !pruneNeverConnected {
+			return errors.New("at least one of --not-seen-for, --failed-attempts or --never-connected is required")
+		}
+		return withAddrBook(config, false, func(book *pex.DBAddrBook) error {
+			pruned, err := book.Prune(addrBookPruneFilter(time.Now(), pruneNotSeenFor, pruneFailedAttempts, pruneNeverConnected))
+			if err != nil {
+				return fmt.Errorf("failed to prune the address book: %w", err)
+			}
+			fmt.Printf("Pruned %d entries\n", pruned)
+			return nil
+		})
+	},
+}
+
+var addrBookImportCmd = &cobra.Command{
+	Use:   "import [input-file]",
+	Short: "Import entries into the address book",
+	Long: `
+Import the entries of a file exported with "cometbft addrbook export", or of
+the address book file of a node whose p2p.addr_book_backend is "file", into the
+address book. The peers which are already in the address book are skipped.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		entries, err := readAddrBookEntries(args[0])
+		if err != nil {
+			return err
+		}
+		return withAddrBook(config, true, func(book *pex.DBAddrBook) error {
+			imported, err := book.Import(entries)
+			if err != nil {
+				return fmt.Errorf("failed to import into the address book: %w", err)
+			}
+			fmt.Printf("Imported %d of %d entries\n", imported, len(entries))
+			return nil
+		})
+	},
+}
+
+// withAddrBook runs fn with the address book database of the node, which is
+// created if create is set.
+func withAddrBook(config *cfg.Config, create bool, fn func(book *pex.DBAddrBook) error) error {
+	if !create && !cmtos.FileExists(filepath.Join(config.DBDir(), addrBookDBName)) {
+		return fmt.Errorf("no address book database found in %v", config.DBDir())
+	}
+	db, err := dbm.NewDB("addrbook", dbm.BackendType(config.DBBackend), config.DBDir())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	book := pex.NewDBAddrBook(db, config.P2P.AddrBookStrict)
+	if err := book.Start(); err != nil {
+		return err
+	}
+	defer book.Stop() //nolint:errcheck // the changes are saved by fn
+
+	return fn(book)
+}
+
+// addrBookPruneFilter returns whether an entry matches all the given criteria:
+// not seen nor connected to for notSeenFor, at least failedAttempts failed
+// dials since the last connection, and never connected to. Zero criteria are
+// ignored.
+func addrBookPruneFilter(
+	now time.Time,
+	notSeenFor time.Duration,
+	failedAttempts int,
+	neverConnected bool,
+) func(e *pex.AddrBookEntry) bool {
+	return func(e *pex.AddrBookEntry) bool {
+		if notSeenFor > 0 {
+			lastSeen := e.LastSeen
+			if e.LastSuccess.After(lastSeen) {
+				lastSeen = e.LastSuccess
+			}
+			if now.Sub(lastSeen) < notSeenFor {
+				return false
+			}
+		}
+		if failedAttempts > 0 && int(e.Attempts) < failedAttempts {
+			return false
+		}
+		if neverConnected && e.Connections > 0 {
+			return false
+		}
+		return true
+	}
+}
+
+// readAddrBookEntries reads the entries of an export of the address book, or
+// of the file of a file-backed address book.
+func readAddrBookEntries(filePath string) ([]*pex.AddrBookEntry, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*pex.AddrBookEntry
+	if err := json.Unmarshal(bz, &entries); err == nil {
+		return entries, nil
+	}
+	return pex.ReadAddrBookFile(filePath)
+}
+
+func printAddrBookEntries(entries []*pex.AddrBookEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ADDRESS\tLAST SEEN\tCONNECTIONS\tUPTIME\tLATENCY\tFAILED\tGOOD\tBANNED UNTIL\tSOURCES")
+	for _, e := range entries {
+		bannedUntil := "-"
+		if !e.BannedUntil.IsZero() {
+			bannedUntil = e.BannedUntil.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%v\t%s\t%d\t%v\t%v\t%d\t%t\t%s\t%d\n",
+			e.Addr,
+			e.LastSeen.Format(time.RFC3339),
+			e.Connections,
+			e.Uptime.Round(time.Second),
+			e.Latency.Round(time.Millisecond),
+			e.Attempts,
+			e.Good,
+			bannedUntil,
+			len(e.Sources),
+		)
+	}
+	w.Flush()
+	fmt.Printf("%d entries\n", len(entries))
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
+)
+
+func TestAddrBookPruneFilter(t *testing.T) {
+	now := time.Now()
+	var (
+		stale = &pex.AddrBookEntry{LastSeen: now.Add(-48 * time.Hour), Attempts: 5}
+		// connected to recently, but not gossiped for long
+		connected = &pex.AddrBookEntry{LastSeen: now.Add(-48 * time.Hour), LastSuccess: now, Connections: 1}
+		recent    = &pex.AddrBookEntry{LastSeen: now, Attempts: 1}
+	)
+
+	filter := addrBookPruneFilter(now, 24*time.Hour, 0, false)
+	assert.True(t, filter(stale))
+	assert.False(t, filter(connected))
+	assert.False(t, filter(recent))
+
+	filter = addrBookPruneFilter(now, 0, 3, true)
+	assert.True(t, filter(stale))
+	assert.False(t, filter(connected))
+	assert.False(t, filter(recent))
+
+	filter = addrBookPruneFilter(now, 0, 0, true)
+	assert.True(t, filter(stale))
+	assert.False(t, filter(connected))
+	assert.True(t, filter(recent))
+}
+
+func TestReadAddrBookEntries(t *testing.T) {
+	dir := t.TempDir()
+	addr, err := p2p.NewNetAddressString("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@1.2.3.4:26656")
+	require.NoError(t, err)
+
+	// an export of the address book
+	exportFile := filepath.Join(dir, "export.json")
+	bz, err := json.Marshal([]*pex.AddrBookEntry{{Addr: addr, Connections: 3}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(exportFile, bz, 0o644))
+	entries, err := readAddrBookEntries(exportFile)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.EqualValues(t, 3, entries[0].Connections)
+
+	// the file of a file-backed address book
+	bookFile := filepath.Join(dir, "addrbook.json")
+	book := pex.NewAddrBook(bookFile, true)
+	require.NoError(t, book.AddAddress(addr, addr))
+	book.Save()
+	entries, err = readAddrBookEntries(bookFile)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, addr.ID, entries[0].ID())
+
+	_, err = readAddrBookEntries(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
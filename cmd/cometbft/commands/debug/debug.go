@@ -38,4 +38,7 @@ func init() {
 
 	DebugCmd.AddCommand(killCmd)
 	DebugCmd.AddCommand(dumpCmd)
+	DebugCmd.AddCommand(mempoolDumpCmd)
+	DebugCmd.AddCommand(mempoolImportCmd)
+	DebugCmd.AddCommand(walCmd)
 }
@@ -107,6 +107,12 @@ func dumpDebugData(outDir string, conf *cfg.Config, rpc *rpchttp.HTTP) {
 		return
 	}
 
+	logger.Info("getting node mempool...")
+	if err := dumpMempool(nodeRPCAddr, tmpDir, "mempool.json"); err != nil {
+		// dump_mempool is an unsafe route, which may be disabled.
+		logger.Error("failed to dump node mempool", "error", err)
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(conf, tmpDir); err != nil {
 		logger.Error("failed to copy node WAL", "error", err)
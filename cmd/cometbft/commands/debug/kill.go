@@ -23,7 +23,7 @@ var killCmd = &cobra.Command{
 	Short: "Kill a CometBFT process while aggregating and packaging debugging data",
 	Long: `Kill a CometBFT process while also aggregating CometBFT process data
 such as the latest node state, including consensus and networking state,
-go-routine state, and the node's WAL and config information. This aggregated data
+go-routine state, the node's mempool, and the node's WAL and config information. This aggregated data
 is packaged into a compressed archive.
 
 Example:
@@ -76,6 +76,12 @@ func killCmdHandler(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	logger.Info("getting node mempool...")
+	if err := dumpMempool(nodeRPCAddr, tmpDir, "mempool.json"); err != nil {
+		// dump_mempool is an unsafe route, which may be disabled.
+		logger.Error("failed to dump node mempool", "error", err)
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(conf, tmpDir); err != nil {
 		return err
@@ -0,0 +1,98 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+var mempoolDumpCmd = &cobra.Command{
+	Use:   "mempool-dump [output-file]",
+	Short: "Dump the mempool of a CometBFT process into a file",
+	Long: `Dump all the transactions in the mempool of a CometBFT process into a JSON
+file, in the order in which they were added, along with their keys (hashes), the
+height and time at which they were added, the gas wanted returned by CheckTx,
+and the IDs of the peers that sent them.
+
+The file can be replayed into the mempool of another node with mempool-import.
+
+Example:
+$ cometbft debug mempool-dump /path/to/mempool.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: mempoolDumpCmdHandler,
+}
+
+var mempoolImportCmd = &cobra.Command{
+	Use:   "mempool-import [input-file]",
+	Short: "Replay a mempool dump into the mempool of a CometBFT process",
+	Long: `Replay a mempool dump, created with mempool-dump, into the mempool of a
+CometBFT process. Transactions are submitted in the order of the dump with
+broadcast_tx_sync, so they are checked again with CheckTx and added to the
+mempool as if they were received via RPC. In particular, their senders are not
+preserved.
+
+Example:
+$ cometbft debug mempool-import /path/to/mempool.json --rpc-laddr=tcp://localhost:36657/v1`,
+	Args: cobra.ExactArgs(1),
+	RunE: mempoolImportCmdHandler,
+}
+
+func mempoolDumpCmdHandler(_ *cobra.Command, args []string) error {
+	outFile := args[0]
+	if outFile == "" {
+		return errors.New("invalid output file")
+	}
+
+	logger.Info("getting node mempool...")
+	return dumpMempool(nodeRPCAddr, filepath.Dir(outFile), filepath.Base(outFile))
+}
+
+func mempoolImportCmdHandler(_ *cobra.Command, args []string) error {
+	inFile := args[0]
+	if inFile == "" {
+		return errors.New("invalid input file")
+	}
+
+	bz, err := os.ReadFile(inFile)
+	if err != nil {
+		return fmt.Errorf("failed to read mempool dump: %w", err)
+	}
+	var dump ctypes.ResultDumpMempool
+	if err := json.Unmarshal(bz, &dump); err != nil {
+		return fmt.Errorf("failed to decode mempool dump: %w", err)
+	}
+
+	rpc, err := rpchttp.New(nodeRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create new http client: %w", err)
+	}
+
+	logger.Info("replaying mempool dump...", "txs", len(dump.Txs))
+	var added, rejected, failed int
+	for _, info := range dump.Txs {
+		res, err := rpc.BroadcastTxSync(context.Background(), info.Tx)
+		switch {
+		case err != nil:
+			// For example, the tx is already in the mempool or in the cache.
+			logger.Error("failed to submit transaction", "tx", info.Hash, "error", err)
+			failed++
+		case res.Code != abci.CodeTypeOK:
+			logger.Error("transaction rejected by CheckTx", "tx", info.Hash, "code", res.Code, "log", res.Log)
+			rejected++
+		default:
+			added++
+		}
+	}
+
+	logger.Info("replayed mempool dump", "added", added, "rejected", rejected, "failed", failed)
+	return nil
+}
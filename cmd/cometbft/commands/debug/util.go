@@ -10,6 +10,8 @@ import (
 
 	cfg "github.com/cometbft/cometbft/config"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 )
 
 // dumpStatus gets node status state dump from the CometBFT RPC and writes it
@@ -45,6 +47,25 @@ func dumpConsensusState(rpc *rpchttp.HTTP, dir, filename string) error {
 	return writeStateJSONToFile(consDump, dir, filename)
 }
 
+// dumpMempool gets all the transactions in the mempool, along with their
+// metadata, from the unsafe dump_mempool route of the CometBFT RPC at remote
+// and writes them to file. The route is not part of the RPC client, as the
+// other unsafe routes, so it is called directly. It returns an error upon
+// failure.
+func dumpMempool(remote, dir, filename string) error {
+	rpc, err := jsonrpcclient.New(remote)
+	if err != nil {
+		return fmt.Errorf("failed to create new http client: %w", err)
+	}
+
+	mempool := new(ctypes.ResultDumpMempool)
+	if _, err := rpc.Call(context.Background(), "dump_mempool", map[string]any{}, mempool); err != nil {
+		return fmt.Errorf("failed to get node mempool: %w", err)
+	}
+
+	return writeStateJSONToFile(mempool, dir, filename)
+}
+
 // copyWAL copies the CometBFT node's WAL file. It returns an error if the
 // WAL file cannot be read or copied.
 func copyWAL(conf *cfg.Config, dir string) error {
@@ -0,0 +1,228 @@
+package debug
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	cfg "github.com/cometbft/cometbft/config"
+	auto "github.com/cometbft/cometbft/internal/autofile"
+	cs "github.com/cometbft/cometbft/internal/consensus"
+	"github.com/cometbft/cometbft/libs/cli"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+var (
+	walFromHeight int64
+	walToHeight   int64
+	walMsgTypes   []string
+	walVerify     bool
+
+	flagWALFromHeight = "from-height"
+	flagWALToHeight   = "to-height"
+	flagWALMsgTypes   = "types"
+	flagWALVerify     = "verify"
+
+	walAllMsgTypes = []string{
+		cs.WALMsgTypeRoundState,
+		cs.WALMsgTypeProposal,
+		cs.WALMsgTypeBlockPart,
+		cs.WALMsgTypeVote,
+		cs.WALMsgTypeTimeout,
+		cs.WALMsgTypeEndHeight,
+	}
+)
+
+var walCmd = &cobra.Command{
+	Use:   "wal [wal-file]",
+	Short: "Decode the consensus WAL of a CometBFT node into JSON lines",
+	Long: `Decode the consensus write-ahead log (WAL) of a CometBFT node, along with its
+rotated files, and print its messages as JSON lines, each with the file and the
+offset at which the message starts, its type and its height.
+
+The WAL file defaults to the one of the node whose home directory is given with
+--home. The node should be stopped, or the last messages may be missing.
+
+Messages can be filtered by height, with --from-height and --to-height, and by
+type, with --types, among: ` + strings.Join(walAllMsgTypes, ", ") + `.
+An end_height message marks the end of the given height.
+
+The checksum of each message is verified. Upon a corrupted message, the command
+reports the file and the offset at which it starts, skips the rest of the file
+and exits with an error once all the files are decoded. With --verify, the
+messages are only verified, not printed.
+
+Example:
+$ cometbft debug wal --home=/path/to/cmthome --from-height=100 --types=proposal,vote`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: walCmdHandler,
+}
+
+func init() {
+	walCmd.Flags().Int64Var(&walFromHeight, flagWALFromHeight, 0, "lowest height of the messages to print (0 for no limit)")
+	walCmd.Flags().Int64Var(&walToHeight, flagWALToHeight, 0, "highest height of the messages to print (0 for no limit)")
+	walCmd.Flags().StringSliceVar(&walMsgTypes, flagWALMsgTypes, nil,
+		"types of the messages to print, among "+strings.Join(walAllMsgTypes, ", ")+" (all if empty)")
+	walCmd.Flags().BoolVar(&walVerify, flagWALVerify, false, "only verify the messages, without printing them")
+}
+
+// walEntry is a decoded WAL message, along with its location in the WAL.
+type walEntry struct {
+	File   string        `json:"file"`
+	Offset int64         `json:"offset"`
+	Type   string        `json:"type"`
+	Height int64         `json:"height"`
+	Time   time.Time     `json:"time"`
+	Msg    cs.WALMessage `json:"msg"`
+}
+
+func walCmdHandler(_ *cobra.Command, args []string) error {
+	var walFile string
+	if len(args) > 0 {
+		walFile = args[0]
+	} else {
+		conf := cfg.DefaultConfig()
+		conf = conf.SetRoot(viper.GetString(cli.HomeFlag))
+		walFile = conf.Consensus.WalFile()
+	}
+	if _, err := os.Stat(walFile); err != nil {
+		return fmt.Errorf("failed to find WAL file: %w", err)
+	}
+
+	types := make(map[string]bool, len(walMsgTypes))
+	for _, t := range walMsgTypes {
+		if !slices.Contains(walAllMsgTypes, t) {
+			return fmt.Errorf("unknown message type %q, expected one of %s", t, strings.Join(walAllMsgTypes, ", "))
+		}
+		types[t] = true
+	}
+	filter := func(msgType string, height int64) bool {
+		return (len(types) == 0 || types[msgType]) &&
+			(walFromHeight == 0 || height >= walFromHeight) &&
+			(walToHeight == 0 || height <= walToHeight)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	return decodeWAL(walFile, filter, func(entry walEntry) error {
+		if walVerify {
+			return nil
+		}
+		bz, err := cmtjson.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to marshal message: %w", err)
+		}
+		if _, err := out.Write(append(bz, '\n')); err != nil {
+			return fmt.Errorf("failed to write message: %w", err)
+		}
+		return nil
+	})
+}
+
+// decodeWAL decodes the messages of the WAL, from the oldest file of the group
+// to the head, and calls fn with those that pass filter. Upon a corrupted
+// message, it reports the file and the offset at which the message starts and
+// skips to the next file. It returns an error if any message is corrupted.
+func decodeWAL(walFile string, filter func(msgType string, height int64) bool, fn func(walEntry) error) error {
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return fmt.Errorf("failed to open WAL: %w", err)
+	}
+	defer group.Close()
+
+	var corrupted int
+	info := group.ReadGroupInfo()
+	for index := info.MinIndex; index <= info.MaxIndex; index++ {
+		path := walFile
+		if index < info.MaxIndex {
+			path = fmt.Sprintf("%s.%03d", walFile, index)
+		}
+		ok, err := decodeWALFile(group, index, path, filter, fn)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			corrupted++
+		}
+	}
+
+	if corrupted > 0 {
+		return fmt.Errorf("found corrupted messages in %d WAL file(s)", corrupted)
+	}
+	return nil
+}
+
+// decodeWALFile decodes the messages of the file of the group at index, and
+// returns false if one of them is corrupted.
+func decodeWALFile(
+	group *auto.Group,
+	index int,
+	path string,
+	filter func(msgType string, height int64) bool,
+	fn func(walEntry) error,
+) (bool, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat WAL file: %w", err)
+	}
+	gr, err := group.NewReader(index)
+	if err != nil {
+		return false, fmt.Errorf("failed to open WAL file %s: %w", path, err)
+	}
+	defer gr.Close()
+
+	// The group reader moves on to the next file at the end of the current
+	// one, so stop at the end of the file to keep track of the offsets.
+	rd := &countingReader{r: io.LimitReader(gr, stat.Size())}
+	dec := cs.NewWALDecoder(rd)
+	for {
+		offset := rd.n
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return true, nil
+		}
+		if err != nil {
+			if !cs.IsDataCorruptionError(err) {
+				return false, fmt.Errorf("failed to decode WAL file %s at offset %d: %w", path, offset, err)
+			}
+			fmt.Fprintf(os.Stderr, "corrupted message in WAL file %s at offset %d, skipping the rest of the file: %v\n", path, offset, err)
+			return false, nil
+		}
+
+		msgType, height := cs.WALMessageType(msg.Msg)
+		if !filter(msgType, height) {
+			continue
+		}
+		err = fn(walEntry{
+			File:   path,
+			Offset: offset,
+			Type:   msgType,
+			Height: height,
+			Time:   msg.Time,
+			Msg:    msg.Msg,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
@@ -86,7 +86,7 @@ func resetAll(dbDir, addrBookFile, privValKeyFile, privValStateFile string, logg
 		removeAddrBook(addrBookFile, logger)
 	}
 
-	if err := os.RemoveAll(dbDir); err == nil {
+	if err := removeDBDir(dbDir, keepAddrBook); err == nil {
 		logger.Info("Removed all blockchain history", "dir", dbDir)
 	} else {
 		logger.Error("Error removing all blockchain history", "dir", dbDir, "err", err)
@@ -101,6 +101,30 @@ func resetAll(dbDir, addrBookFile, privValKeyFile, privValStateFile string, logg
 	return nil
 }
 
+// removeDBDir removes dbDir, or all its content but the address book database
+// if keepAddrBook is set.
+func removeDBDir(dbDir string, keepAddrBook bool) error {
+	if !keepAddrBook {
+		return os.RemoveAll(dbDir)
+	}
+	entries, err := os.ReadDir(dbDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.Name() == addrBookDBName {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dbDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // resetState removes address book files plus all databases.
 func resetState(dbDir string, logger log.Logger) error {
 	blockdb := filepath.Join(dbDir, "blockstore.db")
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -31,6 +32,24 @@ func Test_ResetAll(t *testing.T) {
 	require.Equal(t, int64(0), pv.LastSignState.Height)
 }
 
+func Test_ResetAllKeepAddrBook(t *testing.T) {
+	config := cfg.TestConfig()
+	dir := t.TempDir()
+	config.SetRoot(dir)
+	cfg.EnsureRoot(dir)
+	require.NoError(t, initFilesWithConfig(config))
+	require.NoError(t, os.MkdirAll(filepath.Join(config.DBDir(), addrBookDBName), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(config.DBDir(), "state.db"), 0o700))
+
+	keepAddrBook = true
+	defer func() { keepAddrBook = false }()
+	require.NoError(t, resetAll(config.DBDir(), config.P2P.AddrBookFile(), config.PrivValidatorKeyFile(),
+		config.PrivValidatorStateFile(), logger))
+	require.DirExists(t, filepath.Join(config.DBDir(), addrBookDBName))
+	require.NoDirExists(t, filepath.Join(config.DBDir(), "state.db"))
+	require.FileExists(t, config.PrivValidatorStateFile())
+}
+
 func Test_ResetState(t *testing.T) {
 	config := cfg.TestConfig()
 	dir := t.TempDir()
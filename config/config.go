@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -43,14 +44,30 @@ const (
 	DefaultNodeKeyName  = "node_key.json"
 	DefaultAddrBookName = "addrbook.json"
 
+	DefaultPeerScoresName = "peer_scores.json"
+	DefaultBanListName    = "ban_list.json"
+
 	DefaultPruningInterval = 10 * time.Second
 
+	// ed25519PubKeySize is the size of the ed25519 public key of DNS seeds.
+	ed25519PubKeySize = 32
+
 	v0 = "v0"
 	v1 = "v1"
 	v2 = "v2"
 
-	MempoolTypeFlood = "flood"
-	MempoolTypeNop   = "nop"
+	MempoolTypeFlood    = "flood"
+	MempoolTypeNop      = "nop"
+	MempoolTypePriority = "priority"
+
+	MempoolGossipModePush     = "push"
+	MempoolGossipModeHaveWant = "have_want"
+
+	P2PTransportTCP  = "tcp"
+	P2PTransportQUIC = "quic"
+
+	AddrBookBackendFile = "file"
+	AddrBookBackendDB   = "db"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -68,6 +85,9 @@ var (
 	defaultNodeKeyPath  = filepath.Join(DefaultConfigDir, DefaultNodeKeyName)
 	defaultAddrBookPath = filepath.Join(DefaultConfigDir, DefaultAddrBookName)
 
+	defaultPeerScoresPath = filepath.Join(DefaultDataDir, DefaultPeerScoresName)
+	defaultBanListPath    = filepath.Join(DefaultDataDir, DefaultBanListName)
+
 	minSubscriptionBufferSize     = 100
 	defaultSubscriptionBufferSize = 200
 
@@ -618,6 +638,10 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC mempool service streams the events of the mempool: txs added
+	// to it, evicted from it, or removed from it after being committed
+	MempoolService *GRPCMempoolServiceConfig `mapstructure:"mempool_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -629,6 +653,7 @@ func DefaultGRPCConfig() *GRPCConfig {
 		VersionService:      DefaultGRPCVersionServiceConfig(),
 		BlockService:        DefaultGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      DefaultGRPCMempoolServiceConfig(),
 		Privileged:          DefaultGRPCPrivilegedConfig(),
 	}
 }
@@ -639,6 +664,7 @@ func TestGRPCConfig() *GRPCConfig {
 		VersionService:      TestGRPCVersionServiceConfig(),
 		BlockService:        TestGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      TestGRPCMempoolServiceConfig(),
 		Privileged:          TestGRPCPrivilegedConfig(),
 	}
 }
@@ -698,6 +724,22 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCMempoolServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: true,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -756,20 +798,49 @@ type P2PConfig struct { //nolint: maligned
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
+	// Transport used to connect to peers:
+	//  - "tcp"  : multiplexed connections over TCP (default)
+	//  - "quic" : QUIC connections, with a stream per channel, in addition to
+	//  TCP connections with the peers that do not support QUIC. QUIC listens on
+	//  the UDP port of ListenAddress.
+	Transport string `mapstructure:"transport"`
+
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
 
+	// Comma separated list of DNS names whose TXT records list seed nodes,
+	// as id@host:port entries, signed with DNSSeedsPubKey along with an expiry
+	DNSSeeds string `mapstructure:"dns_seeds"`
+
+	// Base64-encoded ed25519 public key the TXT records of DNSSeeds must be
+	// signed with
+	DNSSeedsPubKey string `mapstructure:"dns_seeds_pub_key"`
+
 	// Comma separated list of nodes to keep persistent connections to
 	PersistentPeers string `mapstructure:"persistent_peers"`
 
 	// Path to address book
 	AddrBook string `mapstructure:"addr_book_file"`
 
+	// Storage of the address book:
+	//  - "file" : the whole book is periodically written to AddrBook (default)
+	//  - "db"   : the book is stored in the "addrbook" database, along with
+	//  the connection history of every address, which is used to select the
+	//  peers to dial. AddrBook is not used.
+	AddrBookBackend string `mapstructure:"addr_book_backend"`
+
 	// Set true for strict address routability rules
 	// Set false for private or local networks
 	AddrBookStrict bool `mapstructure:"addr_book_strict"`
 
+	// Path to the file where the scores of peers are persisted
+	PeerScores string `mapstructure:"peer_scores_file"`
+
+	// Path to the file where the bans of peers, set with the unsafe RPC
+	// routes, are persisted
+	BanList string `mapstructure:"ban_list_file"`
+
 	// Maximum number of inbound peers
 	MaxNumInboundPeers int `mapstructure:"max_num_inbound_peers"`
 
@@ -827,8 +898,12 @@ func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
 		ExternalAddress:              "",
+		Transport:                    P2PTransportTCP,
 		AddrBook:                     defaultAddrBookPath,
+		AddrBookBackend:              AddrBookBackendFile,
 		AddrBookStrict:               true,
+		PeerScores:                   defaultPeerScoresPath,
+		BanList:                      defaultBanListPath,
 		MaxNumInboundPeers:           40,
 		MaxNumOutboundPeers:          10,
 		PersistentPeersMaxDialPeriod: 0 * time.Second,
@@ -860,6 +935,16 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// PeerScoresFile returns the full path to the peer scores.
+func (cfg *P2PConfig) PeerScoresFile() string {
+	return rootify(cfg.PeerScores, cfg.RootDir)
+}
+
+// BanListFile returns the full path to the ban list.
+func (cfg *P2PConfig) BanListFile() string {
+	return rootify(cfg.BanList, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -884,6 +969,32 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return cmterrors.ErrNegativeField{Field: "recv_rate"}
 	}
+	switch cfg.Transport {
+	case P2PTransportTCP, P2PTransportQUIC:
+	default:
+		return fmt.Errorf("unknown p2p transport: %q", cfg.Transport)
+	}
+	switch cfg.AddrBookBackend {
+	// allow empty string for backward compatibility
+	case AddrBookBackendFile, AddrBookBackendDB, "":
+	default:
+		return fmt.Errorf("unknown address book backend: %q", cfg.AddrBookBackend)
+	}
+	if cfg.DNSSeeds != "" {
+		if cfg.DNSSeedsPubKey == "" {
+			return cmterrors.ErrRequiredField{Field: "dns_seeds_pub_key"}
+		}
+		pubKey, err := base64.StdEncoding.DecodeString(cfg.DNSSeedsPubKey)
+		if err != nil {
+			return cmterrors.ErrWrongField{Field: "dns_seeds_pub_key", Err: err}
+		}
+		if len(pubKey) != ed25519PubKeySize {
+			return cmterrors.ErrInvalidField{
+				Field:  "dns_seeds_pub_key",
+				Reason: fmt.Sprintf("must be a %d bytes ed25519 public key, got %d bytes", ed25519PubKeySize, len(pubKey)),
+			}
+		}
+	}
 	return nil
 }
 
@@ -920,9 +1031,12 @@ type MempoolConfig struct {
 	// The type of mempool for this node to use.
 	//
 	//  Possible types:
-	//  - "flood" : concurrent linked list mempool with flooding gossip protocol
+	//  - "flood"    : concurrent linked list mempool with flooding gossip protocol
 	//  (default)
-	//  - "nop"   : nop-mempool (short for no operation; the ABCI app is
+	//  - "priority" : same as "flood", but transactions are reaped in order of
+	//  the priority returned by the application in CheckTx and, when the
+	//  mempool is full, lower priority transactions are evicted to make room.
+	//  - "nop"      : nop-mempool (short for no operation; the ABCI app is
 	//  responsible for storing, disseminating and proposing txs).
 	//  "create_empty_blocks=false" is not supported.
 	Type string `mapstructure:"type"`
@@ -941,6 +1055,33 @@ type MempoolConfig struct {
 	// arrive after the timeout expires are discarded. It only applies to
 	// non-local ABCI clients and when recheck is enabled.
 	RecheckTimeout time.Duration `mapstructure:"recheck_timeout"`
+	// RecheckBatchSize is the number of transactions sent to the application
+	// in each batch of recheck requests. The connection to the application is
+	// flushed after each batch. If 0 (default), all transactions are sent in
+	// a single batch.
+	RecheckBatchSize int `mapstructure:"recheck_batch_size"`
+	// RecheckConcurrency is the number of recheck batches sent concurrently
+	// to the application. If greater than 1, the application may receive
+	// recheck requests in a different order than the transactions in the
+	// mempool, so only use it with applications whose CheckTx does not depend
+	// on the order of transactions. Default: 1.
+	RecheckConcurrency int `mapstructure:"recheck_concurrency"`
+	// RecheckEventKeys, if not empty, restricts rechecking to the
+	// transactions touched by the committed block. Each key is a composite
+	// event key of the form "{event.type}.{attribute.key}", e.g.
+	// "transfer.sender". A transaction in the mempool is rechecked only if
+	// one of the values of these keys in the events of its CheckTx response
+	// also appears in the events of the committed block or of one of its
+	// transactions, or if its CheckTx response has none of these keys.
+	RecheckEventKeys []string `mapstructure:"recheck_event_keys"`
+	// GossipMode defines how transactions are gossiped to peers.
+	//
+	//  Possible modes:
+	//  - "push"      : every transaction is sent to every peer (default).
+	//  - "have_want" : the keys of transactions are announced to peers, which
+	//  request the transactions they have not seen yet. Transactions are still
+	//  pushed to peers that do not support this mode.
+	GossipMode string `mapstructure:"gossip_mode"`
 	// Broadcast (default: true) defines whether the mempool should relay
 	// transactions to other peers. Setting this to false will stop the mempool
 	// from relaying transactions to other peers until they are included in a
@@ -962,6 +1103,23 @@ type MempoolConfig struct {
 	// Set to true if it's not possible for any invalid transaction to become
 	// valid again in the future.
 	KeepInvalidTxsInCache bool `mapstructure:"keep-invalid-txs-in-cache"`
+	// TTLDuration, if non-zero, defines the maximum amount of time a transaction
+	// can exist for in the mempool. Expired transactions are removed from the
+	// mempool and the cache when a block is committed.
+	TTLDuration time.Duration `mapstructure:"ttl_duration"`
+	// TTLNumBlocks, if non-zero, defines the maximum number of blocks a
+	// transaction can exist for in the mempool. Expired transactions are
+	// removed from the mempool and the cache when a block is committed.
+	//
+	// If both TTLDuration and TTLNumBlocks are set, a transaction is removed
+	// as soon as it exceeds either of them.
+	TTLNumBlocks int64 `mapstructure:"ttl_num_blocks"`
+	// Journal (default: false) defines whether the mempool should persist its
+	// transactions to the "mempool" database, in db_dir. If enabled, the
+	// transactions are re-validated with CheckTx and restored into the mempool
+	// when the node restarts, before it starts gossiping, up to MaxTxsBytes.
+	// Not supported by the "nop" mempool.
+	Journal bool `mapstructure:"journal"`
 	// Experimental parameters to limit gossiping txs to up to the specified number of peers.
 	// We use two independent upper values for persistent and non-persistent peers.
 	// Unconditional peers are not affected by this feature.
@@ -976,23 +1134,71 @@ type MempoolConfig struct {
 	// performance results using the default P2P configuration.
 	ExperimentalMaxGossipConnectionsToPersistentPeers    int `mapstructure:"experimental_max_gossip_connections_to_persistent_peers"`
 	ExperimentalMaxGossipConnectionsToNonPersistentPeers int `mapstructure:"experimental_max_gossip_connections_to_non_persistent_peers"`
+	// PeerMaxTxsPerSecond, if non-zero, limits the number of transactions per
+	// second that the mempool accepts from a single peer. Transactions beyond
+	// the limit are dropped without being checked.
+	PeerMaxTxsPerSecond int `mapstructure:"peer_max_txs_per_second"`
+	// PeerMaxBytesPerSecond, if non-zero, limits the number of bytes of
+	// transactions per second that the mempool accepts from a single peer. It
+	// must not be lower than MaxTxBytes.
+	PeerMaxBytesPerSecond int64 `mapstructure:"peer_max_bytes_per_second"`
+	// PeerMaxTxs, if non-zero, limits the number of transactions first
+	// received from a single peer that can be in the mempool at the same time.
+	PeerMaxTxs int `mapstructure:"peer_max_txs"`
+	// PeerQuotaGracePeriod is how long a peer can keep exceeding the above
+	// quotas before it is disconnected. If 0, peers are never disconnected for
+	// exceeding their quotas.
+	PeerQuotaGracePeriod time.Duration `mapstructure:"peer_quota_grace_period"`
+	// Lanes partition the mempool into independent queues. The application
+	// assigns each transaction to a lane in CheckTx. Each lane has its own
+	// capacity and gossip queue, and lanes are reaped in a weighted round-robin
+	// order. If empty (default), the mempool has a single queue.
+	Lanes []MempoolLaneConfig `mapstructure:"lanes"`
+	// DefaultLane is the lane of the transactions for which the application
+	// does not specify a lane. It must be set if, and only if, Lanes is not
+	// empty.
+	DefaultLane string `mapstructure:"default_lane"`
+}
+
+// MempoolLaneConfig defines a mempool lane.
+type MempoolLaneConfig struct {
+	// Identifier of the lane, as set by the application in CheckTx responses.
+	ID string `mapstructure:"id"`
+	// Number of transactions reaped from this lane in each round of the
+	// weighted round-robin, when creating a block. Must be positive.
+	Weight int `mapstructure:"weight"`
+	// Maximum number of transactions in the lane. If 0, the lane is only
+	// limited by the mempool size.
+	Size int `mapstructure:"size"`
+	// Maximum size in bytes of all transactions in the lane. If 0, the lane is
+	// only limited by the mempool max_txs_bytes.
+	MaxTxsBytes int64 `mapstructure:"max_txs_bytes"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool.
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
-		Type:           MempoolTypeFlood,
-		Recheck:        true,
-		RecheckTimeout: 1000 * time.Millisecond,
-		Broadcast:      true,
+		Type:               MempoolTypeFlood,
+		Recheck:            true,
+		RecheckTimeout:     1000 * time.Millisecond,
+		RecheckBatchSize:   0,
+		RecheckConcurrency: 1,
+		GossipMode:         MempoolGossipModePush,
+		Broadcast:          true,
 		// Each signature verification takes .5ms, Size reduced until we implement
 		// ABCI Recheck
-		Size:        5000,
-		MaxTxBytes:  1024 * 1024,      // 1MiB
-		MaxTxsBytes: 64 * 1024 * 1024, // 64MiB, enough to fill 16 blocks of 4 MiB
-		CacheSize:   10000,
+		Size:         5000,
+		MaxTxBytes:   1024 * 1024,      // 1MiB
+		MaxTxsBytes:  64 * 1024 * 1024, // 64MiB, enough to fill 16 blocks of 4 MiB
+		CacheSize:    10000,
+		TTLDuration:  0 * time.Second,
+		TTLNumBlocks: 0,
 		ExperimentalMaxGossipConnectionsToNonPersistentPeers: 0,
 		ExperimentalMaxGossipConnectionsToPersistentPeers:    0,
+		PeerMaxTxsPerSecond:   0,
+		PeerMaxBytesPerSecond: 0,
+		PeerMaxTxs:            0,
+		PeerQuotaGracePeriod:  10 * time.Second,
 	}
 }
 
@@ -1007,11 +1213,28 @@ func TestMempoolConfig() *MempoolConfig {
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
 	switch cfg.Type {
-	case MempoolTypeFlood, MempoolTypeNop:
+	case MempoolTypeFlood, MempoolTypePriority, MempoolTypeNop:
 	case "": // allow empty string to be backwards compatible
 	default:
 		return fmt.Errorf("unknown mempool type: %q", cfg.Type)
 	}
+	switch cfg.GossipMode {
+	case MempoolGossipModePush, MempoolGossipModeHaveWant:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown mempool gossip_mode: %q", cfg.GossipMode)
+	}
+	if cfg.RecheckBatchSize < 0 {
+		return cmterrors.ErrNegativeField{Field: "recheck_batch_size"}
+	}
+	if cfg.RecheckConcurrency < 0 {
+		return cmterrors.ErrNegativeField{Field: "recheck_concurrency"}
+	}
+	for i, key := range cfg.RecheckEventKeys {
+		if parts := strings.SplitN(key, ".", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("recheck_event_keys[%d]: %q is not of the form {event.type}.{attribute.key}", i, key)
+		}
+	}
 	if cfg.Size < 0 {
 		return cmterrors.ErrNegativeField{Field: "size"}
 	}
@@ -1024,12 +1247,60 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_tx_bytes"}
 	}
+	if cfg.TTLDuration < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_duration"}
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_num_blocks"}
+	}
 	if cfg.ExperimentalMaxGossipConnectionsToPersistentPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "experimental_max_gossip_connections_to_persistent_peers"}
 	}
 	if cfg.ExperimentalMaxGossipConnectionsToNonPersistentPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "experimental_max_gossip_connections_to_non_persistent_peers"}
 	}
+	if cfg.PeerMaxTxsPerSecond < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_max_txs_per_second"}
+	}
+	if cfg.PeerMaxBytesPerSecond < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_max_bytes_per_second"}
+	}
+	if cfg.PeerMaxBytesPerSecond > 0 && cfg.PeerMaxBytesPerSecond < int64(cfg.MaxTxBytes) {
+		return fmt.Errorf("peer_max_bytes_per_second (%d) must not be lower than max_tx_bytes (%d)",
+			cfg.PeerMaxBytesPerSecond, cfg.MaxTxBytes)
+	}
+	if cfg.PeerMaxTxs < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_max_txs"}
+	}
+	if cfg.PeerQuotaGracePeriod < 0 {
+		return cmterrors.ErrNegativeField{Field: "peer_quota_grace_period"}
+	}
+	laneIDs := make(map[string]struct{}, len(cfg.Lanes))
+	for i, lane := range cfg.Lanes {
+		if lane.ID == "" {
+			return fmt.Errorf("lanes[%d]: empty id", i)
+		}
+		if _, ok := laneIDs[lane.ID]; ok {
+			return fmt.Errorf("lanes[%d]: duplicate id %q", i, lane.ID)
+		}
+		laneIDs[lane.ID] = struct{}{}
+		if lane.Weight <= 0 {
+			return fmt.Errorf("lanes[%d]: weight must be positive, got %d", i, lane.Weight)
+		}
+		if lane.Size < 0 {
+			return cmterrors.ErrNegativeField{Field: fmt.Sprintf("lanes[%d].size", i)}
+		}
+		if lane.MaxTxsBytes < 0 {
+			return cmterrors.ErrNegativeField{Field: fmt.Sprintf("lanes[%d].max_txs_bytes", i)}
+		}
+	}
+	if len(cfg.Lanes) > 0 {
+		if _, ok := laneIDs[cfg.DefaultLane]; !ok {
+			return fmt.Errorf("default_lane %q is not one of the configured lanes", cfg.DefaultLane)
+		}
+	} else if cfg.DefaultLane != "" {
+		return errors.New("default_lane is set, but there are no lanes")
+	}
 	return nil
 }
 
@@ -1186,6 +1457,58 @@ type ConsensusConfig struct {
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// CompactBlocks enables the propagation of proposed blocks as compact
+	// blocks, i.e., block headers along with the keys of their transactions,
+	// to peers that also enable it. Peers rebuild the block from their mempool
+	// and request only the missing transactions.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
+	// CompactBlockTimeout is how long we wait, after sending a compact block
+	// to a peer, before falling back to sending it the block parts.
+	CompactBlockTimeout time.Duration `mapstructure:"compact_block_timeout"`
+
+	// BlockPartsParityRatio is the number of parity parts added per data part
+	// to the proposed blocks, which are then erasure-coded: a block split in k
+	// data parts can be reconstructed from any k of its parts. 0 disables
+	// erasure coding.
+	BlockPartsParityRatio float64 `mapstructure:"block_parts_parity_ratio"`
+
+	// AdaptiveTimeouts derives the propose and vote timeouts of round 0 from
+	// the latencies observed over the last AdaptiveTimeoutsWindow heights:
+	// how long after entering the propose, prevote and precommit steps the
+	// complete proposal and +2/3 prevotes/precommits were received.
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Number of heights over which latencies are observed
+	AdaptiveTimeoutsWindow int64 `mapstructure:"adaptive_timeouts_window"`
+	// Percentile, in (0, 1], of the observed latencies used as timeout
+	AdaptiveTimeoutsPercentile float64 `mapstructure:"adaptive_timeouts_percentile"`
+	// Bounds of the adaptive timeout_propose
+	TimeoutProposeMin time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax time.Duration `mapstructure:"timeout_propose_max"`
+	// Bounds of the adaptive timeout_vote
+	TimeoutVoteMin time.Duration `mapstructure:"timeout_vote_min"`
+	TimeoutVoteMax time.Duration `mapstructure:"timeout_vote_max"`
+
+	// TimelineTrace enables recording, for each height, a timeline of the
+	// consensus: step transitions, the receipt of the proposal, of each vote
+	// and of the last block part, and the FinalizeBlock and Commit durations.
+	// Timelines are written to TimelineTracePath and the last
+	// TimelineTraceHeights ones are kept in memory for the RPC.
+	TimelineTrace bool `mapstructure:"timeline_trace"`
+	// Path of the file timelines are written to, one JSON object per line, if
+	// not empty
+	TimelineTracePath string `mapstructure:"timeline_trace_file"`
+	// Number of recent heights whose timeline is kept in memory
+	TimelineTraceHeights int `mapstructure:"timeline_trace_heights"`
+
+	// OptimisticExecution starts executing a proposed block as soon as +2/3
+	// prevotes for it are received, rather than once it is decided, and uses
+	// the result if it is then decided. The application must support
+	// FinalizeBlock being called again at the same height for another block,
+	// discarding the state of the previous call, and advertise it with
+	// InfoResponse.OptimisticExecution, or the node does not start. It has no
+	// effect at the heights at which vote extensions are enabled.
+	OptimisticExecution bool `mapstructure:"optimistic_execution"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1203,6 +1526,20 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerQueryMaj23SleepDuration:      2000 * time.Millisecond,
 		PeerGossipIntraloopSleepDuration: 0 * time.Second,
 		DoubleSignCheckHeight:            int64(0),
+		CompactBlocks:                    false,
+		CompactBlockTimeout:              500 * time.Millisecond,
+		BlockPartsParityRatio:            0,
+		AdaptiveTimeouts:                 false,
+		AdaptiveTimeoutsWindow:           100,
+		AdaptiveTimeoutsPercentile:       0.99,
+		TimeoutProposeMin:                500 * time.Millisecond,
+		TimeoutProposeMax:                10 * time.Second,
+		TimeoutVoteMin:                   100 * time.Millisecond,
+		TimeoutVoteMax:                   5 * time.Second,
+		TimelineTrace:                    false,
+		TimelineTracePath:                filepath.Join(DefaultDataDir, "cs.timeline", "timeline"),
+		TimelineTraceHeights:             100,
+		OptimisticExecution:              false,
 	}
 }
 
@@ -1217,6 +1554,7 @@ func TestConsensusConfig() *ConsensusConfig {
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
 	cfg.PeerQueryMaj23SleepDuration = 250 * time.Millisecond
 	cfg.DoubleSignCheckHeight = int64(0)
+	cfg.CompactBlockTimeout = 50 * time.Millisecond
 	return cfg
 }
 
@@ -1265,6 +1603,11 @@ func (cfg *ConsensusConfig) SetWalFile(walFile string) {
 	cfg.walFile = walFile
 }
 
+// TimelineTraceFile returns the full path to the timeline trace file.
+func (cfg *ConsensusConfig) TimelineTraceFile() string {
+	return rootify(cfg.TimelineTracePath, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *ConsensusConfig) ValidateBasic() error {
@@ -1295,6 +1638,39 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
+	if cfg.CompactBlockTimeout < 0 {
+		return cmterrors.ErrNegativeField{Field: "compact_block_timeout"}
+	}
+	if cfg.BlockPartsParityRatio < 0 {
+		return cmterrors.ErrNegativeField{Field: "block_parts_parity_ratio"}
+	}
+	if cfg.BlockPartsParityRatio > 1 {
+		return errors.New("block_parts_parity_ratio can't be greater than 1")
+	}
+	if cfg.AdaptiveTimeoutsWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "adaptive_timeouts_window"}
+	}
+	if cfg.AdaptiveTimeouts && cfg.AdaptiveTimeoutsWindow == 0 {
+		return errors.New("adaptive_timeouts_window can't be 0 when adaptive_timeouts is enabled")
+	}
+	if cfg.AdaptiveTimeoutsPercentile <= 0 || cfg.AdaptiveTimeoutsPercentile > 1 {
+		return errors.New("adaptive_timeouts_percentile must be in (0, 1]")
+	}
+	if cfg.TimeoutProposeMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_propose_min"}
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return errors.New("timeout_propose_max can't be less than timeout_propose_min")
+	}
+	if cfg.TimeoutVoteMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_vote_min"}
+	}
+	if cfg.TimeoutVoteMax < cfg.TimeoutVoteMin {
+		return errors.New("timeout_vote_max can't be less than timeout_vote_min")
+	}
+	if cfg.TimelineTraceHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeline_trace_heights"}
+	}
 	return nil
 }
 
@@ -1435,16 +1811,24 @@ type InstrumentationConfig struct {
 
 	// Instrumentation namespace.
 	Namespace string `mapstructure:"namespace"`
+
+	// Number of the last heights over which the node tracks which validators
+	// signed, signed nil or missed each commit. The uptime of each validator
+	// is exposed via the validator_uptime RPC endpoint and, if Prometheus is
+	// enabled, via metrics.
+	// 0 - disabled.
+	ValidatorUptimeWindow int64 `mapstructure:"validator_uptime_window"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
 // reporting.
 func DefaultInstrumentationConfig() *InstrumentationConfig {
 	return &InstrumentationConfig{
-		Prometheus:           false,
-		PrometheusListenAddr: ":26660",
-		MaxOpenConnections:   3,
-		Namespace:            "cometbft",
+		Prometheus:            false,
+		PrometheusListenAddr:  ":26660",
+		MaxOpenConnections:    3,
+		Namespace:             "cometbft",
+		ValidatorUptimeWindow: 1000,
 	}
 }
 
@@ -1460,6 +1844,9 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.MaxOpenConnections < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_open_connections"}
 	}
+	if cfg.ValidatorUptimeWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "validator_uptime_window"}
+	}
 	return nil
 }
 
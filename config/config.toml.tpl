@@ -222,6 +222,11 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC mempool service streams the events of the mempool: txs added to it,
+# evicted from it, or removed from it after being committed.
+[grpc.mempool_service]
+enabled = {{ .GRPC.MempoolService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -256,19 +261,56 @@ laddr = "{{ .P2P.ListenAddress }}"
 # address. IP and port are required. Example: 159.89.10.97:26656
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# Transport used to connect to peers:
+#   - "tcp"  : multiplexed connections over TCP (default)
+#   - "quic" : QUIC connections, with a stream per channel. QUIC listens on the
+#   UDP port of laddr, in addition to TCP, which is still used with the peers
+#   that do not support QUIC.
+transport = "{{ .P2P.Transport }}"
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
+# Comma separated list of DNS names whose TXT records list seed nodes, as
+# id@host:port entries. The addresses are added to the address book at
+# startup and periodically. The entries must be signed with the key of
+# dns_seeds_pub_key, by a "sig=<base64 signature>" TXT record, and expire at
+# the time of an "exp=<Unix time>" TXT record. Expired records, and records
+# expiring before the last ones accepted, are ignored.
+dns_seeds = "{{ .P2P.DNSSeeds }}"
+
+# Base64-encoded ed25519 public key the TXT records of dns_seeds are signed with
+dns_seeds_pub_key = "{{ .P2P.DNSSeedsPubKey }}"
+
 # Comma separated list of nodes to keep persistent connections to
 persistent_peers = "{{ .P2P.PersistentPeers }}"
 
 # Path to address book
 addr_book_file = "{{ js .P2P.AddrBook }}"
 
+# Storage of the address book:
+# 1) "file" - the whole book is periodically written to addr_book_file (default)
+# 2) "db"   - the book is stored in the "addrbook" database, along with the
+#   connection history of every address (last seen, connections, uptime,
+#   latency, peers that gossiped it), which is used to select the peers to
+#   dial. It can be managed with the "cometbft addrbook" command.
+addr_book_backend = "{{ .P2P.AddrBookBackend }}"
+
 # Set true for strict address routability rules
 # Set false for private or local networks
 addr_book_strict = {{ .P2P.AddrBookStrict }}
 
+# Path to the file where the scores of peers are persisted. Peers are scored
+# by the reactors from the messages they send: the best scored peers are
+# preferred when dialing or when the inbound peers are full, and peers whose
+# score drops too low are temporarily banned.
+peer_scores_file = "{{ js .P2P.PeerScores }}"
+
+# Path to the file where the bans of node IDs, IPs and CIDR ranges are
+# persisted. Bans are managed at runtime with the unsafe_ban_peer,
+# unsafe_unban_peer and list_bans RPC endpoints.
+ban_list_file = "{{ js .P2P.BanList }}"
+
 # Maximum number of inbound peers
 max_num_inbound_peers = {{ .P2P.MaxNumInboundPeers }}
 
@@ -320,12 +362,15 @@ dial_timeout = "{{ .P2P.DialTimeout }}"
 # The type of mempool for this node to use.
 #
 #  Possible types:
-#  - "flood" : concurrent linked list mempool with flooding gossip protocol
+#  - "flood"    : concurrent linked list mempool with flooding gossip protocol
 #  (default)
-#  - "nop"   : nop-mempool (short for no operation; the ABCI app is responsible
-#  for storing, disseminating and proposing txs). "create_empty_blocks=false" is
-#  not supported.
-type = "flood"
+#  - "priority" : same as "flood", but transactions are reaped in order of the
+#  priority returned by the application in CheckTx and, when the mempool is
+#  full, lower priority transactions are evicted to make room.
+#  - "nop"      : nop-mempool (short for no operation; the ABCI app is
+#  responsible for storing, disseminating and proposing txs).
+#  "create_empty_blocks=false" is not supported.
+type = "{{ .Mempool.Type }}"
 
 # recheck (default: true) defines whether CometBFT should recheck the
 # validity for all remaining transaction in the mempool after a block.
@@ -340,6 +385,34 @@ recheck = {{ .Mempool.Recheck }}
 # non-local ABCI clients and when recheck is enabled.
 recheck_timeout = "{{ .Mempool.RecheckTimeout }}"
 
+# recheck_batch_size is the number of transactions sent to the application in
+# each batch of recheck requests. The connection to the application is flushed
+# after each batch. If 0, all transactions are sent in a single batch.
+recheck_batch_size = {{ .Mempool.RecheckBatchSize }}
+
+# recheck_concurrency is the number of recheck batches sent concurrently to the
+# application. If greater than 1, the application may receive recheck requests
+# in a different order than the transactions in the mempool, so only use it
+# with applications whose CheckTx does not depend on the order of transactions.
+recheck_concurrency = {{ .Mempool.RecheckConcurrency }}
+
+# recheck_event_keys, if not empty, restricts rechecking to the transactions
+# touched by the committed block. Each key is a composite event key of the form
+# "{event.type}.{attribute.key}", e.g. "transfer.sender". A transaction in the
+# mempool is rechecked only if one of the values of these keys in the events of
+# its CheckTx response also appears in the events of the committed block or of
+# one of its transactions, or if its CheckTx response has none of these keys.
+recheck_event_keys = [{{ range .Mempool.RecheckEventKeys }}{{ printf "%q, " . }}{{end}}]
+
+# gossip_mode defines how transactions are gossiped to peers.
+#
+# Possible modes:
+# - "push"      : every transaction is sent to every peer (default).
+# - "have_want" : the keys of transactions are announced to peers, which
+#   request the transactions they have not seen yet. Transactions are still
+#   pushed to peers that do not support this mode.
+gossip_mode = "{{ .Mempool.GossipMode }}"
+
 # broadcast (default: true) defines whether the mempool should relay
 # transactions to other peers. Setting this to false will stop the mempool
 # from relaying transactions to other peers until they are included in a
@@ -367,6 +440,26 @@ cache_size = {{ .Mempool.CacheSize }}
 # again in the future.
 keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 
+# ttl_duration, if non-zero, defines the maximum amount of time a transaction
+# can exist for in the mempool. Expired transactions are removed from the
+# mempool and the cache when a block is committed.
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
+# ttl_num_blocks, if non-zero, defines the maximum number of blocks a
+# transaction can exist for in the mempool. Expired transactions are removed
+# from the mempool and the cache when a block is committed.
+#
+# If both ttl_duration and ttl_num_blocks are set, a transaction is removed as
+# soon as it exceeds either of them.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+
+# journal (default: false) defines whether the mempool should persist its
+# transactions to the "mempool" database, in db_dir. If enabled, the
+# transactions are re-validated with CheckTx and restored into the mempool when
+# the node restarts, before it starts gossiping, up to max_txs_bytes.
+# Not supported by the "nop" mempool.
+journal = {{ .Mempool.Journal }}
+
 # Experimental parameters to limit gossiping txs to up to the specified number of peers.
 # We use two independent upper values for persistent and non-persistent peers.
 # Unconditional peers are not affected by this feature.
@@ -382,6 +475,54 @@ keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 experimental_max_gossip_connections_to_persistent_peers = {{ .Mempool.ExperimentalMaxGossipConnectionsToPersistentPeers }}
 experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.ExperimentalMaxGossipConnectionsToNonPersistentPeers }}
 
+# peer_max_txs_per_second, if non-zero, limits the number of transactions per
+# second that the mempool accepts from a single peer. Transactions beyond the
+# limit are dropped without being checked.
+peer_max_txs_per_second = {{ .Mempool.PeerMaxTxsPerSecond }}
+
+# peer_max_bytes_per_second, if non-zero, limits the number of bytes of
+# transactions per second that the mempool accepts from a single peer. It must
+# not be lower than max_tx_bytes.
+peer_max_bytes_per_second = {{ .Mempool.PeerMaxBytesPerSecond }}
+
+# peer_max_txs, if non-zero, limits the number of transactions first received
+# from a single peer that can be in the mempool at the same time.
+peer_max_txs = {{ .Mempool.PeerMaxTxs }}
+
+# peer_quota_grace_period is how long a peer can keep exceeding the above quotas
+# before it is disconnected. If 0, peers are never disconnected for exceeding
+# their quotas.
+peer_quota_grace_period = "{{ .Mempool.PeerQuotaGracePeriod }}"
+
+# default_lane is the lane of the transactions for which the application does
+# not specify a lane in CheckTx. It must be set if, and only if, lanes are
+# defined below.
+default_lane = "{{ .Mempool.DefaultLane }}"
+
+# Lanes partition the mempool into independent queues. The application assigns
+# each transaction to a lane by setting lane_id in CheckTx responses. Each lane
+# has its own capacity and gossip queue, and lanes are reaped in a weighted
+# round-robin order: in each round, up to "weight" transactions are taken from
+# each lane, in the order in which lanes are defined here. A "size" or
+# "max_txs_bytes" of 0 means the lane is only limited by the mempool limits.
+# If no lanes are defined (default), the mempool has a single queue.
+#
+# Example:
+#
+# [[mempool.lanes]]
+# id = "oracle"
+# weight = 3
+# size = 1000
+# max_txs_bytes = 1048576
+{{- range .Mempool.Lanes }}
+
+[[mempool.lanes]]
+id = "{{ .ID }}"
+weight = {{ .Weight }}
+size = {{ .Size }}
+max_txs_bytes = {{ .MaxTxsBytes }}
+{{- end }}
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -464,6 +605,56 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Propagate proposed blocks as compact blocks, i.e. block headers along with the
+# keys of their transactions, to peers that also enable this option. Peers
+# rebuild the block from their mempool and request only the transactions they
+# miss, which saves bandwidth when mempools are in sync. Peers that do not
+# enable this option receive the block parts as usual.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+
+# How long to wait, after sending a compact block to a peer, before falling back
+# to sending it the block parts.
+compact_block_timeout = "{{ .Consensus.CompactBlockTimeout }}"
+
+# Number of parity parts to add per data part to the proposed blocks, which are
+# then erasure-coded: a block split in k data parts can be reconstructed from
+# any k of its parts, so that peers can forward different parts. Must be
+# between 0 and 1, and 0 disables erasure coding.
+# WARNING: only enable it once all the nodes of the network support it.
+block_parts_parity_ratio = {{ .Consensus.BlockPartsParityRatio }}
+
+# Derive timeout_propose and timeout_vote from the latencies observed over the
+# last adaptive_timeouts_window heights: how long after entering the propose,
+# prevote and precommit steps the complete proposal and +2/3 prevotes/precommits
+# were received. The timeouts of round 0 are the adaptive_timeouts_percentile
+# of these latencies, bounded by timeout_propose_min/max and
+# timeout_vote_min/max, and the deltas are added for each following round.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+adaptive_timeouts_window = {{ .Consensus.AdaptiveTimeoutsWindow }}
+adaptive_timeouts_percentile = {{ .Consensus.AdaptiveTimeoutsPercentile }}
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+timeout_vote_min = "{{ .Consensus.TimeoutVoteMin }}"
+timeout_vote_max = "{{ .Consensus.TimeoutVoteMax }}"
+
+# Record, for each height, a timeline of the consensus: step transitions, the
+# receipt of the proposal, of each vote and of the last block part, and the
+# durations of FinalizeBlock and Commit. Timelines are written as JSON lines to
+# timeline_trace_file, which is rotated, and the last timeline_trace_heights
+# ones can be retrieved with the /consensus_timeline RPC endpoint.
+timeline_trace = {{ .Consensus.TimelineTrace }}
+timeline_trace_file = "{{ js .Consensus.TimelineTracePath }}"
+timeline_trace_heights = {{ .Consensus.TimelineTraceHeights }}
+
+# Start executing a proposed block, calling FinalizeBlock, as soon as +2/3
+# prevotes for it are received rather than once it is decided, and use the
+# result if it is then decided. Otherwise, the result is discarded and
+# FinalizeBlock is called again at the same height for the decided block: the
+# application must support it, discarding the state of the previous call, and
+# advertise it with InfoResponse.optimistic_execution, or the node does not start.
+# It has no effect at the heights at which vote extensions are enabled.
+optimistic_execution = {{ .Consensus.OptimisticExecution }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -577,3 +768,10 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 
 # Instrumentation namespace
 namespace = "{{ .Instrumentation.Namespace }}"
+
+# Number of the last heights over which the node tracks which validators
+# signed, signed nil or missed each commit. The uptime of each validator is
+# exposed via the /validator_uptime RPC endpoint and, if Prometheus is enabled,
+# via metrics.
+# 0 - disabled.
+validator_uptime_window = {{ .Instrumentation.ValidatorUptimeWindow }}
@@ -136,6 +136,27 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		require.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.Transport = config.P2PTransportQUIC
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Transport = "udp"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Transport = config.P2PTransportTCP
+
+	cfg.AddrBookBackend = config.AddrBookBackendDB
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.AddrBookBackend = "sql"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.AddrBookBackend = config.AddrBookBackendFile
+
+	cfg.DNSSeeds = "seeds.example.com"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.DNSSeedsPubKey = "not base64"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.DNSSeedsPubKey = "AAAA"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.DNSSeedsPubKey = "0Q1uD1hH0V7s0+9tGcQ3MJGqVsZVTTHBm5AaTvMjmC8="
+	require.NoError(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -159,6 +180,76 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	require.Error(t, cfg.ValidateBasic())
 }
 
+func TestMempoolConfigValidateBasicPeerQuotas(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.PeerMaxTxsPerSecond = 10
+	cfg.PeerMaxBytesPerSecond = int64(cfg.MaxTxBytes)
+	cfg.PeerMaxTxs = 100
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.PeerMaxBytesPerSecond = int64(cfg.MaxTxBytes) - 1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.PeerMaxBytesPerSecond = 0
+
+	cfg.PeerMaxTxs = -1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.PeerMaxTxs = 0
+
+	cfg.PeerQuotaGracePeriod = -time.Second
+	require.Error(t, cfg.ValidateBasic())
+}
+
+func TestMempoolConfigValidateBasicRecheck(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.RecheckBatchSize = 100
+	cfg.RecheckConcurrency = 4
+	cfg.RecheckEventKeys = []string{"transfer.sender", "message.sender.address"}
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.RecheckBatchSize = -1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.RecheckBatchSize = 0
+
+	cfg.RecheckConcurrency = -1
+	require.Error(t, cfg.ValidateBasic())
+	cfg.RecheckConcurrency = 1
+
+	for _, key := range []string{"transfer", ".sender", "transfer."} {
+		cfg.RecheckEventKeys = []string{key}
+		require.Error(t, cfg.ValidateBasic(), key)
+	}
+}
+
+func TestMempoolConfigValidateBasicLanes(t *testing.T) {
+	cfg := config.TestMempoolConfig()
+	cfg.Lanes = []config.MempoolLaneConfig{
+		{ID: "a", Weight: 1},
+		{ID: "b", Weight: 2, Size: 10, MaxTxsBytes: 1024},
+	}
+	cfg.DefaultLane = "a"
+	require.NoError(t, cfg.ValidateBasic())
+
+	testCases := map[string]func(cfg *config.MempoolConfig){
+		"empty id":           func(cfg *config.MempoolConfig) { cfg.Lanes[0].ID = "" },
+		"duplicate id":       func(cfg *config.MempoolConfig) { cfg.Lanes[1].ID = "a" },
+		"zero weight":        func(cfg *config.MempoolConfig) { cfg.Lanes[0].Weight = 0 },
+		"negative size":      func(cfg *config.MempoolConfig) { cfg.Lanes[0].Size = -1 },
+		"negative max bytes": func(cfg *config.MempoolConfig) { cfg.Lanes[0].MaxTxsBytes = -1 },
+		"unknown default":    func(cfg *config.MempoolConfig) { cfg.DefaultLane = "c" },
+		"no default":         func(cfg *config.MempoolConfig) { cfg.DefaultLane = "" },
+		"default, no lanes":  func(cfg *config.MempoolConfig) { cfg.Lanes = nil },
+	}
+	for name, malleate := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.TestMempoolConfig()
+			cfg.Lanes = []config.MempoolLaneConfig{{ID: "a", Weight: 1}, {ID: "b", Weight: 1}}
+			cfg.DefaultLane = "a"
+			malleate(cfg)
+			require.Error(t, cfg.ValidateBasic())
+		})
+	}
+}
+
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
@@ -197,6 +288,16 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"AdaptiveTimeouts":                     {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts = true }, false},
+		"AdaptiveTimeoutsWindow negative":      {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutsWindow = -1 }, true},
+		"AdaptiveTimeoutsWindow zero":          {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts, c.AdaptiveTimeoutsWindow = true, 0 }, true},
+		"AdaptiveTimeoutsPercentile zero":      {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutsPercentile = 0 }, true},
+		"AdaptiveTimeoutsPercentile above 1":   {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutsPercentile = 1.1 }, true},
+		"TimeoutProposeMin negative":           {func(c *config.ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":          {func(c *config.ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutVoteMin negative":              {func(c *config.ConsensusConfig) { c.TimeoutVoteMin = -1 }, true},
+		"TimeoutVoteMax below min":             {func(c *config.ConsensusConfig) { c.TimeoutVoteMax = c.TimeoutVoteMin - 1 }, true},
+		"TimelineTraceHeights negative":        {func(c *config.ConsensusConfig) { c.TimelineTraceHeights = -1 }, true},
 	}
 	for desc, tc := range testcases {
 		t.Run(desc, func(t *testing.T) {
@@ -220,4 +321,9 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	// tamper with maximum open connections
 	cfg.MaxOpenConnections = -1
 	require.Error(t, cfg.ValidateBasic())
+
+	// tamper with the validator uptime window
+	cfg = config.TestInstrumentationConfig()
+	cfg.ValidatorUptimeWindow = -1
+	require.Error(t, cfg.ValidateBasic())
 }
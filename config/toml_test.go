@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -82,3 +83,26 @@ func assertValidConfig(t *testing.T, configFile string) {
 		assert.Contains(t, configFile, e)
 	}
 }
+
+func TestWriteConfigFileMempoolLanes(t *testing.T) {
+	tmpDir := t.TempDir()
+	configFilePath := filepath.Join(tmpDir, config.DefaultConfigFileName)
+
+	cfg := config.DefaultConfig()
+	cfg.Mempool.Lanes = []config.MempoolLaneConfig{
+		{ID: "oracle", Weight: 3, Size: 1000, MaxTxsBytes: 1048576},
+		{ID: "default", Weight: 1},
+	}
+	cfg.Mempool.DefaultLane = "default"
+	config.WriteConfigFile(configFilePath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFilePath)
+	require.NoError(t, v.ReadInConfig())
+
+	readCfg := config.DefaultConfig()
+	require.NoError(t, v.Unmarshal(readCfg))
+	assert.Equal(t, cfg.Mempool.Lanes, readCfg.Mempool.Lanes)
+	assert.Equal(t, "default", readCfg.Mempool.DefaultLane)
+	require.NoError(t, readCfg.Mempool.ValidateBasic())
+}
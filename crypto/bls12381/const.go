@@ -1,5 +1,10 @@
 package bls12381
 
+import "errors"
+
+// ErrNoSignatures is returned when aggregating an empty list of signatures.
+var ErrNoSignatures = errors.New("no signatures to aggregate")
+
 const (
 	// PrivKeySize defines the length of the PrivKey byte array.
 	PrivKeySize = 32
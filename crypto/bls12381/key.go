@@ -103,3 +103,17 @@ func (PubKey) Type() string {
 func (PubKey) Equals(crypto.PubKey) bool {
 	panic("bls12_381 is disabled")
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// AggregateSignatures returns ErrDisabled.
+func AggregateSignatures([][]byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// VerifyAggregateSignature always returns false.
+func VerifyAggregateSignature([]byte, []PubKey, [][]byte) bool {
+	return false
+}
@@ -5,9 +5,11 @@ package bls12381
 import (
 	"bytes"
 	"crypto/sha256"
+	"errors"
 
 	"github.com/cometbft/cometbft/crypto"
 	bls12381 "github.com/cosmos/crypto/curves/bls12381"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
@@ -156,3 +158,66 @@ func (PubKey) Type() string {
 func (pubKey PubKey) Equals(other crypto.PubKey) bool {
 	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
 }
+
+// ===============================================================================================
+// Aggregation
+// ===============================================================================================
+
+// dst is the domain separation tag of the proof of possession scheme, which
+// is used by bls12381.SecretKey.Sign.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// AggregateSignatures aggregates the given signatures into a single signature,
+// which can be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, ErrNoSignatures
+	}
+	agg := new(blst.P2Aggregate)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies the aggregate sig of the signatures of
+// msgs by the corresponding pubKeys. As with Sign, a message larger than
+// MaxMsgLen is verified against its SHA256 sum.
+//
+// The public keys are not proven (e.g. by a proof of possession), so the
+// messages must be distinct, as in the basic scheme: otherwise, a rogue key
+// chosen to cancel out the other keys signing the same message could forge
+// the aggregate. Returns false if any message is repeated.
+func VerifyAggregateSignature(sig []byte, pubKeys []PubKey, msgs [][]byte) bool {
+	if len(sig) != SignatureLength || len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil { // bad signature
+		return false
+	}
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pks[i] = new(blst.P1Affine).Uncompress(pubKey)
+		if pks[i] == nil || !pks[i].KeyValidate() { // invalid pubkey
+			return false
+		}
+	}
+
+	blstMsgs := make([]blst.Message, len(msgs))
+	seen := make(map[string]struct{}, len(msgs))
+	for i, msg := range msgs {
+		if len(msg) > MaxMsgLen {
+			hash := sha256.Sum256(msg)
+			msg = hash[:]
+		}
+		if _, ok := seen[string(msg)]; ok { // repeated message
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+		blstMsgs[i] = msg
+	}
+
+	return signature.AggregateVerify(true, pks, false, blstMsgs, dst)
+}
@@ -125,3 +125,47 @@ func TestPubKeyType(t *testing.T) {
 
 	assert.Equal(t, "bls12_381", pubKey.Type())
 }
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 4
+	var (
+		privKeys = make([]bls12381.PrivKey, n)
+		pubKeys  = make([]bls12381.PubKey, n)
+		msgs     = make([][]byte, n)
+		sigs     = make([][]byte, n)
+	)
+	for i := 0; i < n; i++ {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		privKeys[i] = privKey
+		pubKeys[i] = privKey.PubKey().(bls12381.PubKey)
+		msgs[i] = crypto.CRandBytes(128)
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.Len(t, aggSig, bls12381.SignatureLength)
+	assert.True(t, bls12381.VerifyAggregateSignature(aggSig, pubKeys, msgs))
+
+	// Wrong message.
+	msgs[1] = crypto.CRandBytes(128)
+	assert.False(t, bls12381.VerifyAggregateSignature(aggSig, pubKeys, msgs))
+
+	// Missing signature.
+	aggSig, err = bls12381.AggregateSignatures(sigs[1:])
+	require.NoError(t, err)
+	assert.False(t, bls12381.VerifyAggregateSignature(aggSig, pubKeys, msgs))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	require.ErrorIs(t, err, bls12381.ErrNoSignatures)
+
+	// Repeated messages, which would allow rogue key attacks.
+	msgs[1] = msgs[0]
+	sigs[1], err = privKeys[1].Sign(msgs[1])
+	require.NoError(t, err)
+	aggSig, err = bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	assert.False(t, bls12381.VerifyAggregateSignature(aggSig, pubKeys, msgs))
+}
@@ -259,6 +259,16 @@ func (bA *BitArray) IsFull() bool {
 	return (lastElem+1)&((uint64(1)<<uint(lastElemBits))-1) == 0
 }
 
+// Count returns the number of bits set to 1 in the bit array.
+func (bA *BitArray) Count() int {
+	if bA == nil || bA.Bits == 0 {
+		return 0
+	}
+	bA.mtx.Lock()
+	defer bA.mtx.Unlock()
+	return bA.getNumTrueIndices()
+}
+
 // PickRandom returns a random index for a set bit in the bit array.
 // If there is no such value, it returns 0, false.
 // It uses the provided randomness to get this index.
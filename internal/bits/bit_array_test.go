@@ -174,7 +174,12 @@ func TestGetNumTrueIndices(t *testing.T) {
 		require.Equal(t, tc.ExpectedResult, result, "for input %s, expected %d, got %d", tc.Input, tc.ExpectedResult, result)
 		result = bitArr.Not().getNumTrueIndices()
 		require.Equal(t, bitArr.Bits-result, bitArr.getNumTrueIndices())
+		require.Equal(t, tc.ExpectedResult, bitArr.Count())
 	}
+
+	// Count does not panic on nil or empty bit arrays.
+	require.Equal(t, 0, (*BitArray)(nil).Count())
+	require.Equal(t, 0, NewBitArray(0).Count())
 }
 
 func TestGetNthTrueIndex(t *testing.T) {
@@ -44,6 +44,8 @@ type mempoolReactor interface {
 type peerError struct {
 	err    error
 	peerID p2p.ID
+	// the peer did not send the requested blocks in time
+	timeout bool
 }
 
 func (e peerError) Error() string {
@@ -246,6 +248,7 @@ func (bcR *Reactor) respondToPeer(msg *bcproto.BlockRequest, src p2p.Peer) (queu
 func (bcR *Reactor) Receive(e p2p.Envelope) {
 	if err := ValidateMsg(e.Message); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		bcR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 		bcR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -259,6 +262,7 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 		bi, err := types.BlockFromProto(msg.Block)
 		if err != nil {
 			bcR.Logger.Error("Peer sent us invalid block", "peer", e.Src, "msg", e.Message, "err", err)
+			bcR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 			bcR.Switch.StopPeerForError(e.Src, err)
 			return
 		}
@@ -270,6 +274,7 @@ func (bcR *Reactor) Receive(e p2p.Envelope) {
 				bcR.Logger.Error("failed to convert extended commit from proto",
 					"peer", e.Src,
 					"err", err)
+				bcR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 				bcR.Switch.StopPeerForError(e.Src, err)
 				return
 			}
@@ -389,7 +394,10 @@ FOR_LOOP:
 			// Try again quickly next loop.
 			didProcessCh <- struct{}{}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+			// The part set must be coded the same way as the one the commit
+			// of the first block was for.
+			parity := second.LastCommit.BlockID.PartSetHeader.Parity
+			firstParts, err := first.MakeCodedPartSet(types.BlockPartSizeBytes, parity)
 			if err != nil {
 				bcR.Logger.Error("failed to make ",
 					"height", first.Height,
@@ -458,6 +466,11 @@ func (bcR *Reactor) handleBlockRequestsRoutine() {
 		case err := <-bcR.errorsCh:
 			peer := bcR.Switch.Peers().Get(err.peerID)
 			if peer != nil {
+				if err.timeout {
+					bcR.Switch.ReportPeerEvent(peer, p2p.PeerEventTimeout)
+				} else {
+					bcR.Switch.ReportPeerEvent(peer, p2p.PeerEventInvalidMessage)
+				}
 				bcR.Switch.StopPeerForError(peer, err)
 			}
 		case <-statusUpdateTicker.C:
@@ -532,7 +545,7 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 
 	// Finally, verify the first block using the second's commit
 	// NOTE: we can probably make this more efficient, but note that calling
-	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
+	// first.Hash() doesn't verify the tx contents, so MakeCodedPartSet() is
 	// currently necessary.
 	// TODO(sergio): Should we also validate against the extended commit?
 	err := state.Validators.VerifyCommitLight(
@@ -558,6 +571,7 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 		if peer != nil {
 			// NOTE: we've already removed the peer's request, but we
 			// still need to clean up the rest.
+			bcR.Switch.ReportPeerEvent(peer, p2p.PeerEventInvalidMessage)
 			bcR.Switch.StopPeerForError(peer, ErrReactorValidation{Err: err})
 		}
 		peerID2 := bcR.pool.RemovePeerAndRedoAllPeerRequests(second.Height)
@@ -565,6 +579,7 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 		if peer2 != nil && peer2 != peer {
 			// NOTE: we've already removed the peer's request, but we
 			// still need to clean up the rest.
+			bcR.Switch.ReportPeerEvent(peer2, p2p.PeerEventInvalidMessage)
 			bcR.Switch.StopPeerForError(peer2, ErrReactorValidation{Err: err})
 		}
 		return state, err
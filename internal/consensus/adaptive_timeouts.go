@@ -0,0 +1,186 @@
+package consensus
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// adaptiveTimeoutsMinSamples is the minimum number of latencies observed for a
+// step before its timeout is derived from them, rather than taken from the
+// configuration.
+const adaptiveTimeoutsMinSamples = 10
+
+// adaptiveTimeouts computes the timeouts of the propose, prevote and
+// precommit steps.
+//
+// If adaptive timeouts are enabled, it records, for each step and over a
+// sliding window of heights, how long after entering the step we received:
+//   - the complete proposal, for the propose step;
+//   - +2/3 prevotes for a block or nil, for the prevote step;
+//   - +2/3 precommits for a block or nil, for the precommit step.
+//
+// The timeout of each step in round 0 is then the configured percentile of
+// its latencies, bounded by the configured floor and ceiling, to which the
+// configured delta is added for each round. Otherwise, it returns the
+// configured timeouts.
+//
+// NOTE: Not thread safe. Should only be used by functions downstream of the
+// cs.receiveRoutine.
+type adaptiveTimeouts struct {
+	config *cfg.ConsensusConfig
+
+	propose   *latencyWindow
+	prevote   *latencyWindow
+	precommit *latencyWindow
+}
+
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	size := max(int(config.AdaptiveTimeoutsWindow), 1)
+	return &adaptiveTimeouts{
+		config:    config,
+		propose:   newLatencyWindow(size),
+		prevote:   newLatencyWindow(size),
+		precommit: newLatencyWindow(size),
+	}
+}
+
+// window returns the latency window of the given step, or nil if its latency
+// is not tracked.
+func (at *adaptiveTimeouts) window(step cstypes.RoundStepType) *latencyWindow {
+	switch step {
+	case cstypes.RoundStepPropose:
+		return at.propose
+	case cstypes.RoundStepPrevote:
+		return at.prevote
+	case cstypes.RoundStepPrecommit:
+		return at.precommit
+	default:
+		return nil
+	}
+}
+
+// enterStep records that we entered the given step of height/round.
+func (at *adaptiveTimeouts) enterStep(height int64, round int32, step cstypes.RoundStepType) {
+	if w := at.window(step); w != nil && at.config.AdaptiveTimeouts {
+		w.start(height, round, cmttime.Now())
+	}
+}
+
+// observe records the latency of the given step of height/round, if we
+// entered it and no latency was recorded for this step at this height yet.
+func (at *adaptiveTimeouts) observe(height int64, round int32, step cstypes.RoundStepType) {
+	if w := at.window(step); w != nil && at.config.AdaptiveTimeouts {
+		w.observe(height, round, cmttime.Now())
+	}
+}
+
+// Propose returns the amount of time to wait for a proposal.
+func (at *adaptiveTimeouts) Propose(round int32) time.Duration {
+	base, ok := at.derive(at.propose, at.config.TimeoutProposeMin, at.config.TimeoutProposeMax)
+	if !ok {
+		return at.config.Propose(round)
+	}
+	return base + at.config.TimeoutProposeDelta*time.Duration(round)
+}
+
+// Prevote returns the amount of time to wait for straggler votes after
+// receiving any +2/3 prevotes.
+func (at *adaptiveTimeouts) Prevote(round int32) time.Duration {
+	base, ok := at.derive(at.prevote, at.config.TimeoutVoteMin, at.config.TimeoutVoteMax)
+	if !ok {
+		return at.config.Prevote(round)
+	}
+	return base + at.config.TimeoutVoteDelta*time.Duration(round)
+}
+
+// Precommit returns the amount of time to wait for straggler votes after
+// receiving any +2/3 precommits.
+func (at *adaptiveTimeouts) Precommit(round int32) time.Duration {
+	base, ok := at.derive(at.precommit, at.config.TimeoutVoteMin, at.config.TimeoutVoteMax)
+	if !ok {
+		return at.config.Precommit(round)
+	}
+	return base + at.config.TimeoutVoteDelta*time.Duration(round)
+}
+
+// RoundTimeouts returns the timeouts of the given round.
+func (at *adaptiveTimeouts) RoundTimeouts(round int32) cstypes.RoundTimeouts {
+	return cstypes.RoundTimeouts{
+		Propose:   at.Propose(round),
+		Prevote:   at.Prevote(round),
+		Precommit: at.Precommit(round),
+	}
+}
+
+// derive returns the configured percentile of the latencies in w, bounded by
+// floor and ceiling, or false if adaptive timeouts are disabled or there are
+// not enough latencies to derive a timeout from.
+func (at *adaptiveTimeouts) derive(w *latencyWindow, floor, ceiling time.Duration) (time.Duration, bool) {
+	if !at.config.AdaptiveTimeouts || w.len() < min(adaptiveTimeoutsMinSamples, len(w.samples)) {
+		return 0, false
+	}
+	d := w.percentile(at.config.AdaptiveTimeoutsPercentile)
+	return min(max(d, floor), ceiling), true
+}
+
+// latencyWindow holds the latencies of a step observed at the last heights,
+// at most one per height.
+type latencyWindow struct {
+	samples []time.Duration // ring buffer
+	next    int             // index of the next sample in samples
+	full    bool            // whether samples wrapped around
+
+	// the height/round whose step we entered last, and when
+	startHeight int64
+	startRound  int32
+	startTime   time.Time
+	// the last height for which a latency was recorded
+	lastHeight int64
+}
+
+func newLatencyWindow(size int) *latencyWindow {
+	return &latencyWindow{
+		samples: make([]time.Duration, size),
+	}
+}
+
+func (w *latencyWindow) start(height int64, round int32, t time.Time) {
+	w.startHeight, w.startRound, w.startTime = height, round, t
+}
+
+func (w *latencyWindow) observe(height int64, round int32, t time.Time) {
+	if w.startTime.IsZero() || height != w.startHeight || round != w.startRound || height <= w.lastHeight {
+		return
+	}
+	w.add(height, t.Sub(w.startTime))
+}
+
+func (w *latencyWindow) add(height int64, d time.Duration) {
+	w.samples[w.next] = max(d, 0)
+	w.next = (w.next + 1) % len(w.samples)
+	if w.next == 0 {
+		w.full = true
+	}
+	w.lastHeight = height
+}
+
+func (w *latencyWindow) len() int {
+	if w.full {
+		return len(w.samples)
+	}
+	return w.next
+}
+
+// percentile returns the p-th percentile, with p in (0, 1], of the latencies
+// in the window, which must not be empty, using the nearest-rank method.
+func (w *latencyWindow) percentile(p float64) time.Duration {
+	sorted := slices.Clone(w.samples[:w.len()])
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
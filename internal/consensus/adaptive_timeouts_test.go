@@ -0,0 +1,85 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+)
+
+func TestLatencyWindow(t *testing.T) {
+	w := newLatencyWindow(4)
+	start := time.Now()
+
+	// Not entered.
+	w.observe(1, 0, start)
+	assert.Equal(t, 0, w.len())
+
+	// Only the first latency of a height, in the round of the step entered,
+	// is recorded.
+	w.start(1, 0, start)
+	w.observe(1, 1, start.Add(time.Second))
+	w.observe(1, 0, start.Add(2*time.Second))
+	w.observe(1, 0, start.Add(3*time.Second))
+	require.Equal(t, 1, w.len())
+	assert.Equal(t, 2*time.Second, w.percentile(1))
+
+	w.start(1, 1, start)
+	w.observe(1, 1, start.Add(time.Second))
+	require.Equal(t, 1, w.len())
+
+	// The window slides.
+	for h := int64(2); h <= 6; h++ {
+		w.add(h, time.Duration(h)*time.Second)
+	}
+	require.Equal(t, 4, w.len())
+	assert.Equal(t, 3*time.Second, w.percentile(0.01))
+	assert.Equal(t, 4*time.Second, w.percentile(0.5))
+	assert.Equal(t, 5*time.Second, w.percentile(0.75))
+	assert.Equal(t, 6*time.Second, w.percentile(1))
+}
+
+func TestAdaptiveTimeouts(t *testing.T) {
+	config := cfg.TestConsensusConfig()
+	config.AdaptiveTimeouts = true
+	config.AdaptiveTimeoutsWindow = 20
+	config.AdaptiveTimeoutsPercentile = 0.9
+	config.TimeoutProposeMin = 100 * time.Millisecond
+	config.TimeoutProposeMax = time.Second
+	config.TimeoutVoteMin = 50 * time.Millisecond
+	config.TimeoutVoteMax = 200 * time.Millisecond
+	at := newAdaptiveTimeouts(config)
+
+	// Not enough latencies: the configured timeouts are used.
+	for h := int64(1); h < adaptiveTimeoutsMinSamples; h++ {
+		at.propose.add(h, 300*time.Millisecond)
+		at.prevote.add(h, 300*time.Millisecond)
+		at.precommit.add(h, 10*time.Millisecond)
+	}
+	assert.Equal(t, cstypes.RoundTimeouts{
+		Propose:   config.Propose(1),
+		Prevote:   config.Prevote(1),
+		Precommit: config.Precommit(1),
+	}, at.RoundTimeouts(1))
+
+	at.propose.add(adaptiveTimeoutsMinSamples, 300*time.Millisecond)
+	at.prevote.add(adaptiveTimeoutsMinSamples, 300*time.Millisecond)
+	at.precommit.add(adaptiveTimeoutsMinSamples, 10*time.Millisecond)
+	assert.Equal(t, cstypes.RoundTimeouts{
+		Propose:   300 * time.Millisecond,
+		Prevote:   200 * time.Millisecond, // ceiling
+		Precommit: 50 * time.Millisecond,  // floor
+	}, at.RoundTimeouts(0))
+	assert.Equal(t, 300*time.Millisecond+2*config.TimeoutProposeDelta, at.Propose(2))
+	assert.Equal(t, 200*time.Millisecond+2*config.TimeoutVoteDelta, at.Prevote(2))
+
+	// Disabled: the configured timeouts are used.
+	config.AdaptiveTimeouts = false
+	assert.Equal(t, config.Propose(0), at.Propose(0))
+	assert.Equal(t, config.Prevote(0), at.Prevote(0))
+	assert.Equal(t, config.Precommit(0), at.Precommit(0))
+}
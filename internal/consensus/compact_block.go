@@ -0,0 +1,373 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v1"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Compact blocks
+//
+// When compact blocks are enabled, a node that has a complete proposal block
+// sends to each peer that also enables them, and that has the proposal but
+// none of the block parts, a CompactBlockMessage: the block without its
+// transactions, along with the ordered keys of the transactions. The peer looks
+// up the transactions in its mempool, requests the missing ones with a
+// CompactBlockTxsRequestMessage, and rebuilds the block parts, which are then
+// handled by the consensus state as if they had been received from the peer.
+//
+// If the peer cannot rebuild a block whose part set header matches the one of
+// the compact block, it requests the block parts instead. The sender also
+// falls back to sending the block parts if the peer does not receive them
+// within CompactBlockTimeout.
+
+// compactBlockCache is the compact block built for the last proposal block
+// gossiped, which is the same for all peers.
+type compactBlockCache struct {
+	partSetHeader types.PartSetHeader
+	msg           *cmtcons.CompactBlock // nil if too large to be sent
+}
+
+// compactBlocks returns true if compact blocks are enabled.
+func (conR *Reactor) compactBlocks() bool {
+	return conR.conS.config.CompactBlocks && conR.mempool != nil
+}
+
+// peerSupportsCompactBlocks returns true if peer advertises
+// CompactBlockChannel, that is, if it enables compact blocks.
+func peerSupportsCompactBlocks(peer p2p.Peer) bool {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(CompactBlockChannel)
+}
+
+// gossipCompactBlock sends the compact block of our proposal block to the peer
+// if it has the proposal but none of the block parts, and we did not send it
+// already. It returns true if the compact block was sent.
+func (conR *Reactor) gossipCompactBlock(
+	logger log.Logger,
+	rs *cstypes.RoundState,
+	ps *PeerState,
+	prs *cstypes.PeerRoundState,
+) bool {
+	if rs.ProposalBlock == nil || rs.ProposalBlockParts == nil || !rs.ProposalBlockParts.IsComplete() {
+		return false
+	}
+	if rs.Height != prs.Height || !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) ||
+		prs.ProposalBlockParts == nil || !prs.ProposalBlockParts.IsEmpty() {
+		return false
+	}
+	psh := rs.ProposalBlockParts.Header()
+	if ps.hasSentCompactBlock(psh) {
+		return false
+	}
+
+	msg := conR.getCompactBlock(rs)
+	if msg == nil {
+		return false
+	}
+	if !ps.peer.Send(p2p.Envelope{ChannelID: DataChannel, Message: msg}) {
+		return false
+	}
+	ps.setCompactBlockSent(psh, time.Now())
+	logger.Debug("Sent compact block", "height", rs.Height, "round", rs.Round, "txs", len(msg.TxKeys))
+	return true
+}
+
+// getCompactBlock returns the compact block of the proposal block in rs, or nil
+// if it cannot be sent, building it if needed.
+func (conR *Reactor) getCompactBlock(rs *cstypes.RoundState) *cmtcons.CompactBlock {
+	psh := rs.ProposalBlockParts.Header()
+
+	conR.compactBlockMtx.Lock()
+	defer conR.compactBlockMtx.Unlock()
+
+	if conR.compactBlock != nil && conR.compactBlock.partSetHeader.Equals(psh) {
+		return conR.compactBlock.msg
+	}
+
+	msg, err := makeCompactBlock(rs.Height, rs.Round, psh, rs.ProposalBlock)
+	if err != nil {
+		conR.Logger.Error("Failed to make compact block", "height", rs.Height, "err", err)
+		msg = nil
+	} else if size := proto.Size(msg.Wrap()); size > maxMsgSize {
+		conR.Logger.Debug("Compact block too large, sending block parts", "height", rs.Height, "size", size)
+		msg = nil
+	}
+	conR.compactBlock = &compactBlockCache{partSetHeader: psh, msg: msg}
+	return msg
+}
+
+// makeCompactBlock returns the compact block of block.
+func makeCompactBlock(height int64, round int32, psh types.PartSetHeader, block *types.Block) (*cmtcons.CompactBlock, error) {
+	txKeys := make([]types.TxKey, len(block.Txs))
+	for i, tx := range block.Txs {
+		txKeys[i] = tx.Key()
+	}
+	pb, err := MsgToWrappedProto(&CompactBlockMessage{
+		Height:             height,
+		Round:              round,
+		BlockPartSetHeader: psh,
+		Block: &types.Block{
+			Header:     block.Header,
+			Evidence:   block.Evidence,
+			LastCommit: block.LastCommit,
+		},
+		TxKeys: txKeys,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pb.GetCompactBlock(), nil
+}
+
+// handleCompactBlock rebuilds the proposal block from a compact block sent by
+// peer and the transactions in the mempool, or requests the transactions
+// missing from the mempool from peer.
+func (conR *Reactor) handleCompactBlock(peer p2p.Peer, ps *PeerState, msg *CompactBlockMessage) {
+	if !conR.compactBlocks() {
+		conR.Logger.Debug("Ignoring compact block, compact blocks are disabled", "peer", peer)
+		return
+	}
+	rs := conR.getRoundState()
+	if msg.Height != rs.Height || (rs.ProposalBlockParts != nil && rs.ProposalBlockParts.IsComplete()) {
+		return
+	}
+
+	pending := newPendingCompactBlock(msg, conR.mempool)
+	missing := pending.missing()
+	if len(missing) == 0 {
+		conR.rebuildCompactBlock(peer, ps, pending)
+		return
+	}
+
+	conR.Metrics.CompactBlockMissingTxs.Add(float64(len(missing)))
+	ps.setPendingCompactBlock(pending)
+	peer.Send(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message: &cmtcons.CompactBlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: missing,
+		},
+	})
+}
+
+// handleCompactBlockTxs rebuilds the proposal block from the pending compact
+// block sent by peer, once peer sent the missing transactions.
+func (conR *Reactor) handleCompactBlockTxs(peer p2p.Peer, ps *PeerState, msg *CompactBlockTxsMessage) {
+	pending := ps.takePendingCompactBlock(msg.Height, msg.Round)
+	if pending == nil {
+		// We did not request these transactions, or we requested them for a
+		// compact block that was since replaced.
+		return
+	}
+	if rs := conR.getRoundState(); msg.Height != rs.Height {
+		return
+	}
+
+	for i, index := range msg.Indexes {
+		if int(index) < len(pending.txs) && msg.Txs[i].Key() == pending.msg.TxKeys[index] {
+			pending.txs[index] = msg.Txs[i]
+		}
+	}
+	conR.rebuildCompactBlock(peer, ps, pending)
+}
+
+// handleCompactBlockTxsRequest sends to peer the transactions of the compact
+// block we sent it that are missing from its mempool. If peer could not
+// rebuild the block, or we cannot send the transactions, we stop waiting and
+// send it the block parts.
+func (conR *Reactor) handleCompactBlockTxsRequest(peer p2p.Peer, ps *PeerState, msg *CompactBlockTxsRequestMessage) {
+	rs := conR.getRoundState()
+	if msg.Fallback || rs.Height != msg.Height || rs.ProposalBlock == nil ||
+		!ps.hasSentCompactBlock(rs.ProposalBlockParts.Header()) {
+		ps.stopWaitingForCompactBlock()
+		return
+	}
+
+	txs := rs.ProposalBlock.Txs
+	resp := &cmtcons.CompactBlockTxs{
+		Height:  msg.Height,
+		Round:   msg.Round,
+		Indexes: make([]int32, 0, len(msg.Indexes)),
+		Txs:     make([][]byte, 0, len(msg.Indexes)),
+	}
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			ps.stopWaitingForCompactBlock()
+			return
+		}
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, txs[index])
+	}
+	if proto.Size(resp.Wrap()) > maxMsgSize ||
+		!peer.Send(p2p.Envelope{ChannelID: CompactBlockChannel, Message: resp}) {
+		ps.stopWaitingForCompactBlock()
+	}
+}
+
+// rebuildCompactBlock rebuilds the block parts of a compact block sent by peer
+// and passes them to the consensus state. If the block cannot be rebuilt, it
+// requests the block parts from peer instead.
+func (conR *Reactor) rebuildCompactBlock(peer p2p.Peer, ps *PeerState, pending *pendingCompactBlock) {
+	msg := pending.msg
+	parts, err := pending.rebuild()
+	if err != nil {
+		conR.Logger.Info("Failed to rebuild compact block, requesting block parts",
+			"peer", peer, "height", msg.Height, "round", msg.Round, "err", err)
+		conR.Metrics.CompactBlocksReceived.With("status", "fallback").Add(1)
+		peer.TrySend(p2p.Envelope{
+			ChannelID: CompactBlockChannel,
+			Message: &cmtcons.CompactBlockTxsRequest{
+				Height:   msg.Height,
+				Round:    msg.Round,
+				Fallback: true,
+			},
+		})
+		return
+	}
+	conR.Metrics.CompactBlocksReceived.With("status", "rebuilt").Add(1)
+
+	// The peer has the whole block, so it does not need any of its parts.
+	prs := ps.GetRoundState()
+	peerHasBlock := prs.ProposalBlockPartSetHeader.Equals(msg.BlockPartSetHeader)
+	for i := 0; i < int(parts.Total()); i++ {
+		if peerHasBlock {
+			ps.SetHasProposalBlockPart(prs.Height, prs.Round, i)
+		}
+		part := parts.GetPart(i)
+		conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{msg.Height, msg.Round, part}, peer.ID(), time.Time{}}
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// pendingCompactBlock is a compact block received from a peer, along with the
+// transactions of the block collected so far.
+type pendingCompactBlock struct {
+	msg *CompactBlockMessage
+	txs []types.Tx // nil if missing
+}
+
+// newPendingCompactBlock returns a pendingCompactBlock for msg, with the
+// transactions found in mempool.
+func newPendingCompactBlock(msg *CompactBlockMessage, mempool mempl.Mempool) *pendingCompactBlock {
+	txs := make([]types.Tx, len(msg.TxKeys))
+	for i, key := range msg.TxKeys {
+		txs[i] = mempool.GetTxByHash(key[:])
+	}
+	return &pendingCompactBlock{msg: msg, txs: txs}
+}
+
+// missing returns the indexes of the missing transactions.
+func (p *pendingCompactBlock) missing() []int32 {
+	var indexes []int32
+	for i, tx := range p.txs {
+		if tx == nil {
+			indexes = append(indexes, int32(i))
+		}
+	}
+	return indexes
+}
+
+// rebuild returns the part set of the block rebuilt from the compact block and
+// its transactions, or an error if a transaction is missing or if the part set
+// header does not match the one of the compact block.
+func (p *pendingCompactBlock) rebuild() (*types.PartSet, error) {
+	if missing := p.missing(); len(missing) > 0 {
+		return nil, fmt.Errorf("missing %d txs", len(missing))
+	}
+
+	b := p.msg.Block
+	block := &types.Block{
+		Header:     b.Header,
+		Data:       types.Data{Txs: p.txs},
+		Evidence:   b.Evidence,
+		LastCommit: b.LastCommit,
+	}
+	parts, err := block.MakeCodedPartSet(types.BlockPartSizeBytes, p.msg.BlockPartSetHeader.Parity)
+	if err != nil {
+		return nil, err
+	}
+	if !parts.HasHeader(p.msg.BlockPartSetHeader) {
+		return nil, errors.New("rebuilt block does not match the part set header")
+	}
+	return parts, nil
+}
+
+// -----------------------------------------------------------------------------
+
+// hasSentCompactBlock returns true if we sent the peer the compact block of
+// the block with the given part set header.
+func (ps *PeerState) hasSentCompactBlock(psh types.PartSetHeader) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return ps.compactBlockSent.Equals(psh)
+}
+
+// setCompactBlockSent records that we sent the peer the compact block of the
+// block with the given part set header at time t.
+func (ps *PeerState) setCompactBlockSent(psh types.PartSetHeader, t time.Time) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlockSent = psh
+	ps.compactBlockSentAt = t
+}
+
+// stopWaitingForCompactBlock falls back to sending the block parts to the
+// peer.
+func (ps *PeerState) stopWaitingForCompactBlock() {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlockSentAt = time.Time{}
+}
+
+// waitingForCompactBlock returns true if the peer is rebuilding our proposal
+// block from the compact block we sent it less than timeout ago.
+func (ps *PeerState) waitingForCompactBlock(rs *cstypes.RoundState, timeout time.Duration) bool {
+	if rs.ProposalBlockParts == nil {
+		return false
+	}
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	return !ps.compactBlockSentAt.IsZero() &&
+		ps.compactBlockSent.Equals(rs.ProposalBlockParts.Header()) &&
+		time.Since(ps.compactBlockSentAt) < timeout
+}
+
+// setPendingCompactBlock records a compact block received from the peer, whose
+// missing transactions were requested.
+func (ps *PeerState) setPendingCompactBlock(pending *pendingCompactBlock) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlockPending = pending
+}
+
+// takePendingCompactBlock returns and clears the pending compact block
+// received from the peer, if it is for the given height and round.
+func (ps *PeerState) takePendingCompactBlock(height int64, round int32) *pendingCompactBlock {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	pending := ps.compactBlockPending
+	if pending == nil || pending.msg.Height != height || pending.msg.Round != round {
+		return nil
+	}
+	ps.compactBlockPending = nil
+	return pending
+}
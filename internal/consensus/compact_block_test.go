@@ -0,0 +1,85 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/types"
+)
+
+// txsMempool is a mempool that only contains the given transactions.
+type txsMempool struct {
+	emptyMempool
+	txs map[types.TxKey]types.Tx
+}
+
+func (m txsMempool) GetTxByHash(hash []byte) types.Tx { return m.txs[types.TxKey(hash)] }
+
+func TestCompactBlockRebuild(t *testing.T) {
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3"), types.Tx("d=4"), types.Tx("e=5")}
+	block := types.MakeBlock(1, txs, &types.Commit{}, nil)
+	block.ProposerAddress = cmtrand.Bytes(crypto.AddressSize)
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	pb, err := makeCompactBlock(1, 0, parts.Header(), block)
+	require.NoError(t, err)
+	msg, err := MsgFromProto(pb)
+	require.NoError(t, err)
+	compactBlock, ok := msg.(*CompactBlockMessage)
+	require.True(t, ok)
+	require.Len(t, compactBlock.TxKeys, len(txs))
+	require.Empty(t, compactBlock.Block.Txs)
+
+	mempool := txsMempool{txs: make(map[types.TxKey]types.Tx)}
+	for _, i := range []int{0, 2, 4} {
+		mempool.txs[txs[i].Key()] = txs[i]
+	}
+	pending := newPendingCompactBlock(compactBlock, mempool)
+	require.Equal(t, []int32{1, 3}, pending.missing())
+	_, err = pending.rebuild()
+	require.Error(t, err)
+
+	// Once the missing txs are received, the same parts are rebuilt.
+	pending.txs[1], pending.txs[3] = txs[1], txs[3]
+	rebuilt, err := pending.rebuild()
+	require.NoError(t, err)
+	require.Equal(t, parts.Header(), rebuilt.Header())
+	for i := 0; i < int(parts.Total()); i++ {
+		assert.Equal(t, parts.GetPart(i).Bytes, rebuilt.GetPart(i).Bytes)
+	}
+
+	// A different tx, as if the mempool had a tx with the same key, does not
+	// rebuild the block.
+	pending.txs[3] = types.Tx("d=5")
+	_, err = pending.rebuild()
+	require.Error(t, err)
+}
+
+func TestCompactBlockTxsMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		malleateFn func(*CompactBlockTxsMessage)
+		expErr     bool
+	}{
+		{func(_ *CompactBlockTxsMessage) {}, false},
+		{func(msg *CompactBlockTxsMessage) { msg.Height = 0 }, true},
+		{func(msg *CompactBlockTxsMessage) { msg.Round = -1 }, true},
+		{func(msg *CompactBlockTxsMessage) { msg.Indexes = []int32{-1} }, true},
+		{func(msg *CompactBlockTxsMessage) { msg.Txs = nil }, true},
+	}
+
+	for _, tc := range testCases {
+		msg := &CompactBlockTxsMessage{
+			Height:  1,
+			Round:   0,
+			Indexes: []int32{0},
+			Txs:     types.Txs{types.Tx("a=1")},
+		}
+		tc.malleateFn(msg)
+		assert.Equal(t, tc.expErr, msg.ValidateBasic() != nil)
+	}
+}
@@ -22,6 +22,9 @@ var (
 	ErrSignatureFoundInPastBlocks = errors.New("found signature from the same key")
 	ErrPubKeyIsNotSet             = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
 	ErrProposalTooManyParts       = errors.New("proposal block has too many parts")
+	ErrTimelineTraceDisabled      = errors.New("consensus timeline trace is disabled")
+	ErrTimelineNotFound           = errors.New("consensus timeline not found")
+	ErrMisbehaviorsDisabled       = errors.New("misbehaviors are only supported by binaries built with the e2e tag")
 )
 
 type ErrConsensusMessageNotRecognized struct {
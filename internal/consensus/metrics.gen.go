@@ -150,12 +150,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: stdprometheus.ExponentialBucketsRange(0.1, 100, 8),
 		}, append(labels, "step")).With(labelsAndValues...),
+		StepTimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "step_timeout_seconds",
+			Help:      "Timeout of the propose, prevote and precommit steps in effect for the current round, in seconds. With adaptive timeouts, it is derived from the observed latencies.",
+		}, append(labels, "step")).With(labelsAndValues...),
 		BlockGossipPartsReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "block_gossip_parts_received",
 			Help:      "Number of block parts received by the node, separated by whether the part was relevant to the block the node is trying to gather or not.",
 		}, append(labels, "matches_current")).With(labelsAndValues...),
+		CompactBlocksReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks_received",
+			Help:      "Number of compact blocks received by the node, separated by whether the block could be rebuilt from the mempool (rebuilt) or the node fell back to receiving its parts (fallback).",
+		}, append(labels, "status")).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of transactions of the compact blocks received by the node that were missing from the mempool and requested from the sender.",
+		}, labels).With(labelsAndValues...),
 		QuorumPrevoteDelay: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -233,7 +251,10 @@ func NopMetrics() *Metrics {
 		DuplicateBlockPart:          discard.NewCounter(),
 		DuplicateVote:               discard.NewCounter(),
 		StepDurationSeconds:         discard.NewHistogram(),
+		StepTimeoutSeconds:          discard.NewGauge(),
 		BlockGossipPartsReceived:    discard.NewCounter(),
+		CompactBlocksReceived:       discard.NewCounter(),
+		CompactBlockMissingTxs:      discard.NewCounter(),
 		QuorumPrevoteDelay:          discard.NewGauge(),
 		FullPrevoteDelay:            discard.NewGauge(),
 		VoteExtensionReceiveCount:   discard.NewCounter(),
@@ -77,10 +77,24 @@ type Metrics struct {
 	StepDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.1, 100, 8" metrics_buckettype:"exprange" metrics_labels:"step"`
 	stepStart           time.Time
 
+	// Timeout of the propose, prevote and precommit steps in effect for the
+	// current round, in seconds. With adaptive timeouts, it is derived from the
+	// observed latencies.
+	StepTimeoutSeconds metrics.Gauge `metrics_labels:"step"`
+
 	// Number of block parts received by the node, separated by whether the part
 	// was relevant to the block the node is trying to gather or not.
 	BlockGossipPartsReceived metrics.Counter `metrics_labels:"matches_current"`
 
+	// Number of compact blocks received by the node, separated by whether the
+	// block could be rebuilt from the mempool (rebuilt) or the node fell back
+	// to receiving its parts (fallback).
+	CompactBlocksReceived metrics.Counter `metrics_labels:"status"`
+
+	// Number of transactions of the compact blocks received by the node that
+	// were missing from the mempool and requested from the sender.
+	CompactBlockMissingTxs metrics.Counter
+
 	// QuroumPrevoteMessageDelay is the interval in seconds between the proposal
 	// timestamp and the timestamp of the earliest prevote that achieved a quorum
 	// during the prevote step.
@@ -181,6 +195,12 @@ func (m *Metrics) MarkLateVote(vt types.SignedMsgType) {
 	m.LateVotes.With("vote_type", n).Add(1)
 }
 
+func (m *Metrics) MarkTimeouts(timeouts cstypes.RoundTimeouts) {
+	m.StepTimeoutSeconds.With("step", "Propose").Set(timeouts.Propose.Seconds())
+	m.StepTimeoutSeconds.With("step", "Prevote").Set(timeouts.Prevote.Seconds())
+	m.StepTimeoutSeconds.With("step", "Precommit").Set(timeouts.Precommit.Seconds())
+}
+
 func (m *Metrics) MarkStep(s cstypes.RoundStepType) {
 	if !m.stepStart.IsZero() {
 		stepTime := cmttime.Since(m.stepStart).Seconds()
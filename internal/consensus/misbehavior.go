@@ -0,0 +1,38 @@
+package consensus
+
+import "fmt"
+
+// Misbehavior is a byzantine behavior a validator can be made to adopt at
+// given heights, to test that the network detects it, punishes it or makes
+// progress despite it. Misbehaviors are only supported by binaries built with
+// the e2e tag, such as the e2e node.
+type Misbehavior string
+
+const (
+	// MisbehaviorDoublePrevote sends each peer, along with the prevote, a
+	// conflicting prevote of the same round.
+	MisbehaviorDoublePrevote Misbehavior = "double-prevote"
+	// MisbehaviorDoublePrecommit sends each peer, along with the precommit, a
+	// conflicting precommit of the same round.
+	MisbehaviorDoublePrecommit Misbehavior = "double-precommit"
+	// MisbehaviorConflictingProposal proposes, when the validator is the
+	// proposer, a block to half of the peers and a different one to the other
+	// half.
+	MisbehaviorConflictingProposal Misbehavior = "conflicting-proposal"
+	// MisbehaviorInvalidBlockParts proposes, when the validator is the
+	// proposer, a block whose parts are corrupted.
+	MisbehaviorInvalidBlockParts Misbehavior = "invalid-block-parts"
+	// MisbehaviorWithholdVotes does not sign nor send any vote.
+	MisbehaviorWithholdVotes Misbehavior = "withhold-votes"
+)
+
+// ParseMisbehavior returns the misbehavior named s.
+func ParseMisbehavior(s string) (Misbehavior, error) {
+	switch m := Misbehavior(s); m {
+	case MisbehaviorDoublePrevote, MisbehaviorDoublePrecommit, MisbehaviorConflictingProposal,
+		MisbehaviorInvalidBlockParts, MisbehaviorWithholdVotes:
+		return m, nil
+	default:
+		return "", fmt.Errorf("unknown misbehavior %q", s)
+	}
+}
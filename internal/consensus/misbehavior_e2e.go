@@ -0,0 +1,246 @@
+//go:build e2e
+// +build e2e
+
+package consensus
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	cmtcons "github.com/cometbft/cometbft/api/cometbft/consensus/v1"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// misbehaviors are the byzantine behaviors of the validator, by height.
+type misbehaviors struct {
+	byHeight map[int64]Misbehavior
+	// signs the conflicting votes and proposals with the validator key,
+	// bypassing the double signing protection of the private validator
+	signer types.PrivValidator
+	// sends the conflicting messages to the peers directly
+	conR *Reactor
+}
+
+// SetMisbehaviors makes the validator, whose private key is privKey, adopt the
+// given byzantine behaviors at the given heights. It must be called before the
+// reactor is started.
+func (conR *Reactor) SetMisbehaviors(byHeight map[int64]Misbehavior, privKey crypto.PrivKey) error {
+	for _, m := range byHeight {
+		if _, err := ParseMisbehavior(string(m)); err != nil {
+			return err
+		}
+	}
+	cs := conR.conS
+	cs.misbehaviors = &misbehaviors{
+		byHeight: byHeight,
+		signer:   types.NewMockPVWithParams(privKey, false, false),
+		conR:     conR,
+	}
+	cs.decideProposal = cs.misbehavingDecideProposal(cs.decideProposal)
+	return nil
+}
+
+// misbehaviorAt returns the misbehavior of the validator at height, if any.
+func (cs *State) misbehaviorAt(height int64) Misbehavior {
+	if cs.misbehaviors == nil {
+		return ""
+	}
+	return cs.misbehaviors.byHeight[height]
+}
+
+// withholdVote returns whether the validator must not sign its vote at the
+// current height.
+func (cs *State) withholdVote() bool {
+	if cs.misbehaviorAt(cs.Height) != MisbehaviorWithholdVotes {
+		return false
+	}
+	cs.Logger.Info("misbehavior: withholding vote", "height", cs.Height, "round", cs.Round)
+	return true
+}
+
+// sendConflictingVote sends the peers, along with vote, a conflicting vote if
+// the validator double votes at the height of vote. Both votes are sent
+// directly, so that every peer receives both and reports the duplicate vote
+// evidence.
+func (cs *State) sendConflictingVote(vote *types.Vote) {
+	switch m := cs.misbehaviorAt(vote.Height); {
+	case m == MisbehaviorDoublePrevote && vote.Type == types.PrevoteType:
+	case m == MisbehaviorDoublePrecommit && vote.Type == types.PrecommitType:
+	default:
+		return
+	}
+
+	conflicting := &types.Vote{
+		ValidatorAddress: vote.ValidatorAddress,
+		ValidatorIndex:   vote.ValidatorIndex,
+		Height:           vote.Height,
+		Round:            vote.Round,
+		Timestamp:        vote.Timestamp,
+		Type:             vote.Type,
+	}
+	var block *types.Block
+	switch {
+	case !vote.BlockID.IsNil():
+		// Vote nil.
+	case cs.ProposalBlock != nil:
+		block = cs.ProposalBlock
+		conflicting.BlockID = types.BlockID{Hash: block.Hash(), PartSetHeader: cs.ProposalBlockParts.Header()}
+	default:
+		conflicting.BlockID = types.BlockID{
+			Hash:          crypto.CRandBytes(tmhash.Size),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: crypto.CRandBytes(tmhash.Size)},
+		}
+	}
+
+	extEnabled := vote.Type == types.PrecommitType && cs.state.ConsensusParams.Feature.VoteExtensionsEnabled(vote.Height)
+	if extEnabled && !conflicting.BlockID.IsNil() {
+		if block == nil {
+			cs.Logger.Error("misbehavior: cannot extend a conflicting precommit for an unknown block",
+				"height", vote.Height, "round", vote.Round)
+			return
+		}
+		ext, err := cs.blockExec.ExtendVote(context.TODO(), conflicting, block, cs.state)
+		if err != nil {
+			cs.Logger.Error("misbehavior: failed extending conflicting vote", "height", vote.Height, "round", vote.Round, "err", err)
+			return
+		}
+		conflicting.Extension = ext
+	}
+	if _, err := types.SignAndCheckVote(conflicting, cs.misbehaviors.signer, cs.state.ChainID, extEnabled); err != nil {
+		cs.Logger.Error("misbehavior: failed signing conflicting vote", "height", vote.Height, "round", vote.Round, "err", err)
+		return
+	}
+
+	cs.Logger.Info("misbehavior: double voting", "vote", vote, "conflicting", conflicting)
+	cs.misbehaviors.send(func(int) bool { return true }, VoteChannel,
+		&cmtcons.Vote{Vote: vote.ToProto()}, &cmtcons.Vote{Vote: conflicting.ToProto()})
+}
+
+// misbehavingDecideProposal wraps decideProposal to propose conflicting blocks,
+// or a block with invalid parts, at the heights of these misbehaviors.
+func (cs *State) misbehavingDecideProposal(decideProposal func(int64, int32)) func(int64, int32) {
+	return func(height int64, round int32) {
+		m := cs.misbehaviorAt(height)
+		if (m != MisbehaviorConflictingProposal && m != MisbehaviorInvalidBlockParts) || cs.ValidBlock != nil {
+			decideProposal(height, round)
+			return
+		}
+
+		block, err := cs.createProposalBlock(context.TODO())
+		if err != nil {
+			cs.Logger.Error("misbehavior: unable to create proposal block", "error", err)
+			return
+		}
+		proposal, parts, err := cs.signMisbehavingProposal(height, round, block)
+		if err != nil {
+			cs.Logger.Error("misbehavior: unable to sign proposal", "height", height, "round", round, "err", err)
+			return
+		}
+
+		switch m {
+		case MisbehaviorConflictingProposal:
+			conflictingProposal, conflictingParts, err := cs.signMisbehavingProposal(height, round, cs.makeConflictingBlock(block))
+			if err != nil {
+				cs.Logger.Error("misbehavior: unable to sign conflicting proposal", "height", height, "round", round, "err", err)
+				return
+			}
+
+			cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cmttime.Now()})
+			for _, part := range parts {
+				cs.sendInternalMessage(msgInfo{&BlockPartMessage{height, round, part}, "", time.Time{}})
+			}
+			cs.Logger.Info("misbehavior: proposing conflicting blocks",
+				"height", height, "round", round, "proposal", proposal, "conflicting", conflictingProposal)
+			cs.misbehaviors.send(func(i int) bool { return i%2 == 0 }, DataChannel,
+				proposalMessages(height, round, proposal, parts)...)
+			cs.misbehaviors.send(func(i int) bool { return i%2 == 1 }, DataChannel,
+				proposalMessages(height, round, conflictingProposal, conflictingParts)...)
+
+		case MisbehaviorInvalidBlockParts:
+			// The valid parts are not added, otherwise they would be gossiped.
+			corrupted := make([]*types.Part, len(parts))
+			for i, part := range parts {
+				bz := append([]byte(nil), part.Bytes...)
+				bz[0] ^= 0xff
+				corrupted[i] = &types.Part{Index: part.Index, Bytes: bz, Proof: part.Proof}
+			}
+
+			cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, "", cmttime.Now()})
+			cs.Logger.Info("misbehavior: proposing invalid block parts", "height", height, "round", round, "proposal", proposal)
+			cs.misbehaviors.send(func(int) bool { return true }, DataChannel,
+				proposalMessages(height, round, proposal, corrupted)...)
+		}
+	}
+}
+
+// makeConflictingBlock returns a block of the same height as block, but
+// without its last transaction or, if it has none, with a later time.
+func (cs *State) makeConflictingBlock(block *types.Block) *types.Block {
+	txs, timestamp := block.Txs, block.Time
+	if len(txs) > 0 {
+		txs = txs[:len(txs)-1]
+	} else {
+		timestamp = timestamp.Add(time.Millisecond)
+	}
+	conflicting := cs.state.MakeBlock(block.Height, txs, block.LastCommit, block.Evidence.Evidence, block.ProposerAddress)
+	conflicting.Time = timestamp
+	return conflicting
+}
+
+// signMisbehavingProposal returns the proposal of block, signed without the
+// double signing protection of the private validator, along with its parts.
+func (cs *State) signMisbehavingProposal(height int64, round int32, block *types.Block) (*types.Proposal, []*types.Part, error) {
+	parity := types.NumParityParts(block.Size(), types.BlockPartSizeBytes, cs.config.BlockPartsParityRatio)
+	partSet, err := block.MakeCodedPartSet(types.BlockPartSizeBytes, parity)
+	if err != nil {
+		return nil, nil, err
+	}
+	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID, block.Header.Time)
+	p := proposal.ToProto()
+	if err := cs.misbehaviors.signer.SignProposal(cs.state.ChainID, p); err != nil {
+		return nil, nil, err
+	}
+	proposal.Signature = p.Signature
+
+	parts := make([]*types.Part, partSet.Total())
+	for i := range parts {
+		parts[i] = partSet.GetPart(i)
+	}
+	return proposal, parts, nil
+}
+
+// proposalMessages returns the messages sending proposal and its parts.
+func proposalMessages(height int64, round int32, proposal *types.Proposal, parts []*types.Part) []proto.Message {
+	msgs := []proto.Message{&cmtcons.Proposal{Proposal: *proposal.ToProto()}}
+	for _, part := range parts {
+		pp, err := part.ToProto()
+		if err != nil {
+			panic(err)
+		}
+		msgs = append(msgs, &cmtcons.BlockPart{Height: height, Round: round, Part: *pp})
+	}
+	return msgs
+}
+
+// send sends msgs, in order, on channel chID to the peers whose index in the
+// list of peers satisfies include. It does not block.
+func (m *misbehaviors) send(include func(i int) bool, chID byte, msgs ...proto.Message) {
+	peers := m.conR.Switch.Peers().Copy()
+	go func() {
+		for i, peer := range peers {
+			if !include(i) {
+				continue
+			}
+			for _, msg := range msgs {
+				peer.Send(p2p.Envelope{ChannelID: chID, Message: msg})
+			}
+		}
+	}()
+}
@@ -0,0 +1,22 @@
+//go:build !e2e
+// +build !e2e
+
+package consensus
+
+import (
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/types"
+)
+
+// misbehaviors is empty, as misbehaviors are not compiled in.
+type misbehaviors struct{}
+
+// SetMisbehaviors returns ErrMisbehaviorsDisabled, as misbehaviors are only
+// supported by binaries built with the e2e tag.
+func (*Reactor) SetMisbehaviors(map[int64]Misbehavior, crypto.PrivKey) error {
+	return ErrMisbehaviorsDisabled
+}
+
+func (*State) withholdVote() bool { return false }
+
+func (*State) sendConflictingVote(*types.Vote) {}
@@ -0,0 +1,25 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMisbehavior(t *testing.T) {
+	for _, m := range []Misbehavior{
+		MisbehaviorDoublePrevote,
+		MisbehaviorDoublePrecommit,
+		MisbehaviorConflictingProposal,
+		MisbehaviorInvalidBlockParts,
+		MisbehaviorWithholdVotes,
+	} {
+		parsed, err := ParseMisbehavior(string(m))
+		require.NoError(t, err)
+		assert.Equal(t, m, parsed)
+	}
+
+	_, err := ParseMisbehavior("double-sign")
+	require.Error(t, err)
+}
@@ -118,6 +118,43 @@ func MsgToWrappedProto(msg Message) (cmtcons.Message, error) {
 
 		pb.Sum = &cmtcons.Message_VoteSetBits{VoteSetBits: vsb}
 
+	case *CompactBlockMessage:
+		block, err := msg.Block.ToProto()
+		if err != nil {
+			return pb, cmterrors.ErrMsgToProto{MessageName: "Block", Err: err}
+		}
+		txKeys := make([][]byte, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			txKeys[i] = key[:]
+		}
+		pb.Sum = &cmtcons.Message_CompactBlock{CompactBlock: &cmtcons.CompactBlock{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: msg.BlockPartSetHeader.ToProto(),
+			Block:              block,
+			TxKeys:             txKeys,
+		}}
+
+	case *CompactBlockTxsRequestMessage:
+		pb.Sum = &cmtcons.Message_CompactBlockTxsRequest{CompactBlockTxsRequest: &cmtcons.CompactBlockTxsRequest{
+			Height:   msg.Height,
+			Round:    msg.Round,
+			Indexes:  msg.Indexes,
+			Fallback: msg.Fallback,
+		}}
+
+	case *CompactBlockTxsMessage:
+		txs := make([][]byte, len(msg.Txs))
+		for i, tx := range msg.Txs {
+			txs[i] = tx
+		}
+		pb.Sum = &cmtcons.Message_CompactBlockTxs{CompactBlockTxs: &cmtcons.CompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     txs,
+		}}
+
 	default:
 		return pb, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -239,6 +276,50 @@ func MsgFromProto(p proto.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		psh, err := types.PartSetHeaderFromProto(&msg.BlockPartSetHeader)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "BlockPartSetHeader", Err: err}
+		}
+		block, err := compactBlockFromProto(msg.Block)
+		if err != nil {
+			return nil, cmterrors.ErrMsgToProto{MessageName: "CompactBlock", Err: err}
+		}
+		txKeys := make([]types.TxKey, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			if len(key) != len(txKeys[i]) {
+				return nil, cmterrors.ErrMsgToProto{
+					MessageName: "CompactBlock",
+					Err:         fmt.Errorf("invalid tx key length %d", len(key)),
+				}
+			}
+			copy(txKeys[i][:], key)
+		}
+		pb = &CompactBlockMessage{
+			Height:             msg.Height,
+			Round:              msg.Round,
+			BlockPartSetHeader: *psh,
+			Block:              block,
+			TxKeys:             txKeys,
+		}
+	case *cmtcons.CompactBlockTxsRequest:
+		pb = &CompactBlockTxsRequestMessage{
+			Height:   msg.Height,
+			Round:    msg.Round,
+			Indexes:  msg.Indexes,
+			Fallback: msg.Fallback,
+		}
+	case *cmtcons.CompactBlockTxs:
+		txs := make(types.Txs, len(msg.Txs))
+		for i, tx := range msg.Txs {
+			txs[i] = tx
+		}
+		pb = &CompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     txs,
+		}
 	default:
 		return nil, ErrConsensusMessageNotRecognized{msg}
 	}
@@ -250,6 +331,35 @@ func MsgFromProto(p proto.Message) (Message, error) {
 	return pb, nil
 }
 
+// compactBlockFromProto converts the block of a compact block, which has no
+// transactions, from its proto representation. Unlike types.BlockFromProto, it
+// does not validate the block, since its data hash does not match its (empty)
+// data until the transactions are put back.
+func compactBlockFromProto(bp *cmtproto.Block) (*types.Block, error) {
+	if bp == nil {
+		return nil, ErrNilMessage
+	}
+
+	b := new(types.Block)
+	h, err := types.HeaderFromProto(&bp.Header)
+	if err != nil {
+		return nil, err
+	}
+	b.Header = h
+	if err := b.Evidence.FromProto(&bp.Evidence); err != nil {
+		return nil, err
+	}
+	if bp.LastCommit != nil {
+		lc, err := types.CommitFromProto(bp.LastCommit)
+		if err != nil {
+			return nil, err
+		}
+		b.LastCommit = lc
+	}
+
+	return b, nil
+}
+
 // WALToProto takes a WAL message and return a proto walMessage and error.
 func WALToProto(msg WALMessage) (*cmtcons.WALMessage, error) {
 	var pb cmtcons.WALMessage
@@ -187,6 +187,34 @@ func TestMsgToProto(t *testing.T) {
 
 			false,
 		},
+		{
+			"successful CompactBlockTxsRequest", &CompactBlockTxsRequestMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{0, 2},
+			}, &cmtcons.CompactBlockTxsRequest{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{0, 2},
+			},
+
+			false,
+		},
+		{
+			"successful CompactBlockTxs", &CompactBlockTxsMessage{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{0, 2},
+				Txs:     types.Txs{types.Tx("a"), types.Tx("b")},
+			}, &cmtcons.CompactBlockTxs{
+				Height:  1,
+				Round:   1,
+				Indexes: []int32{0, 2},
+				Txs:     [][]byte{[]byte("a"), []byte("b")},
+			},
+
+			false,
+		},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
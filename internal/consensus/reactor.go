@@ -16,6 +16,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -28,6 +29,9 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel is only advertised, and used to request the missing
+	// transactions of compact blocks, if compact blocks are enabled.
+	CompactBlockChannel = byte(0x24)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -51,6 +55,13 @@ type Reactor struct {
 	initialHeight int64 // under rsMtx
 
 	Metrics *Metrics
+
+	// mempool from which compact blocks are rebuilt; if nil, compact blocks
+	// are disabled.
+	mempool mempl.Mempool
+
+	compactBlockMtx cmtsync.Mutex
+	compactBlock    *compactBlockCache // last compact block built for gossiping
 }
 
 type ReactorOption func(*Reactor)
@@ -151,9 +162,9 @@ conR:
 }
 
 // GetChannels implements Reactor.
-func (*Reactor) GetChannels() []*p2p.ChannelDescriptor {
+func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	// TODO optimize
-	return []*p2p.ChannelDescriptor{
+	chs := []*p2p.ChannelDescriptor{
 		{
 			ID:                  StateChannel,
 			Priority:            6,
@@ -187,6 +198,17 @@ func (*Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			MessageType:         &cmtcons.Message{},
 		},
 	}
+	if conR.compactBlocks() {
+		chs = append(chs, &p2p.ChannelDescriptor{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   10,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		})
+	}
+	return chs
 }
 
 // InitPeer implements Reactor by creating a state for the peer.
@@ -246,12 +268,14 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 	msg, err := MsgFromProto(e.Message)
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
+		conR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 		conR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		conR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 		conR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -273,6 +297,7 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.rsMtx.RUnlock()
 			if err = msg.ValidateHeight(initialHeight); err != nil {
 				conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", msg, "err", err)
+				conR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 				conR.Switch.StopPeerForError(e.Src, err)
 				return
 			}
@@ -339,6 +364,22 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
 			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID(), time.Time{}}
+		case *CompactBlockMessage:
+			conR.handleCompactBlock(e.Src, ps, msg)
+		default:
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
+	case CompactBlockChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *CompactBlockTxsRequestMessage:
+			conR.handleCompactBlockTxsRequest(e.Src, ps, msg)
+		case *CompactBlockTxsMessage:
+			conR.handleCompactBlockTxs(e.Src, ps, msg)
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -608,12 +649,26 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// --------------------
+		// Send compact block?
+		// (If the peer supports compact blocks and has the proposal but none of its parts)
+		// --------------------
+
+		if conR.compactBlocks() && peerSupportsCompactBlocks(peer) {
+			if conR.gossipCompactBlock(logger, rs, ps, prs) {
+				continue OUTER_LOOP
+			}
+		}
+
 		// --------------------
 		// Send block part?
 		// (Note these can match on hash so round doesn't matter)
 		// --------------------
 
-		if part, continueLoop := pickPartToSend(logger, conR.conS.blockStore, rs, ps, prs, rng); part != nil {
+		if ps.waitingForCompactBlock(rs, conR.conS.config.CompactBlockTimeout) {
+			// The peer is rebuilding the proposal block from the compact block
+			// we sent, so do not send it the parts until the timeout expires.
+		} else if part, continueLoop := pickPartToSend(logger, conR.conS.blockStore, rs, ps, prs, rng); part != nil {
 			// part is not nil: we either succeed in sending it,
 			// or we were instructed not to sleep (busy-waiting)
 			if ps.SendPartSetHasPart(part, prs) || continueLoop {
@@ -813,7 +868,8 @@ func pickPartToSend(
 	rng *rand.Rand,
 ) (*types.Part, bool) {
 	// If peer has same part set header as us, send block parts
-	if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
+	// (unless it already has enough parts to reconstruct an erasure-coded block)
+	if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) && !hasEnoughParts(prs) {
 		if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(rng); ok {
 			part := rs.ProposalBlockParts.GetPart(index)
 			// If sending this part fails, restart the OUTER_LOOP (busy-waiting).
@@ -857,6 +913,9 @@ func pickPartForCatchup(
 	blockStore sm.BlockStore,
 	rng *rand.Rand,
 ) *types.Part {
+	if hasEnoughParts(prs) {
+		return nil
+	}
 	index, ok := prs.ProposalBlockParts.Not().PickRandom(rng)
 	if !ok {
 		return nil
@@ -882,6 +941,13 @@ func pickPartForCatchup(
 	return part
 }
 
+// hasEnoughParts returns true if the peer has enough parts of an erasure-coded
+// proposal block to reconstruct it, in which case it does not need any more.
+func hasEnoughParts(prs *cstypes.PeerRoundState) bool {
+	psh := prs.ProposalBlockPartSetHeader
+	return psh.Parity > 0 && prs.ProposalBlockParts.Count() >= int(psh.DataTotal())
+}
+
 func pickVoteToSend(
 	logger log.Logger,
 	conS *State,
@@ -1022,10 +1088,12 @@ func (conR *Reactor) peerStatsRoutine() {
 			}
 			switch msg.Msg.(type) {
 			case *VoteMessage:
+				conR.Switch.ReportPeerEvent(peer, p2p.PeerEventVote)
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
 					conR.Switch.MarkPeerAsGood(peer)
 				}
 			case *BlockPartMessage:
+				conR.Switch.ReportPeerEvent(peer, p2p.PeerEventBlockPart)
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
 					conR.Switch.MarkPeerAsGood(peer)
 				}
@@ -1067,6 +1135,12 @@ func ReactorMetrics(metrics *Metrics) ReactorOption {
 	return func(conR *Reactor) { conR.Metrics = metrics }
 }
 
+// ReactorMempool sets the mempool from which compact blocks are rebuilt. It is
+// required to enable compact blocks.
+func ReactorMempool(mempool mempl.Mempool) ReactorOption {
+	return func(conR *Reactor) { conR.mempool = mempool }
+}
+
 // -----------------------------------------------------------------------------
 
 // PeerState contains the known state of a peer, including its connection and
@@ -1080,6 +1154,12 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	// Compact block sent to the peer, by part set header, and when.
+	compactBlockSent   types.PartSetHeader
+	compactBlockSentAt time.Time
+	// Compact block received from the peer, waiting for its missing txs.
+	compactBlockPending *pendingCompactBlock
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1662,6 +1742,9 @@ func init() {
 	cmtjson.RegisterType(&HasProposalBlockPartMessage{}, "tendermint/HasProposalBlockPart")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&CompactBlockTxsRequestMessage{}, "tendermint/CompactBlockTxsRequest")
+	cmtjson.RegisterType(&CompactBlockTxsMessage{}, "tendermint/CompactBlockTxs")
 }
 
 // -------------------------------------
@@ -1994,8 +2077,124 @@ func (m *HasProposalBlockPartMessage) String() string {
 	return fmt.Sprintf("[HasProposalBlockPart PI:%v HR:{%v/%02d}]", m.Index, m.Height, m.Round)
 }
 
+// -------------------------------------
+
+// CompactBlockMessage is sent instead of the parts of a proposed block to peers
+// supporting compact blocks. Block is the proposed block without its
+// transactions, whose keys are given in order by TxKeys.
+type CompactBlockMessage struct {
+	Height             int64
+	Round              int32
+	BlockPartSetHeader types.PartSetHeader
+	Block              *types.Block
+	TxKeys             []types.TxKey
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if err := m.BlockPartSetHeader.ValidateBasic(); err != nil {
+		return cmterrors.ErrWrongField{Field: "BlockPartSetHeader", Err: err}
+	}
+	if m.BlockPartSetHeader.IsZero() {
+		return cmterrors.ErrRequiredField{Field: "BlockPartSetHeader"}
+	}
+	if m.Block == nil {
+		return cmterrors.ErrRequiredField{Field: "Block"}
+	}
+	if m.Block.Height != m.Height {
+		return fmt.Errorf("block height %d does not match message height %d", m.Block.Height, m.Height)
+	}
+	if len(m.Block.Txs) > 0 {
+		return cmterrors.ErrInvalidField{Field: "Block", Reason: "must not contain transactions"}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v PSH:%v Txs:%v]", m.Height, m.Round, m.BlockPartSetHeader, len(m.TxKeys))
+}
+
+// -------------------------------------
+
+// CompactBlockTxsRequestMessage is sent in response to a CompactBlockMessage to
+// request the transactions, given by their indexes in the block, missing from
+// the mempool of the receiver. If Fallback is set, the receiver could not
+// rebuild the block and requests its parts instead.
+type CompactBlockTxsRequestMessage struct {
+	Height   int64
+	Round    int32
+	Indexes  []int32
+	Fallback bool
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsRequestMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	for _, index := range m.Indexes {
+		if index < 0 {
+			return cmterrors.ErrNegativeField{Field: "Indexes"}
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsRequestMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxsRequest H:%v R:%v Txs:%v Fallback:%v]", m.Height, m.Round, len(m.Indexes), m.Fallback)
+}
+
+// -------------------------------------
+
+// CompactBlockTxsMessage is sent in response to a CompactBlockTxsRequestMessage
+// with the requested transactions.
+type CompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []int32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 1 {
+		return cmterrors.ErrInvalidField{Field: "Height", Reason: "( < 1 )"}
+	}
+	if m.Round < 0 {
+		return cmterrors.ErrNegativeField{Field: "Round"}
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return fmt.Errorf("number of indexes %d does not match number of txs %d", len(m.Indexes), len(m.Txs))
+	}
+	for _, index := range m.Indexes {
+		if index < 0 {
+			return cmterrors.ErrNegativeField{Field: "Indexes"}
+		}
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxs H:%v R:%v Txs:%v]", m.Height, m.Round, len(m.Txs))
+}
+
 var (
 	_ types.Wrapper = &cmtcons.BlockPart{}
+	_ types.Wrapper = &cmtcons.CompactBlock{}
+	_ types.Wrapper = &cmtcons.CompactBlockTxs{}
+	_ types.Wrapper = &cmtcons.CompactBlockTxsRequest{}
 	_ types.Wrapper = &cmtcons.HasVote{}
 	_ types.Wrapper = &cmtcons.HasProposalBlockPart{}
 	_ types.Wrapper = &cmtcons.NewRoundStep{}
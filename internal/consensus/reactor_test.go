@@ -55,7 +55,8 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		// logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		// if err != nil {	t.Fatal(err)}
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		mempool, _ := css[i].txNotifier.(mempl.Mempool)
+		reactors[i] = NewReactor(css[i], true, ReactorMempool(mempool)) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -439,6 +440,58 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	assert.Greater(t, ps.BlockPartsSent(), 0, "number of votes sent should have increased")
 }
 
+// Ensure a testnet with compact blocks enabled makes blocks with txs, and
+// propagates them as compact blocks.
+func TestReactorCompactBlocks(t *testing.T) {
+	n := 4
+	css, cleanup := randConsensusNet(t, n, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) { c.Consensus.CompactBlocks = true })
+	defer cleanup()
+	for i := 0; i < n; i++ {
+		deliverTxsRange(t, css[i], 10)
+	}
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, n)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// wait till everyone makes a block with txs
+	timeoutWaitGroup(n, func(j int) {
+		for msg := range blocksSubs[j].Out() {
+			if len(msg.Data().(types.EventDataNewBlock).Block.Txs) > 0 {
+				return
+			}
+		}
+	})
+
+	sent := false
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			ps := peer.Get(types.PeerStateKey).(*PeerState)
+			ps.mtx.Lock()
+			sent = sent || !ps.compactBlockSent.IsZero()
+			ps.mtx.Unlock()
+		}
+	}
+	assert.True(t, sent, "no compact block was sent")
+}
+
+func TestReactorCodedBlockParts(t *testing.T) {
+	n := 4
+	css, cleanup := randConsensusNet(t, n, "consensus_reactor_test", newMockTickerFunc(true), newKVStore,
+		func(c *cfg.Config) { c.Consensus.BlockPartsParityRatio = 1 })
+	defer cleanup()
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, n)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// wait till everyone makes a couple of erasure-coded blocks
+	timeoutWaitGroup(n, func(j int) {
+		for i := 0; i < 2; i++ {
+			msg := <-blocksSubs[j].Out()
+			psh := msg.Data().(types.EventDataNewBlock).BlockID.PartSetHeader
+			assert.Positive(t, psh.Parity, "block part set header %v", psh)
+		}
+	})
+}
+
 // -------------------------------------------------------------
 // ensure we can make blocks despite cycling a validator set
 
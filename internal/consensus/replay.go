@@ -351,7 +351,14 @@ func (h *Handshaker) ReplayBlocks(
 			if res.ConsensusParams != nil {
 				state.ConsensusParams = state.ConsensusParams.Update(res.ConsensusParams)
 				state.Version.Consensus.App = state.ConsensusParams.Version.App
+				// The proposer selection may have changed, so start over from
+				// the proposer priorities of the genesis validators.
+				if len(res.Validators) == 0 {
+					state.Validators = types.NewValidatorSet(state.Validators.Validators)
+				}
 			}
+			state.Validators.SelectProposer(state.ConsensusParams.Validator.ProposerSelection,
+				nil, state.InitialHeight, 0)
 			// We update the last results hash with the empty hash, to conform with RFC-6962.
 			state.LastResultsHash = merkle.HashFromByteSlices(nil)
 			if err := h.stateStore.Save(state); err != nil {
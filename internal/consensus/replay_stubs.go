@@ -30,13 +30,18 @@ func (emptyMempool) RemoveTxByKey(types.TxKey) error {
 	return nil
 }
 
+func (emptyMempool) GetTxStatus(types.TxKey) mempl.TxStatus {
+	return mempl.TxStatus{Status: mempl.TxStatusUnknown}
+}
+
 func (emptyMempool) ReapMaxBytesMaxGas(int64, int64) types.Txs { return types.Txs{} }
 func (emptyMempool) GetTxByHash([]byte) types.Tx               { return types.Tx{} }
 func (emptyMempool) ReapMaxTxs(int) types.Txs                  { return types.Txs{} }
+func (emptyMempool) DumpTxs() []mempl.TxInfo                   { return nil }
 func (emptyMempool) Update(
 	int64,
 	types.Txs,
-	[]*abci.ExecTxResult,
+	*abci.FinalizeBlockResponse,
 	mempl.PreCheckFunc,
 	mempl.PostCheckFunc,
 ) error {
@@ -141,6 +141,16 @@ type State struct {
 	// a buffer to store the concatenated proposal block parts (serialization format)
 	// should only be accessed under the cs.mtx lock
 	serializedBlockBuffer []byte
+
+	// computes the timeouts of the propose and vote steps, adapting them to
+	// the observed latencies if enabled
+	timeouts *adaptiveTimeouts
+
+	// records the consensus timeline of each height, if enabled
+	timeline *timeline
+
+	// byzantine behaviors injected by the e2e tests, if any
+	misbehaviors *misbehaviors
 }
 
 // StateOption sets an optional parameter on the State.
@@ -171,6 +181,10 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		timeouts:         newAdaptiveTimeouts(config),
+	}
+	if config.TimelineTrace {
+		cs.timeline = newTimeline(config.TimelineTraceHeights)
 	}
 	for _, option := range options {
 		option(cs)
@@ -207,6 +221,9 @@ func NewState(
 func (cs *State) SetLogger(l log.Logger) {
 	cs.BaseService.Logger = l
 	cs.timeoutTicker.SetLogger(l)
+	if cs.timeline != nil {
+		cs.timeline.logger = l.With("module", "timeline")
+	}
 }
 
 // SetEventBus sets event bus.
@@ -328,6 +345,12 @@ func (cs *State) OnStart() error {
 		}
 	}
 
+	if cs.timeline != nil && cs.config.TimelineTracePath != "" {
+		if err := cs.timeline.openFile(cs.config.TimelineTraceFile()); err != nil {
+			return err
+		}
+	}
+
 	// we need the timeoutRoutine for replay so
 	// we don't block on the tick chan.
 	// NOTE: we will get a build up of garbage go routines
@@ -443,6 +466,9 @@ func (cs *State) OnStop() {
 	if err := cs.timeoutTicker.Stop(); err != nil {
 		cs.Logger.Error("failed trying to stop timeoutTicket", "error", err)
 	}
+	if cs.timeline != nil {
+		cs.timeline.closeFile()
+	}
 	// WAL is stopped in receiveRoutine.
 }
 
@@ -555,6 +581,7 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 	}
 	cs.Round = round
 	cs.Step = step
+	cs.traceStep(round, step)
 }
 
 // enterNewRound(height, 0) at cs.StartTime.
@@ -640,6 +667,12 @@ func (cs *State) votesFromSeenCommit(state sm.State) (*types.VoteSet, error) {
 		return nil, fmt.Errorf("heights don't match in votesFromSeenCommit %v!=%v",
 			commit.Height, state.LastBlockHeight)
 	}
+	if commit.IsAggregated() {
+		// The precommits of an aggregated commit (e.g. stored by blocksync or
+		// statesync) cannot be recovered. Start with no precommits instead,
+		// which peers will send us.
+		return types.NewVoteSet(state.ChainID, commit.Height, commit.Round, types.PrecommitType, state.LastValidators), nil
+	}
 	vs := commit.ToVoteSet(state.ChainID, state.LastValidators)
 	if !vs.HasTwoThirdsMajority() {
 		return nil, ErrCommitQuorumNotMet
@@ -898,6 +931,9 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
 		err = cs.setProposal(msg.Proposal, mi.ReceiveTime)
+		if err == nil && cs.Proposal == msg.Proposal {
+			cs.traceProposal(msg.Proposal, peerID, mi.ReceiveTime)
+		}
 
 	case *BlockPartMessage:
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
@@ -1082,6 +1118,8 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	if cs.Round < round {
 		validators = validators.Copy()
 		validators.IncrementProposerPriority(cmtmath.SafeSubInt32(round, cs.Round))
+		validators.SelectProposer(cs.state.ConsensusParams.Validator.ProposerSelection,
+			cs.state.LastBlockID.Hash, height, round)
 	}
 
 	// Setup new round
@@ -1107,6 +1145,8 @@ func (cs *State) enterNewRound(height int64, round int32) {
 
 	cs.Votes.SetRound(cmtmath.SafeAddInt32(round, 1)) // also track next round (round+1) to allow round-skipping
 	cs.TriggeredTimeoutPrecommit = false
+	cs.Timeouts = cs.timeouts.RoundTimeouts(round)
+	cs.metrics.MarkTimeouts(cs.Timeouts)
 
 	if err := cs.eventBus.PublishEventNewRound(cs.NewRoundEvent()); err != nil {
 		cs.Logger.Error("failed publishing new round", "err", err)
@@ -1186,7 +1226,8 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.timeouts.enterStep(height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.timeouts.Propose(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1242,7 +1283,8 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 			panic("Method createProposalBlock should not provide a nil block without errors")
 		}
 		cs.metrics.ProposalCreateCount.Add(1)
-		blockParts, err = block.MakePartSet(types.BlockPartSizeBytes)
+		parity := types.NumParityParts(block.Size(), types.BlockPartSizeBytes, cs.config.BlockPartsParityRatio)
+		blockParts, err = block.MakeCodedPartSet(types.BlockPartSizeBytes, parity)
 		if err != nil {
 			cs.Logger.Error("unable to create proposal block part set", "error", err)
 			return
@@ -1360,6 +1402,7 @@ func (cs *State) enterPrevote(height int64, round int32) {
 	}()
 
 	logger.Debug("entering prevote step", "current", log.NewLazySprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
+	cs.timeouts.enterStep(height, round, cstypes.RoundStepPrevote)
 
 	// Sign and broadcast vote as necessary
 	cs.doPrevote(height, round)
@@ -1598,7 +1641,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.timeouts.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1618,6 +1661,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 	}
 
 	logger.Debug("entering precommit step", "current", log.NewLazySprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
+	cs.timeouts.enterStep(height, round, cstypes.RoundStepPrecommit)
 
 	defer func() {
 		// Done enterPrecommit:
@@ -1668,6 +1712,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 			logger.Error("precommit step; failed publishing event relock", "err", err)
 		}
 
+		cs.executeOptimistically(cs.LockedBlock)
 		cs.signAddVote(types.PrecommitType, blockID.Hash, blockID.PartSetHeader, cs.LockedBlock)
 		return
 	}
@@ -1691,6 +1736,7 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 			logger.Error("precommit step; failed publishing event lock", "err", err)
 		}
 
+		cs.executeOptimistically(cs.ProposalBlock)
 		cs.signAddVote(types.PrecommitType, blockID.Hash, blockID.PartSetHeader, cs.ProposalBlock)
 		return
 	}
@@ -1707,6 +1753,18 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 	cs.signAddVote(types.PrecommitType, nil, types.PartSetHeader{}, nil)
 }
 
+// executeOptimistically starts executing block, which +2/3 prevoted for,
+// before it is decided, if optimistic execution is enabled. It is not at the
+// heights at which vote extensions are enabled, as extending and verifying
+// votes would wait for the execution to complete.
+func (cs *State) executeOptimistically(block *types.Block) {
+	if !cs.config.OptimisticExecution || cs.replayMode ||
+		cs.state.ConsensusParams.Feature.VoteExtensionsEnabled(block.Height) {
+		return
+	}
+	cs.blockExec.ExecuteBlockOptimistically(cs.state, block)
+}
+
 // Enter: any +2/3 precommits for next round.
 func (cs *State) enterPrecommitWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1736,7 +1794,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.timeouts.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block.
@@ -1929,6 +1987,7 @@ func (cs *State) finalizeCommit(height int64) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to apply block; error %v", err))
 	}
+	cs.traceExecution(height, cs.CommitRound, cs.blockExec.LastExecutionTimes())
 
 	fail.Fail() // XXX
 
@@ -2193,6 +2252,7 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		}
 
 		cs.ProposalBlock = block
+		cs.traceBlockPartsComplete(msg.Round, peerID)
 
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
@@ -2228,6 +2288,7 @@ func (cs *State) handleCompleteProposal(blockHeight int64) {
 	}
 
 	if cs.Step <= cstypes.RoundStepPropose && cs.isProposalComplete() {
+		cs.timeouts.observe(blockHeight, cs.Round, cstypes.RoundStepPropose)
 		// Move onto the next step
 		cs.enterPrevote(blockHeight, cs.Round)
 		if hasTwoThirds { // this is optimisation as this will be triggered when prevote is added
@@ -2325,6 +2386,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 
 		cs.Logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
+		cs.traceVote(vote, peerID)
 		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
 			return added, err
 		}
@@ -2400,6 +2462,7 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 		return added, err
 	}
+	cs.traceVote(vote, peerID)
 	if vote.Round == cs.Round {
 		vals := cs.state.Validators
 		_, val := vals.GetByIndex(vote.ValidatorIndex)
@@ -2458,6 +2521,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 		case cs.Round == vote.Round && cstypes.RoundStepPrevote <= cs.Step: // current round
 			blockID, ok := prevotes.TwoThirdsMajority()
+			if ok {
+				cs.timeouts.observe(height, vote.Round, cstypes.RoundStepPrevote)
+			}
 			if ok && (cs.isProposalComplete() || blockID.IsNil()) {
 				cs.enterPrecommit(height, vote.Round)
 			} else if prevotes.HasTwoThirdsAny() {
@@ -2482,6 +2548,8 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 		blockID, ok := precommits.TwoThirdsMajority()
 		if ok {
+			cs.timeouts.observe(height, vote.Round, cstypes.RoundStepPrecommit)
+
 			// Executed as TwoThirdsMajority could be from a higher round
 			cs.enterNewRound(height, vote.Round)
 			cs.enterPrecommit(height, vote.Round)
@@ -2607,6 +2675,10 @@ func (cs *State) signAddVote(
 		return
 	}
 
+	if cs.withholdVote() {
+		return
+	}
+
 	// TODO: pass pubKey to signVote
 	vote, err := cs.signVote(msgType, hash, header, block)
 	if err != nil {
@@ -2621,6 +2693,7 @@ func (cs *State) signAddVote(
 	}
 	cs.sendInternalMessage(msgInfo{&VoteMessage{vote}, "", time.Time{}})
 	cs.Logger.Debug("signed and pushed vote", "height", cs.Height, "round", cs.Round, "vote", vote)
+	cs.sendConflictingVote(vote)
 }
 
 // updatePrivValidatorPubKey gets the private validator public key and
@@ -2651,8 +2724,18 @@ func (cs *State) checkDoubleSigningRisk(height int64) error {
 		for i := int64(1); i < doubleSignCheckHeight; i++ {
 			lastCommit := cs.blockStore.LoadSeenCommit(height - i)
 			if lastCommit != nil {
+				// The signers of an aggregated commit are only known by their
+				// index in the validator set of its height.
+				var vals *types.ValidatorSet
+				if lastCommit.IsAggregated() {
+					var err error
+					if vals, err = cs.blockExec.Store().LoadValidators(height - i); err != nil {
+						return fmt.Errorf("loading validators of the seen commit at height %d: %w", height-i, err)
+					}
+				}
 				for sigIdx, s := range lastCommit.Signatures {
-					if s.BlockIDFlag == types.BlockIDFlagCommit && bytes.Equal(s.ValidatorAddress, valAddr) {
+					if s.BlockIDFlag == types.BlockIDFlagCommit &&
+						bytes.Equal(lastCommit.SignerAddress(int32(sigIdx), vals), valAddr) {
 						cs.Logger.Info("found signature from the same key", "sig", s, "idx", sigIdx, "height", height-i)
 						return ErrSignatureFoundInPastBlocks
 					}
@@ -142,6 +142,36 @@ func TestStateProposerSelection2(t *testing.T) {
 	}
 }
 
+// each round has the proposer selected by the proposer selection of the
+// consensus params.
+func TestStateProposerSelectionStakeWeightedRandom(t *testing.T) {
+	cs1, vss := randState(4)
+	cs1.state.ConsensusParams.Validator.ProposerSelection = types.ProposerSelectionStakeWeightedRandom
+	height, chainID := cs1.Height, cs1.state.ChainID
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	incrementRound(vss[1:]...)
+
+	var round int32 = 1
+	startTestRound(cs1, height, round)
+
+	ensureNewRound(newRoundCh, height, round) // wait for the new round
+
+	// everyone just votes nil, the proposer of each round is drawn at random
+	for i := int32(0); int(i) < len(vss); i++ {
+		prop := cs1.GetRoundState().Validators.GetProposer()
+		correctProposer := cs1.state.Validators.ProposerForRound(types.ProposerSelectionStakeWeightedRandom,
+			cs1.state.LastBlockID.Hash, height, i+round)
+		require.Truef(t, bytes.Equal(prop.Address, correctProposer.Address),
+			"expected RoundState.Validators.GetProposer() to be %X in round %d. Got %X",
+			correctProposer.Address, i+round, prop.Address,
+		)
+		signAddVotes(cs1, types.PrecommitType, chainID, types.BlockID{}, true, vss[1:]...)
+		ensureNewRound(newRoundCh, height, i+round+1) // wait for the new round event each round
+		incrementRound(vss[1:]...)
+	}
+}
+
 // a non-validator should timeout into the prevote round.
 func TestStateEnterProposeNoPrivValidator(t *testing.T) {
 	cs, _ := randState(1)
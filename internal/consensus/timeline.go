@@ -0,0 +1,291 @@
+package consensus
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	auto "github.com/cometbft/cometbft/internal/autofile"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// Types of timeline events.
+const (
+	TimelineEventStep               = "step"
+	TimelineEventProposal           = "proposal"
+	TimelineEventVote               = "vote"
+	TimelineEventBlockPartsComplete = "block_parts_complete"
+	TimelineEventFinalizeBlock      = "finalize_block"
+	TimelineEventCommit             = "commit"
+)
+
+// HeightTimeline is the timeline of the consensus at a height, i.e. the
+// events recorded by the node while deciding on the block at Height, in the
+// order in which they occurred.
+type HeightTimeline struct {
+	Height int64           `json:"height"`
+	Events []TimelineEvent `json:"events"`
+}
+
+// TimelineEvent is an event of the consensus timeline. Only the fields
+// relevant to its Type are set:
+//   - step: Step, the step entered;
+//   - proposal: Peer, POLRound, BlockHash and Timestamp, the proposal's;
+//   - vote: Peer, VoteType, ValidatorAddress, ValidatorIndex, BlockHash and
+//     Timestamp, the vote's;
+//   - block_parts_complete: Peer, the sender of the last part, Parts and
+//     BlockHash;
+//   - finalize_block and commit: Duration, the duration of the call to the
+//     application, which started at Time.
+//
+// Peer is empty if the message was sent by this node.
+type TimelineEvent struct {
+	Time             time.Time      `json:"time"`
+	Type             string         `json:"type"`
+	Round            int32          `json:"round"`
+	Step             string         `json:"step,omitempty"`
+	Peer             p2p.ID         `json:"peer,omitempty"`
+	VoteType         string         `json:"vote_type,omitempty"`
+	ValidatorAddress bytes.HexBytes `json:"validator_address,omitempty"`
+	ValidatorIndex   *int32         `json:"validator_index,omitempty"`
+	POLRound         *int32         `json:"pol_round,omitempty"`
+	BlockHash        bytes.HexBytes `json:"block_hash,omitempty"`
+	Timestamp        *time.Time     `json:"timestamp,omitempty"`
+	Parts            uint32         `json:"parts,omitempty"`
+	Duration         time.Duration  `json:"duration_ns,omitempty"`
+}
+
+// timeline records the consensus timeline of each height, writes it to a
+// rotating file and keeps the last ones in memory.
+//
+// The timeline of a height is complete once the next height enters round 0,
+// as precommits for a height are still accepted until then.
+type timeline struct {
+	mtx    sync.Mutex
+	logger log.Logger
+
+	group      *auto.Group // nil if timelines are not written to a file
+	maxHeights int
+
+	current  *HeightTimeline
+	previous *HeightTimeline // waiting for late precommits
+	recent   map[int64][]byte
+	heights  []int64 // heights in recent, in increasing order
+}
+
+func newTimeline(maxHeights int) *timeline {
+	return &timeline{
+		logger:     log.NewNopLogger(),
+		maxHeights: maxHeights,
+		recent:     make(map[int64][]byte),
+	}
+}
+
+// openFile writes the timelines to the file at path, which is rotated.
+func (tl *timeline) openFile(path string) error {
+	if err := cmtos.EnsureDir(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to ensure timeline trace directory exists: %w", err)
+	}
+	group, err := auto.OpenGroup(path)
+	if err != nil {
+		return err
+	}
+	if err := group.Start(); err != nil {
+		return err
+	}
+
+	tl.mtx.Lock()
+	defer tl.mtx.Unlock()
+	tl.group = group
+	return nil
+}
+
+// closeFile flushes and closes the file timelines are written to, if any.
+func (tl *timeline) closeFile() {
+	tl.mtx.Lock()
+	defer tl.mtx.Unlock()
+	if tl.group == nil {
+		return
+	}
+	if err := tl.group.FlushAndSync(); err != nil {
+		tl.logger.Error("failed to flush timeline trace", "err", err)
+	}
+	if err := tl.group.Stop(); err != nil {
+		tl.logger.Error("failed to stop timeline trace", "err", err)
+	}
+	tl.group = nil
+}
+
+// record adds ev to the timeline of height, which must be the current height,
+// a following one, or the previous one while it waits for late precommits.
+func (tl *timeline) record(height int64, ev TimelineEvent) {
+	tl.mtx.Lock()
+	defer tl.mtx.Unlock()
+
+	switch {
+	case tl.current == nil || height > tl.current.Height:
+		if tl.previous != nil {
+			tl.complete(tl.previous)
+		}
+		tl.previous = tl.current
+		tl.current = &HeightTimeline{Height: height}
+	case height < tl.current.Height:
+		if tl.previous != nil && height == tl.previous.Height {
+			tl.previous.Events = append(tl.previous.Events, ev)
+		}
+		return
+	}
+	tl.current.Events = append(tl.current.Events, ev)
+
+	if ev.Type == TimelineEventStep && ev.Step != stepName(cstypes.RoundStepNewHeight) && tl.previous != nil {
+		tl.complete(tl.previous)
+		tl.previous = nil
+	}
+}
+
+// complete writes the timeline ht to the file and keeps it in memory.
+func (tl *timeline) complete(ht *HeightTimeline) {
+	bz, err := json.Marshal(ht)
+	if err != nil {
+		tl.logger.Error("failed to encode timeline", "height", ht.Height, "err", err)
+		return
+	}
+
+	if tl.group != nil {
+		if err := tl.group.WriteLine(string(bz)); err != nil {
+			tl.logger.Error("failed to write timeline", "height", ht.Height, "err", err)
+		} else if err := tl.group.FlushAndSync(); err != nil {
+			tl.logger.Error("failed to flush timeline", "height", ht.Height, "err", err)
+		}
+	}
+
+	if tl.maxHeights == 0 {
+		return
+	}
+	tl.recent[ht.Height] = bz
+	tl.heights = append(tl.heights, ht.Height)
+	if len(tl.heights) > tl.maxHeights {
+		delete(tl.recent, tl.heights[0])
+		tl.heights = tl.heights[1:]
+	}
+}
+
+// get returns the JSON encoding of the timeline of height, or of the last
+// complete height if height is 0.
+func (tl *timeline) get(height int64) ([]byte, error) {
+	tl.mtx.Lock()
+	defer tl.mtx.Unlock()
+
+	if height == 0 {
+		if len(tl.heights) == 0 {
+			return nil, ErrTimelineNotFound
+		}
+		height = tl.heights[len(tl.heights)-1]
+	}
+	bz, ok := tl.recent[height]
+	if !ok {
+		return nil, fmt.Errorf("%w: height %d", ErrTimelineNotFound, height)
+	}
+	return bz, nil
+}
+
+func stepName(step cstypes.RoundStepType) string {
+	return strings.TrimPrefix(step.String(), "RoundStep")
+}
+
+// ---------------------------------------------------------------------------
+// State hooks
+
+// GetTimelineJSON returns the JSON encoding of the consensus timeline of
+// height, which must be one of the last complete heights, or of the last
+// complete height if height is 0.
+func (cs *State) GetTimelineJSON(height int64) ([]byte, error) {
+	if cs.timeline == nil {
+		return nil, ErrTimelineTraceDisabled
+	}
+	return cs.timeline.get(height)
+}
+
+// trace records ev at height, if the timeline trace is enabled. The time of
+// the event defaults to now.
+func (cs *State) trace(height int64, ev TimelineEvent) {
+	if cs.timeline == nil || cs.replayMode {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = cmttime.Now()
+	}
+	cs.timeline.record(height, ev)
+}
+
+func (cs *State) traceStep(round int32, step cstypes.RoundStepType) {
+	cs.trace(cs.Height, TimelineEvent{
+		Type:  TimelineEventStep,
+		Round: round,
+		Step:  stepName(step),
+	})
+}
+
+func (cs *State) traceProposal(proposal *types.Proposal, peerID p2p.ID, recvTime time.Time) {
+	polRound := proposal.POLRound
+	timestamp := proposal.Timestamp
+	cs.trace(proposal.Height, TimelineEvent{
+		Time:      recvTime,
+		Type:      TimelineEventProposal,
+		Round:     proposal.Round,
+		Peer:      peerID,
+		POLRound:  &polRound,
+		BlockHash: proposal.BlockID.Hash,
+		Timestamp: &timestamp,
+	})
+}
+
+func (cs *State) traceVote(vote *types.Vote, peerID p2p.ID) {
+	valIndex := vote.ValidatorIndex
+	timestamp := vote.Timestamp
+	cs.trace(vote.Height, TimelineEvent{
+		Type:             TimelineEventVote,
+		Round:            vote.Round,
+		Peer:             peerID,
+		VoteType:         types.SignedMsgTypeToShortString(vote.Type),
+		ValidatorAddress: vote.ValidatorAddress,
+		ValidatorIndex:   &valIndex,
+		BlockHash:        vote.BlockID.Hash,
+		Timestamp:        &timestamp,
+	})
+}
+
+func (cs *State) traceBlockPartsComplete(round int32, peerID p2p.ID) {
+	cs.trace(cs.Height, TimelineEvent{
+		Type:      TimelineEventBlockPartsComplete,
+		Round:     round,
+		Peer:      peerID,
+		Parts:     cs.ProposalBlockParts.Total(),
+		BlockHash: cs.ProposalBlock.Hash(),
+	})
+}
+
+func (cs *State) traceExecution(height int64, round int32, times sm.ExecutionTimes) {
+	cs.trace(height, TimelineEvent{
+		Time:     times.FinalizeBlockStart,
+		Type:     TimelineEventFinalizeBlock,
+		Round:    round,
+		Duration: times.FinalizeBlockDuration,
+	})
+	cs.trace(height, TimelineEvent{
+		Time:     times.CommitStart,
+		Type:     TimelineEventCommit,
+		Round:    round,
+		Duration: times.CommitDuration,
+	})
+}
@@ -0,0 +1,118 @@
+package consensus
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTimeline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cs.timeline", "timeline")
+	tl := newTimeline(2)
+	require.NoError(t, tl.openFile(path))
+
+	step := func(step cstypes.RoundStepType) TimelineEvent {
+		return TimelineEvent{Time: time.Now(), Type: TimelineEventStep, Step: stepName(step)}
+	}
+	vote := TimelineEvent{Time: time.Now(), Type: TimelineEventVote, Peer: "peer", VoteType: "precommit"}
+
+	_, err := tl.get(0)
+	require.ErrorIs(t, err, ErrTimelineNotFound)
+
+	tl.record(1, step(cstypes.RoundStepNewHeight))
+	tl.record(1, step(cstypes.RoundStepNewRound))
+	tl.record(1, vote)
+	tl.record(2, step(cstypes.RoundStepNewHeight))
+
+	// Height 1 is not complete until height 2 enters a new round, as late
+	// precommits are still recorded.
+	_, err = tl.get(1)
+	require.ErrorIs(t, err, ErrTimelineNotFound)
+	tl.record(1, vote)
+	tl.record(2, step(cstypes.RoundStepNewRound))
+
+	bz, err := tl.get(0)
+	require.NoError(t, err)
+	var ht HeightTimeline
+	require.NoError(t, json.Unmarshal(bz, &ht))
+	assert.EqualValues(t, 1, ht.Height)
+	require.Len(t, ht.Events, 4)
+	assert.Equal(t, "NewHeight", ht.Events[0].Step)
+	assert.Equal(t, vote.Peer, ht.Events[3].Peer)
+
+	// Events of older heights are dropped.
+	tl.record(1, vote)
+
+	// Only the last heights are kept.
+	for h := int64(3); h <= 4; h++ {
+		tl.record(h, step(cstypes.RoundStepNewHeight))
+		tl.record(h, step(cstypes.RoundStepNewRound))
+	}
+	_, err = tl.get(1)
+	require.ErrorIs(t, err, ErrTimelineNotFound)
+	bz, err = tl.get(3)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &ht))
+	assert.EqualValues(t, 3, ht.Height)
+	assert.Len(t, ht.Events, 2)
+
+	// All the complete heights are written to the file.
+	tl.closeFile()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &ht))
+		assert.EqualValues(t, i+1, ht.Height)
+	}
+}
+
+func TestStateTimelineTrace(t *testing.T) {
+	config := test.ResetTestRoot("consensus_timeline_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.TimelineTrace = true
+	state, privVals := randGenesisState(1, nil)
+	cs := newStateWithConfig(config, state, privVals[0], kvstore.NewInMemoryApplication())
+	height := cs.Height
+
+	_, err := cs.GetTimelineJSON(0)
+	require.ErrorIs(t, err, ErrTimelineNotFound)
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+	startTestRound(cs, height, cs.Round)
+	ensureNewRound(newRoundCh, height, 0)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	bz, err := cs.GetTimelineJSON(height)
+	require.NoError(t, err)
+	var ht HeightTimeline
+	require.NoError(t, json.Unmarshal(bz, &ht))
+	assert.Equal(t, height, ht.Height)
+
+	var evTypes []string
+	for _, ev := range ht.Events {
+		if ev.Type != TimelineEventStep && !slices.Contains(evTypes, ev.Type) {
+			evTypes = append(evTypes, ev.Type)
+		}
+	}
+	assert.Equal(t, []string{
+		TimelineEventProposal,
+		TimelineEventBlockPartsComplete,
+		TimelineEventVote,
+		TimelineEventFinalizeBlock,
+		TimelineEventCommit,
+	}, evTypes)
+}
@@ -101,6 +101,16 @@ type RoundState struct {
 	LastCommit                *types.VoteSet      `json:"last_commit"`  // Last precommits at Height-1
 	LastValidators            *types.ValidatorSet `json:"last_validators"`
 	TriggeredTimeoutPrecommit bool                `json:"triggered_timeout_precommit"`
+
+	// Timeouts of the propose and vote steps in effect for Round.
+	Timeouts RoundTimeouts `json:"timeouts"`
+}
+
+// RoundTimeouts are the timeouts of the propose and vote steps of a round.
+type RoundTimeouts struct {
+	Propose   time.Duration `json:"propose"`
+	Prevote   time.Duration `json:"prevote"`
+	Precommit time.Duration `json:"precommit"`
 }
 
 // Compressed version of the RoundState for use in RPC.
@@ -17,6 +17,7 @@ import (
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/types"
 	cmterrors "github.com/cometbft/cometbft/types/errors"
 	cmttime "github.com/cometbft/cometbft/types/time"
 )
@@ -420,6 +421,41 @@ func (dec *WALDecoder) Decode() (*TimedWALMessage, error) {
 	return tMsgWal, err
 }
 
+// Types of WAL messages, as returned by WALMessageType.
+const (
+	WALMsgTypeRoundState = "round_state"
+	WALMsgTypeProposal   = "proposal"
+	WALMsgTypeBlockPart  = "block_part"
+	WALMsgTypeVote       = "vote"
+	WALMsgTypeTimeout    = "timeout"
+	WALMsgTypeEndHeight  = "end_height"
+	WALMsgTypeUnknown    = "unknown"
+)
+
+// WALMessageType returns the type of the given WAL message, which is one of
+// the WALMsgType constants, and the height it relates to.
+// @internal used by the debug wal command.
+func WALMessageType(msg WALMessage) (msgType string, height int64) {
+	switch msg := msg.(type) {
+	case types.EventDataRoundState:
+		return WALMsgTypeRoundState, msg.Height
+	case msgInfo:
+		switch m := msg.Msg.(type) {
+		case *ProposalMessage:
+			return WALMsgTypeProposal, m.Proposal.Height
+		case *BlockPartMessage:
+			return WALMsgTypeBlockPart, m.Height
+		case *VoteMessage:
+			return WALMsgTypeVote, m.Vote.Height
+		}
+	case timeoutInfo:
+		return WALMsgTypeTimeout, msg.Height
+	case EndHeightMessage:
+		return WALMsgTypeEndHeight, msg.Height
+	}
+	return WALMsgTypeUnknown, 0
+}
+
 type nilWAL struct{}
 
 var _ WAL = nilWAL{}
@@ -139,6 +139,27 @@ func TestWALEncoderDecoder(t *testing.T) {
 	}
 }
 
+func TestWALMessageType(t *testing.T) {
+	vote := &cmttypes.Vote{Type: cmttypes.PrevoteType, Height: 4}
+	for _, tc := range []struct {
+		msg     WALMessage
+		msgType string
+		height  int64
+	}{
+		{EndHeightMessage{1}, WALMsgTypeEndHeight, 1},
+		{timeoutInfo{Duration: time.Second, Height: 2, Round: 1, Step: types.RoundStepPropose}, WALMsgTypeTimeout, 2},
+		{cmttypes.EventDataRoundState{Height: 3, Round: 1}, WALMsgTypeRoundState, 3},
+		{msgInfo{Msg: &VoteMessage{Vote: vote}, PeerID: "Nobody"}, WALMsgTypeVote, 4},
+		{msgInfo{Msg: &ProposalMessage{Proposal: &cmttypes.Proposal{Height: 5}}}, WALMsgTypeProposal, 5},
+		{msgInfo{Msg: &BlockPartMessage{Height: 6}}, WALMsgTypeBlockPart, 6},
+		{msgInfo{Msg: &HasVoteMessage{Height: 7}}, WALMsgTypeUnknown, 0},
+	} {
+		msgType, height := WALMessageType(tc.msg)
+		assert.Equal(t, tc.msgType, msgType, "%T", tc.msg)
+		assert.Equal(t, tc.height, height, "%T", tc.msg)
+	}
+}
+
 func TestWALEncoderDecoderMultiVersion(t *testing.T) {
 	now := time.Time{}.AddDate(100, 10, 20)
 	v038Data, _ := hex.DecodeString("a570586b000000c50a0b0880e2c3b1a4feffffff0112b50112b2010aa7011aa4010aa1010820102a180d200c2a480a2001c073624aaf3978514ef8443bb2a859c75fc3cc6af26d5aaa20926f046baa6612240805122001c073624aaf3978514ef8443bb2a859c75fc3cc6af26d5aaa20926f046baa66320b0880e2c3b1a4feffffff013a404942b2803552651e1c7e7b72557cdade0a4c5a638dcda9822ec402d42c5f75c767f62c0f3fb0d58aef7842a4e18964faaff3d17559989cf1f11dd006e31a9d0f12064e6f626f6479")
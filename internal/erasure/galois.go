@@ -0,0 +1,113 @@
+package erasure
+
+// Arithmetic in GF(2^8), with the primitive polynomial x^8 + x^4 + x^3 + x^2 + 1
+// (0x11d) and generator 2.
+
+const fieldPolynomial = 0x11d
+
+var (
+	expTable [510]byte      // expTable[i] = 2^i, doubled to avoid a modulo in galMul
+	logTable [256]byte      // logTable[x] = log_2(x), for x != 0
+	mulTable [256][256]byte // mulTable[a][b] = a * b
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = byte(x)
+		expTable[i+255] = byte(x)
+		logTable[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= fieldPolynomial
+		}
+	}
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			mulTable[a][b] = galMul(byte(a), byte(b))
+		}
+	}
+}
+
+// galMul returns a * b.
+func galMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+int(logTable[b])]
+}
+
+// galInv returns the multiplicative inverse of a, which must not be zero.
+func galInv(a byte) byte {
+	return expTable[255-int(logTable[a])]
+}
+
+// galMulSliceXor sets out[i] ^= c * in[i] for all i.
+func galMulSliceXor(c byte, in, out []byte) {
+	if c == 0 {
+		return
+	}
+	mt := &mulTable[c]
+	for i, x := range in {
+		out[i] ^= mt[x]
+	}
+}
+
+// matrix is a matrix over GF(2^8).
+type matrix [][]byte
+
+func newMatrix(rows, cols int) matrix {
+	m := make(matrix, rows)
+	for i := range m {
+		m[i] = make([]byte, cols)
+	}
+	return m
+}
+
+// invert returns the inverse of the square matrix m, computed by Gauss-Jordan
+// elimination, or false if m is singular. m is not modified.
+func (m matrix) invert() (matrix, bool) {
+	n := len(m)
+	work := newMatrix(n, 2*n)
+	for i := range m {
+		copy(work[i], m[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		// Find a pivot and move it to the diagonal.
+		pivot := -1
+		for row := col; row < n; row++ {
+			if work[row][col] != 0 {
+				pivot = row
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, false
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		// Scale the pivot row so that the pivot is 1.
+		if p := work[col][col]; p != 1 {
+			inv := galInv(p)
+			for j := range work[col] {
+				work[col][j] = galMul(work[col][j], inv)
+			}
+		}
+
+		// Eliminate the column from all other rows.
+		for row := 0; row < n; row++ {
+			if row == col || work[row][col] == 0 {
+				continue
+			}
+			galMulSliceXor(work[row][col], work[col], work[row])
+		}
+	}
+
+	inv := newMatrix(n, n)
+	for i := range inv {
+		copy(inv[i], work[i][n:])
+	}
+	return inv, true
+}
@@ -0,0 +1,184 @@
+// Package erasure implements a systematic Reed-Solomon erasure code over
+// GF(2^8).
+//
+// Data is split into k data shards of equal size, to which m parity shards are
+// added. Any k of the k+m shards reconstruct the data. The data shards are
+// left unchanged (the code is systematic), and the parity shards are computed
+// with a Cauchy matrix, any square submatrix of which is invertible.
+package erasure
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxShards is the maximum total number of shards, data and parity.
+const MaxShards = 256
+
+var (
+	ErrInvalidShardCounts = errors.New("invalid number of data or parity shards")
+	ErrShardSize          = errors.New("shards must be non-empty and of equal size")
+	ErrTooFewShards       = errors.New("too few shards to reconstruct the data")
+)
+
+// Encoder encodes and reconstructs a fixed number of data and parity shards.
+// It is safe for concurrent use.
+type Encoder struct {
+	dataShards   int
+	parityShards int
+	parity       matrix // parityShards x dataShards Cauchy matrix
+}
+
+// NewEncoder returns an Encoder for the given number of data and parity
+// shards. There must be at least one data shard, and at most MaxShards shards
+// in total.
+func NewEncoder(dataShards, parityShards int) (*Encoder, error) {
+	if dataShards < 1 || parityShards < 0 || dataShards+parityShards > MaxShards {
+		return nil, fmt.Errorf("%w: %d data, %d parity", ErrInvalidShardCounts, dataShards, parityShards)
+	}
+
+	// Cauchy matrix: parity[i][j] = 1 / (x_i + y_j), with x_i = dataShards+i
+	// and y_j = j, which are all distinct.
+	parity := newMatrix(parityShards, dataShards)
+	for i := range parity {
+		for j := range parity[i] {
+			parity[i][j] = galInv(byte(dataShards+i) ^ byte(j))
+		}
+	}
+	return &Encoder{
+		dataShards:   dataShards,
+		parityShards: parityShards,
+		parity:       parity,
+	}, nil
+}
+
+// DataShards returns the number of data shards.
+func (e *Encoder) DataShards() int {
+	return e.dataShards
+}
+
+// ParityShards returns the number of parity shards.
+func (e *Encoder) ParityShards() int {
+	return e.parityShards
+}
+
+// Encode computes the parity shards from the data shards. shards must contain
+// the data shards followed by the parity shards, all of the same size; the
+// contents of the parity shards are overwritten.
+func (e *Encoder) Encode(shards [][]byte) error {
+	if len(shards) != e.dataShards+e.parityShards {
+		return fmt.Errorf("%w: got %d shards, expected %d", ErrInvalidShardCounts, len(shards), e.dataShards+e.parityShards)
+	}
+	size, err := shardSize(shards)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if len(shard) != size {
+			return ErrShardSize
+		}
+	}
+
+	e.encodeParity(shards[:e.dataShards], shards[e.dataShards:])
+	return nil
+}
+
+func (e *Encoder) encodeParity(data, parity [][]byte) {
+	for i, out := range parity {
+		clear(out)
+		for j, in := range data {
+			galMulSliceXor(e.parity[i][j], in, out)
+		}
+	}
+}
+
+// Reconstruct recomputes the missing shards, which must be nil, from the
+// shards present. shards must contain the data shards followed by the parity
+// shards, and at least DataShards of them must be present, all of the same
+// size. Missing shards are allocated.
+func (e *Encoder) Reconstruct(shards [][]byte) error {
+	if len(shards) != e.dataShards+e.parityShards {
+		return fmt.Errorf("%w: got %d shards, expected %d", ErrInvalidShardCounts, len(shards), e.dataShards+e.parityShards)
+	}
+	size, err := shardSize(shards)
+	if err != nil {
+		return err
+	}
+
+	// Pick the first dataShards shards present, along with the corresponding
+	// rows of the encoding matrix, i.e. the identity for data shards and the
+	// Cauchy matrix for parity shards.
+	present := make([]int, 0, e.dataShards)
+	for i, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if len(shard) != size {
+			return ErrShardSize
+		}
+		if len(present) < e.dataShards {
+			present = append(present, i)
+		}
+	}
+	if len(present) < e.dataShards {
+		return ErrTooFewShards
+	}
+
+	missingData := false
+	for i := 0; i < e.dataShards; i++ {
+		if shards[i] == nil {
+			missingData = true
+			break
+		}
+	}
+	if missingData {
+		sub := newMatrix(e.dataShards, e.dataShards)
+		for r, i := range present {
+			if i < e.dataShards {
+				sub[r][i] = 1
+			} else {
+				copy(sub[r], e.parity[i-e.dataShards])
+			}
+		}
+		dec, ok := sub.invert()
+		if !ok {
+			// Impossible, since any square submatrix of [I; C] is invertible.
+			return errors.New("singular decoding matrix")
+		}
+		for i := 0; i < e.dataShards; i++ {
+			if shards[i] != nil {
+				continue
+			}
+			out := make([]byte, size)
+			for r, j := range present {
+				galMulSliceXor(dec[i][r], shards[j], out)
+			}
+			shards[i] = out
+		}
+	}
+
+	for i := 0; i < e.parityShards; i++ {
+		if shards[e.dataShards+i] != nil {
+			continue
+		}
+		out := make([]byte, size)
+		for j, in := range shards[:e.dataShards] {
+			galMulSliceXor(e.parity[i][j], in, out)
+		}
+		shards[e.dataShards+i] = out
+	}
+	return nil
+}
+
+// shardSize returns the size of the shards present, which must be non-empty.
+func shardSize(shards [][]byte) (int, error) {
+	for _, shard := range shards {
+		if shard != nil {
+			if len(shard) == 0 {
+				return 0, ErrShardSize
+			}
+			return len(shard), nil
+		}
+	}
+	return 0, ErrTooFewShards
+}
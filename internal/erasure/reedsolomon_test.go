@@ -0,0 +1,86 @@
+package erasure
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+)
+
+func TestGaloisField(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		assert.Equal(t, byte(1), galMul(byte(a), galInv(byte(a))), "a=%d", a)
+		assert.Equal(t, byte(a), galMul(byte(a), 1), "a=%d", a)
+		assert.Equal(t, byte(0), galMul(byte(a), 0), "a=%d", a)
+	}
+}
+
+func TestNewEncoder(t *testing.T) {
+	for _, tc := range []struct {
+		data, parity int
+		expErr       bool
+	}{
+		{1, 0, false},
+		{10, 4, false},
+		{128, 128, false},
+		{0, 4, true},
+		{4, -1, true},
+		{200, 57, true},
+	} {
+		_, err := NewEncoder(tc.data, tc.parity)
+		assert.Equal(t, tc.expErr, err != nil, "data=%d parity=%d", tc.data, tc.parity)
+	}
+}
+
+func TestEncodeReconstruct(t *testing.T) {
+	const (
+		dataShards   = 10
+		parityShards = 4
+		size         = 1000
+	)
+	enc, err := NewEncoder(dataShards, parityShards)
+	require.NoError(t, err)
+
+	shards := make([][]byte, dataShards+parityShards)
+	for i := range shards {
+		if i < dataShards {
+			shards[i] = cmtrand.Bytes(size)
+		} else {
+			shards[i] = make([]byte, size)
+		}
+	}
+	require.NoError(t, enc.Encode(shards))
+
+	// Any dataShards shards reconstruct all the others.
+	for _, missing := range [][]int{
+		{},
+		{0},
+		{0, 1, 2, 3},
+		{10, 11, 12, 13},
+		{1, 4, 11, 13},
+		{6, 7, 8, 9},
+	} {
+		partial := make([][]byte, len(shards))
+		for i := range shards {
+			partial[i] = append([]byte(nil), shards[i]...)
+		}
+		for _, i := range missing {
+			partial[i] = nil
+		}
+		require.NoError(t, enc.Reconstruct(partial), "missing %v", missing)
+		assert.Equal(t, shards, partial, "missing %v", missing)
+	}
+
+	// Too many missing shards.
+	partial := make([][]byte, len(shards))
+	copy(partial[parityShards+1:], shards[parityShards+1:])
+	require.ErrorIs(t, enc.Reconstruct(partial), ErrTooFewShards)
+
+	// Shards of different sizes.
+	partial = make([][]byte, len(shards))
+	copy(partial, shards)
+	partial[3] = partial[3][:size-1]
+	require.ErrorIs(t, enc.Encode(partial), ErrShardSize)
+}
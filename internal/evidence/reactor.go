@@ -73,6 +73,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 	evis, err := evidenceListFromProto(e.Message)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
+		evR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 		evR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -83,6 +84,7 @@ func (evR *Reactor) Receive(e p2p.Envelope) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
 			// punish peer
+			evR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 			evR.Switch.StopPeerForError(e.Src, err)
 			return
 		case nil:
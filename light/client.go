@@ -125,6 +125,19 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// VerifyProposers makes the client verify that the proposer of each new header
+// is selected, in one of the rounds up to the round of its commit, by the
+// proposer selection of the consensus parameters in effect at its height (see
+// types.ValidatorParams). The parameters are fetched from the primary and
+// verified against the ConsensusHash of the header, so the primary and the
+// witnesses must all be provider.ConsensusParamsProvider.
+// Default: the proposer is not verified.
+func VerifyProposers() Option {
+	return func(c *Client) {
+		c.verifyProposers = true
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -139,6 +152,8 @@ type Client struct {
 	maxClockDrift    time.Duration
 	maxBlockLag      time.Duration
 
+	verifyProposers bool // see VerifyProposers option
+
 	// Mutex for locking during changes of the light clients providers
 	providerMutex cmtsync.Mutex
 	// Primary provider of new headers.
@@ -250,6 +265,16 @@ func NewClientFromTrustedStore(
 		}
 	}
 
+	// Verify all providers can serve the consensus parameters, as any witness
+	// may replace the primary.
+	if c.verifyProposers {
+		for _, p := range append([]provider.Provider{primary}, witnesses...) {
+			if _, ok := p.(provider.ConsensusParamsProvider); !ok {
+				return nil, ErrNoConsensusParamsProvider{Provider: p}
+			}
+		}
+	}
+
 	// Validate trust level.
 	if err := ValidateTrustLevel(c.trustLevel); err != nil {
 		return nil, err
@@ -608,6 +633,36 @@ func (c *Client) verifyLightBlock(ctx context.Context, newLightBlock *types.Ligh
 	return c.updateTrustedLightBlock(newLightBlock)
 }
 
+// verifyProposer verifies the proposer of the verified light block l against
+// the proposer selection of the consensus parameters at its height, if the
+// VerifyProposers option is set.
+func (c *Client) verifyProposer(ctx context.Context, l *types.LightBlock) error {
+	if !c.verifyProposers {
+		return nil
+	}
+
+	c.providerMutex.Lock()
+	primary := c.primary
+	c.providerMutex.Unlock()
+
+	paramsProvider, ok := primary.(provider.ConsensusParamsProvider)
+	if !ok {
+		return ErrNoConsensusParamsProvider{Provider: primary}
+	}
+	params, err := paramsProvider.ConsensusParams(ctx, l.Height)
+	if err != nil {
+		return err
+	}
+	if paramsHash := params.Hash(); !bytes.Equal(paramsHash, l.ConsensusHash) {
+		return ErrInvalidHeader{ErrConsensusParamsMismatch{
+			HeaderHash: l.ConsensusHash,
+			ParamsHash: paramsHash,
+			Height:     l.Height,
+		}}
+	}
+	return VerifyProposer(l.SignedHeader, l.ValidatorSet, params.Validator.ProposerSelection)
+}
+
 // see VerifyHeader.
 func (c *Client) verifySequential(
 	ctx context.Context,
@@ -642,6 +697,9 @@ func (c *Client) verifySequential(
 
 		err = VerifyAdjacent(verifiedBlock.SignedHeader, interimBlock.SignedHeader, interimBlock.ValidatorSet,
 			c.trustingPeriod, now, c.maxClockDrift)
+		if err == nil {
+			err = c.verifyProposer(ctx, interimBlock)
+		}
 		if err != nil {
 			err := ErrVerificationFailed{From: verifiedBlock.Height, To: interimBlock.Height, Reason: err}
 
@@ -726,6 +784,9 @@ func (c *Client) verifySkipping(
 
 		err := Verify(verifiedBlock.SignedHeader, verifiedBlock.ValidatorSet, blockCache[depth].SignedHeader,
 			blockCache[depth].ValidatorSet, c.trustingPeriod, now, c.maxClockDrift, c.trustLevel)
+		if err == nil {
+			err = c.verifyProposer(ctx, blockCache[depth])
+		}
 		switch err.(type) {
 		case nil:
 			// Have we verified the last header
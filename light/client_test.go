@@ -1,6 +1,7 @@
 package light_test
 
 import (
+	"bytes"
 	"context"
 	"sync"
 	"testing"
@@ -1151,3 +1152,134 @@ func TestClientHandlesContexts(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, context.Canceled)
 }
+
+func TestClient_VerifyProposers(t *testing.T) {
+	wrrParams := types.DefaultConsensusParams()
+	swrParams := types.DefaultConsensusParams()
+	swrParams.Validator.ProposerSelection = types.ProposerSelectionStakeWeightedRandom
+
+	// genLightBlock returns a light block proposed by proposer, if set, or by
+	// the proposer selected for the first round by the params.
+	genLightBlock := func(
+		height int64, last *types.SignedHeader, params *types.ConsensusParams, proposer []byte,
+	) *types.LightBlock {
+		header := genHeader(chainID, height, bTime.Add(time.Duration(height)*time.Minute), nil, vals, vals,
+			hash("app_hash"), params.Hash(), hash("results_hash"))
+		if last != nil {
+			header.LastBlockID = types.BlockID{Hash: last.Hash()}
+		}
+		if proposer == nil {
+			proposer = vals.ProposerForRound(params.Validator.ProposerSelection,
+				header.LastBlockID.Hash, height, 0).Address
+		}
+		header.ProposerAddress = proposer
+		return &types.LightBlock{
+			SignedHeader: &types.SignedHeader{Header: header, Commit: keys.signHeader(header, vals, 0, len(keys))},
+			ValidatorSet: vals,
+		}
+	}
+
+	// The proposer selection changes from the weighted round-robin to the
+	// stake-weighted random at height 3.
+	b1 := genLightBlock(1, nil, wrrParams, nil)
+	b2 := genLightBlock(2, b1.SignedHeader, wrrParams, nil)
+	b3 := genLightBlock(3, b2.SignedHeader, swrParams, nil)
+
+	// A validator not selected in any round up to the commit round.
+	var notSelected []byte
+	for _, val := range vals.Validators {
+		selected := false
+		for round := int32(0); round <= b3.Commit.Round; round++ {
+			proposer := vals.ProposerForRound(swrParams.Validator.ProposerSelection,
+				b2.Hash(), 3, round)
+			selected = selected || bytes.Equal(proposer.Address, val.Address)
+		}
+		if !selected {
+			notSelected = val.Address
+			break
+		}
+	}
+	require.NotNil(t, notSelected)
+
+	testCases := []struct {
+		name      string
+		target    *types.LightBlock
+		params    *types.ConsensusParams // at height 3
+		verifyErr error
+	}{
+		{
+			"good",
+			b3,
+			swrParams,
+			nil,
+		},
+		{
+			"bad: params not matching the consensus hash",
+			b3,
+			wrrParams,
+			light.ErrConsensusParamsMismatch{HeaderHash: swrParams.Hash(), ParamsHash: wrrParams.Hash(), Height: 3},
+		},
+		{
+			"bad: proposer not selected",
+			genLightBlock(3, b2.SignedHeader, swrParams, notSelected),
+			swrParams,
+			light.ErrInvalidProposer{
+				Selection:       types.ProposerSelectionStakeWeightedRandom,
+				ProposerAddress: notSelected,
+				Height:          3,
+				Round:           b3.Commit.Round,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks := []*types.LightBlock{b1, b2, tc.target}
+			headers := make(map[int64]*types.SignedHeader)
+			valSets := make(map[int64]*types.ValidatorSet)
+			for _, b := range blocks {
+				headers[b.Height] = b.SignedHeader
+				valSets[b.Height] = b.ValidatorSet
+			}
+			node := mockp.New(chainID, headers, valSets)
+			node.AddConsensusParams(1, wrrParams)
+			node.AddConsensusParams(2, wrrParams)
+			node.AddConsensusParams(3, tc.params)
+
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				light.TrustOptions{Period: trustPeriod, Height: 1, Hash: b1.Hash()},
+				node,
+				[]provider.Provider{node},
+				dbs.New(dbm.NewMemDB(), chainID),
+				light.SequentialVerification(),
+				light.VerifyProposers(),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, 3, bTime.Add(3*time.Hour))
+			if tc.verifyErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			var invalidErr light.ErrInvalidHeader
+			require.ErrorAs(t, err, &invalidErr)
+			require.Equal(t, tc.verifyErr, invalidErr.Reason)
+		})
+	}
+
+	t.Run("bad: provider without consensus params", func(t *testing.T) {
+		_, err := light.NewClient(
+			ctx,
+			chainID,
+			trustOptions,
+			fullNode,
+			[]provider.Provider{deadNode},
+			dbs.New(dbm.NewMemDB(), chainID),
+			light.VerifyProposers(),
+		)
+		require.Equal(t, light.ErrNoConsensusParamsProvider{Provider: deadNode}, err)
+	})
+}
@@ -115,6 +115,38 @@ func (e ErrValidatorsMismatch) Error() string {
 	return fmt.Sprintf("expected new header validators (%X) to match those that were supplied (%X) at height %d", e.HeaderHash, e.ValidatorsHash, e.Height)
 }
 
+type ErrInvalidProposer struct {
+	Selection       types.ProposerSelection
+	ProposerAddress cmtbytes.HexBytes
+	Height          int64
+	Round           int32
+}
+
+func (e ErrInvalidProposer) Error() string {
+	return fmt.Sprintf("proposer %X of the header at height %d is not selected by %v in any round up to the commit round %d",
+		e.ProposerAddress, e.Height, e.Selection, e.Round)
+}
+
+type ErrConsensusParamsMismatch struct {
+	HeaderHash cmtbytes.HexBytes
+	ParamsHash cmtbytes.HexBytes
+	Height     int64
+}
+
+func (e ErrConsensusParamsMismatch) Error() string {
+	return fmt.Sprintf("expected new header consensus hash (%X) to match the hash of the consensus params that were supplied (%X) at height %d", e.HeaderHash, e.ParamsHash, e.Height)
+}
+
+// ErrNoConsensusParamsProvider is returned when the proposers are verified
+// but a provider cannot serve the consensus parameters.
+type ErrNoConsensusParamsProvider struct {
+	Provider provider.Provider
+}
+
+func (e ErrNoConsensusParamsProvider) Error() string {
+	return fmt.Sprintf("provider %v does not provide consensus params, which are required to verify the proposers", e.Provider)
+}
+
 type ErrValidatorHashMismatch struct {
 	TrustedHash   cmtbytes.HexBytes
 	ValidatorHash cmtbytes.HexBytes
@@ -30,6 +30,8 @@ type http struct {
 	client  rpcclient.RemoteClient
 }
 
+var _ provider.ConsensusParamsProvider = (*http)(nil)
+
 // New creates a HTTP provider, which is using the rpchttp.HTTP client under
 // the hood. If no scheme is provided in the remote URL, http will be used by
 // default. The 5s timeout is used for all requests.
@@ -107,6 +109,34 @@ func (p *http) ReportEvidence(ctx context.Context, ev types.Evidence) error {
 	return err
 }
 
+// ConsensusParams calls `/consensus_params` endpoint.
+func (p *http) ConsensusParams(ctx context.Context, height int64) (*types.ConsensusParams, error) {
+	h, err := validateHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.client.ConsensusParams(ctx, h)
+	switch {
+	case err == nil:
+		if height != 0 && res.BlockHeight != height {
+			return nil, provider.ErrBadLightBlock{
+				Reason: fmt.Errorf("height %d responded doesn't match height %d requested", res.BlockHeight, height),
+			}
+		}
+		return &res.ConsensusParams, nil
+
+	case regexpTooHigh.MatchString(err.Error()):
+		return nil, provider.ErrHeightTooHigh
+
+	case regexpMissingHeight.MatchString(err.Error()):
+		return nil, provider.ErrLightBlockNotFound
+
+	default:
+		return nil, err
+	}
+}
+
 func (p *http) validatorSet(ctx context.Context, height *int64) (*types.ValidatorSet, error) {
 	// Since the malicious node could report a massive number of pages, making us
 	// spend a considerable time iterating, we restrict the number of pages here.
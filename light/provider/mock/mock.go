@@ -18,11 +18,12 @@ type Mock struct {
 	mtx              sync.Mutex
 	headers          map[int64]*types.SignedHeader
 	vals             map[int64]*types.ValidatorSet
+	params           map[int64]*types.ConsensusParams
 	evidenceToReport map[string]types.Evidence // hash => evidence
 	latestHeight     int64
 }
 
-var _ provider.Provider = (*Mock)(nil)
+var _ provider.ConsensusParamsProvider = (*Mock)(nil)
 
 // New creates a mock provider with the given set of headers and validator
 // sets.
@@ -37,6 +38,7 @@ func New(chainID string, headers map[int64]*types.SignedHeader, vals map[int64]*
 		chainID:          chainID,
 		headers:          headers,
 		vals:             vals,
+		params:           make(map[int64]*types.ConsensusParams),
 		evidenceToReport: make(map[string]types.Evidence),
 		latestHeight:     height,
 	}
@@ -102,6 +104,25 @@ func (p *Mock) LightBlock(ctx context.Context, height int64) (*types.LightBlock,
 	return lb, nil
 }
 
+// ConsensusParams returns the consensus parameters added for the given height
+// with AddConsensusParams.
+func (p *Mock) ConsensusParams(_ context.Context, height int64) (*types.ConsensusParams, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if height > p.latestHeight {
+		return nil, provider.ErrHeightTooHigh
+	}
+	if height == 0 {
+		height = p.latestHeight
+	}
+	params, ok := p.params[height]
+	if !ok {
+		return nil, provider.ErrLightBlockNotFound
+	}
+	return params, nil
+}
+
 func (p *Mock) ReportEvidence(_ context.Context, ev types.Evidence) error {
 	p.evidenceToReport[string(ev.Hash())] = ev
 	return nil
@@ -126,6 +147,19 @@ func (p *Mock) AddLightBlock(lb *types.LightBlock) {
 	}
 }
 
+// AddConsensusParams sets the consensus parameters in effect at the given
+// height.
+func (p *Mock) AddConsensusParams(height int64, params *types.ConsensusParams) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.params[height] = params
+}
+
 func (p *Mock) Copy(id string) *Mock {
-	return New(id, p.headers, p.vals)
+	c := New(id, p.headers, p.vals)
+	for h, params := range p.params {
+		c.params[h] = params
+	}
+	return c
 }
@@ -27,3 +27,20 @@ type Provider interface {
 	// ReportEvidence reports an evidence of misbehavior.
 	ReportEvidence(ctx context.Context, ev types.Evidence) error
 }
+
+// ConsensusParamsProvider is a Provider which also provides the consensus
+// parameters of the chain.
+type ConsensusParamsProvider interface {
+	Provider
+
+	// ConsensusParams returns the consensus parameters in effect at the given
+	// height. The parameters must be verified against the ConsensusHash of
+	// the header at that height.
+	//
+	// 0 - the latest.
+	// height must be >= 0.
+	//
+	// If there are no consensus parameters for the given height,
+	// ErrLightBlockNotFound error is returned.
+	ConsensusParams(ctx context.Context, height int64) (*types.ConsensusParams, error)
+}
@@ -34,12 +34,15 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
 		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
+		"validator_uptime":     rpcserver.NewRPCFunc(makeValidatorUptimeFunc(c), ""),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
+		"consensus_timeline":   rpcserver.NewRPCFunc(makeConsensusTimelineFunc(c), "height"),
 		"unconfirmed_tx":       rpcserver.NewRPCFunc(makeUnconfirmedTxFunc(c), "hash"),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
+		"tx_status":            rpcserver.NewRPCFunc(makeTxStatusFunc(c), "hash"),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
@@ -208,6 +211,14 @@ func makeValidatorsFunc(c *lrpc.Client) rpcValidatorsFunc {
 	}
 }
 
+type rpcValidatorUptimeFunc func(ctx *rpctypes.Context) (*ctypes.ResultValidatorUptime, error)
+
+func makeValidatorUptimeFunc(c *lrpc.Client) rpcValidatorUptimeFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultValidatorUptime, error) {
+		return c.ValidatorUptime(ctx.Context())
+	}
+}
+
 type rpcDumpConsensusStateFunc func(ctx *rpctypes.Context) (*ctypes.ResultDumpConsensusState, error)
 
 func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
@@ -232,6 +243,14 @@ func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
 	}
 }
 
+type rpcConsensusTimelineFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTimeline, error)
+
+func makeConsensusTimelineFunc(c *lrpc.Client) rpcConsensusTimelineFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+		return c.ConsensusTimeline(ctx.Context(), height)
+	}
+}
+
 type rpcUnconfirmedTxFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultUnconfirmedTx, error)
 
 func makeUnconfirmedTxFunc(c *lrpc.Client) rpcUnconfirmedTxFunc {
@@ -248,6 +267,14 @@ func makeUnconfirmedTxsFunc(c *lrpc.Client) rpcUnconfirmedTxsFunc {
 	}
 }
 
+type rpcTxStatusFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error)
+
+func makeTxStatusFunc(c *lrpc.Client) rpcTxStatusFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+		return c.TxStatus(ctx.Context(), hash)
+	}
+}
+
 type rpcNumUnconfirmedTxsFunc func(ctx *rpctypes.Context) (*ctypes.ResultUnconfirmedTxs, error)
 
 func makeNumUnconfirmedTxsFunc(c *lrpc.Client) rpcNumUnconfirmedTxsFunc {
@@ -204,6 +204,10 @@ func (c *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*ctypes.Result
 	return c.next.UnconfirmedTx(ctx, hash)
 }
 
+func (c *Client) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	return c.next.TxStatus(ctx, hash)
+}
+
 func (c *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.next.UnconfirmedTxs(ctx, limit)
 }
@@ -220,6 +224,12 @@ func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
 
+// ValidatorUptime calls rpcclient#ValidatorUptime. The uptime is tracked
+// locally by the node, thus it is not verified.
+func (c *Client) ValidatorUptime(ctx context.Context) (*ctypes.ResultValidatorUptime, error) {
+	return c.next.ValidatorUptime(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
@@ -256,6 +266,12 @@ func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.Re
 	return res, nil
 }
 
+// ConsensusTimeline calls rpcclient#ConsensusTimeline. The timeline is recorded
+// locally by the node, thus it is not verified.
+func (c *Client) ConsensusTimeline(ctx context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+	return c.next.ConsensusTimeline(ctx, height)
+}
+
 func (c *Client) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	return c.next.Health(ctx)
 }
@@ -174,6 +174,35 @@ func verifyNewHeaderAndVals(
 	return nil
 }
 
+// VerifyProposer verifies that the proposer of untrustedHeader is the one
+// selected by selection among untrustedVals, the validator set of the height,
+// in one of the rounds up to the round of the commit, as the block may have
+// been proposed in an earlier round. If not, ErrInvalidHeader is returned.
+//
+// untrustedVals must have been verified against untrustedHeader. Note that
+// the weighted round-robin depends on the proposer priorities of the
+// validators, which are not part of the validators hash, unlike the
+// stake-weighted random selection, which only depends on the voting powers.
+func VerifyProposer(
+	untrustedHeader *types.SignedHeader,
+	untrustedVals *types.ValidatorSet,
+	selection types.ProposerSelection,
+) error {
+	for round := int32(0); round <= untrustedHeader.Commit.Round; round++ {
+		proposer := untrustedVals.ProposerForRound(selection, untrustedHeader.LastBlockID.Hash,
+			untrustedHeader.Height, round)
+		if proposer != nil && bytes.Equal(proposer.Address, untrustedHeader.ProposerAddress) {
+			return nil
+		}
+	}
+	return ErrInvalidHeader{ErrInvalidProposer{
+		Selection:       selection,
+		ProposerAddress: untrustedHeader.ProposerAddress,
+		Height:          untrustedHeader.Height,
+		Round:           untrustedHeader.Commit.Round,
+	}}
+}
+
 // ValidateTrustLevel checks that trustLevel is within the allowed range [1/3,
 // 1]. If not, it returns an error. 1/3 is the minimum amount of trust needed
 // which does not break the security model.
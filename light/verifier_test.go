@@ -310,6 +310,51 @@ func TestVerifyReturnsErrorIfTrustLevelIsInvalid(t *testing.T) {
 	require.EqualError(t, err, expectedErr.Error())
 }
 
+func TestVerifyProposer(t *testing.T) {
+	const chainID = "TestVerifyProposer"
+
+	var (
+		keys     = genPrivKeys(4)
+		vals     = keys.ToValidators(20, 10)
+		bTime, _ = time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+		header   = keys.GenSignedHeaderLastBlockID(chainID, 2, bTime, nil, vals, vals,
+			hash("app_hash"), hash("cons_hash"), hash("results_hash"), 0, len(keys),
+			types.BlockID{Hash: hash("last_block")})
+	)
+	require.EqualValues(t, 1, header.Commit.Round)
+
+	for _, selection := range []types.ProposerSelection{
+		types.ProposerSelectionWeightedRoundRobin,
+		types.ProposerSelectionStakeWeightedRandom,
+	} {
+		t.Run(selection.String(), func(t *testing.T) {
+			// The block may have been proposed in any round up to the commit
+			// round.
+			proposers := make(map[string]bool)
+			for round := int32(0); round <= header.Commit.Round; round++ {
+				proposer := vals.ProposerForRound(selection, header.LastBlockID.Hash, header.Height, round)
+				header.ProposerAddress = proposer.Address
+				require.NoError(t, light.VerifyProposer(header, vals, selection))
+				proposers[string(proposer.Address)] = true
+			}
+
+			for _, val := range vals.Validators {
+				if proposers[string(val.Address)] {
+					continue
+				}
+				header.ProposerAddress = val.Address
+				err := light.VerifyProposer(header, vals, selection)
+				require.Equal(t, light.ErrInvalidHeader{Reason: light.ErrInvalidProposer{
+					Selection:       selection,
+					ProposerAddress: val.Address,
+					Height:          header.Height,
+					Round:           header.Commit.Round,
+				}}, err)
+			}
+		})
+	}
+}
+
 func TestValidateTrustLevel(t *testing.T) {
 	testCases := []struct {
 		lvl   cmtmath.Fraction
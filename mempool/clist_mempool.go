@@ -1,19 +1,19 @@
 package mempool
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/internal/clist"
 	"github.com/cometbft/cometbft/libs/log"
-	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/proxy"
@@ -46,16 +46,54 @@ type CListMempool struct {
 	// Keeps track of the rechecking process.
 	recheck *recheck
 
+	// If set, selects the txs to recheck after a block is committed.
+	// Otherwise, all txs are rechecked.
+	recheckPolicy RecheckPolicy
+
 	// Concurrent linked-list of valid txs.
 	// `txsMap`: txKey -> CElement is for quick access to txs.
 	// Transactions in both `txs` and `txsMap` must to be kept in sync.
 	txs    *clist.CList
 	txsMap sync.Map
 
+	// Optional partition of the txs into lanes, in the configured order. Each
+	// tx in `txs` is also in exactly one lane.
+	lanes       []*lane
+	defaultLane *lane
+
+	// Serializes the checks that a valid tx fits in the mempool, the eviction
+	// of other txs to make room for it and its addition, so that concurrent
+	// CheckTx responses do not exceed the limits or evict more txs than needed.
+	addMtx cmtsync.Mutex
+
+	// Number of txs in the mempool first received from each peer.
+	peerTxsMtx cmtsync.Mutex
+	peerTxs    map[p2p.ID]int
+
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Statuses of recently evicted and rejected txs.
+	txStatuses *txStatusCache
+
+	// If set, evict is called when a valid tx does not fit in the mempool. It
+	// may remove other txs to make room for memTx, and returns an error if it
+	// cannot. It is set by PriorityMempool.
+	evict func(memTx *mempoolTx) error
+
+	// If set, persists the txs in the mempool so that they can be restored
+	// with ReplayJournal after a restart.
+	journal *journal
+
+	// Publishes an event when a tx is added to the mempool, evicted from it,
+	// or removed from it after being committed.
+	eventBus types.MempoolEventPublisher
+
+	// If set, called with the sender of each tx added to the mempool, so that
+	// the reactor rewards the peers only for the txs actually admitted.
+	onPeerTxAdded func(sender p2p.ID)
+
 	logger  log.Logger
 	metrics *Metrics
 }
@@ -77,11 +115,15 @@ func NewCListMempool(
 		config:       cfg,
 		proxyAppConn: proxyAppConn,
 		txs:          clist.New(),
+		peerTxs:      make(map[p2p.ID]int),
+		txStatuses:   newTxStatusCache(cfg.CacheSize),
 		recheck:      newRecheck(),
+		eventBus:     types.NopEventBus{},
 		logger:       log.NewNopLogger(),
 		metrics:      NopMetrics(),
 	}
 	mp.height.Store(height)
+	mp.lanes, mp.defaultLane = newLanes(cfg)
 
 	if cfg.CacheSize > 0 {
 		mp.cache = NewLRUTxCache(cfg.CacheSize)
@@ -129,12 +171,35 @@ func (mem *CListMempool) removeAllTxs() {
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		mem.txs.Remove(e)
 		e.DetachPrev()
+		if mem.recheckPolicy != nil {
+			mem.recheckPolicy.TxRemoved(e.Value.(*mempoolTx).tx.Key())
+		}
+	}
+
+	for _, l := range mem.lanes {
+		for e := l.txs.Front(); e != nil; e = e.Next() {
+			l.txs.Remove(e)
+			e.DetachPrev()
+		}
+		l.txsBytes.Store(0)
+		mem.metrics.LaneSize.With("lane", l.id).Set(0)
+		mem.metrics.LaneSizeBytes.With("lane", l.id).Set(0)
 	}
 
 	mem.txsMap.Range(func(key, _ any) bool {
 		mem.txsMap.Delete(key)
 		return true
 	})
+
+	mem.peerTxsMtx.Lock()
+	clear(mem.peerTxs)
+	mem.peerTxsMtx.Unlock()
+
+	if mem.journal != nil {
+		if err := mem.journal.reset(); err != nil {
+			mem.logger.Error("failed to reset mempool journal", "err", err)
+		}
+	}
 }
 
 // NOTE: not thread safe - should only be called once, on startup.
@@ -166,6 +231,71 @@ func WithMetrics(metrics *Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithEventBus sets the event bus where the mempool publishes MempoolTx
+// events.
+func WithEventBus(eventBus types.MempoolEventPublisher) CListMempoolOption {
+	return func(mem *CListMempool) { mem.eventBus = eventBus }
+}
+
+// WithRecheckPolicy sets the policy that selects which transactions are
+// rechecked after a block is committed.
+func WithRecheckPolicy(policy RecheckPolicy) CListMempoolOption {
+	return func(mem *CListMempool) { mem.recheckPolicy = policy }
+}
+
+// WithJournal sets a database where the mempool records the txs it admits and
+// removes. Call ReplayJournal on startup to restore the txs it contains.
+func WithJournal(db dbm.DB) CListMempoolOption {
+	return func(mem *CListMempool) { mem.journal = newJournal(db) }
+}
+
+// ReplayJournal re-validates with CheckTx the transactions recorded in the
+// journal, if any, in the order in which they were originally added, and adds
+// the valid ones to the mempool. Transactions beyond MaxTxsBytes are dropped.
+//
+// It should be called once on startup, after the handshake with the
+// application and before the mempool reactor starts gossiping.
+func (mem *CListMempool) ReplayJournal() error {
+	if mem.journal == nil {
+		return nil
+	}
+
+	txs, corrupt, err := mem.journal.load(mem.config.MaxTxsBytes)
+	if err != nil {
+		return fmt.Errorf("failed to load mempool journal: %w", err)
+	}
+	if corrupt > 0 {
+		mem.logger.Error("skipped corrupted entries in mempool journal", "num", corrupt)
+	}
+
+	// Leave the journal untouched while replaying, so that its entries are
+	// not lost if the node stops before the replay completes. It is rewritten
+	// with the txs added back to the mempool once the replay is done.
+	journal := mem.journal
+	mem.journal = nil
+	for _, tx := range txs {
+		if _, err := mem.CheckTx(tx, ""); err != nil {
+			mem.logger.Debug("could not replay transaction", "tx", tx.Hash(), "err", err)
+		}
+	}
+	err = mem.FlushAppConn()
+	mem.journal = journal
+	if err != nil {
+		return err
+	}
+
+	replayed := make(types.Txs, 0, mem.Size())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		replayed = append(replayed, e.Value.(*mempoolTx).tx)
+	}
+	if err := mem.journal.rewrite(replayed); err != nil {
+		return fmt.Errorf("failed to rewrite mempool journal: %w", err)
+	}
+
+	mem.logger.Info("replayed mempool journal", "txs", len(txs), "added", mem.Size())
+	return nil
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -203,6 +333,7 @@ func (mem *CListMempool) Flush() {
 
 	mem.txsBytes.Store(0)
 	mem.cache.Reset()
+	mem.txStatuses.reset()
 
 	mem.removeAllTxs()
 }
@@ -225,6 +356,34 @@ func (mem *CListMempool) TxsWaitChan() <-chan struct{} {
 	return mem.txs.WaitChan()
 }
 
+// gossipQueues returns the lists of transactions that the reactor should
+// gossip independently to each peer: one per lane, or the list of all
+// transactions if the mempool has no lanes.
+func (mem *CListMempool) gossipQueues() []*clist.CList {
+	if len(mem.lanes) == 0 {
+		return []*clist.CList{mem.txs}
+	}
+	queues := make([]*clist.CList, 0, len(mem.lanes))
+	for _, l := range mem.lanes {
+		queues = append(queues, l.txs)
+	}
+	return queues
+}
+
+// getLane returns the lane with the given ID, or the default lane if the ID is
+// empty.
+func (mem *CListMempool) getLane(id string) (*lane, error) {
+	if id == "" {
+		return mem.defaultLane, nil
+	}
+	for _, l := range mem.lanes {
+		if l.id == id {
+			return l, nil
+		}
+	}
+	return nil, ErrUnknownLane{Lane: id}
+}
+
 // It blocks if we're waiting on Update() or Reap().
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) CheckTx(tx types.Tx, sender p2p.ID) (*abcicli.ReqRes, error) {
@@ -234,7 +393,10 @@ func (mem *CListMempool) CheckTx(tx types.Tx, sender p2p.ID) (*abcicli.ReqRes, e
 
 	txSize := len(tx)
 
-	if err := mem.isFull(txSize); err != nil {
+	// If txs can be evicted, we cannot tell whether the tx fits before knowing
+	// its priority, so we leave the decision to the CheckTx response handler.
+	if err := mem.isFull(txSize); err != nil && mem.evict == nil {
+		mem.setTxStatus(tx, TxStatusEvicted, TxStatus{Reason: EvictionReasonFull})
 		return nil, err
 	}
 
@@ -312,6 +474,11 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 		// If tx is invalid, remove it from the cache.
 		if res.Code != abci.CodeTypeOK || postCheckErr != nil {
 			mem.tryRemoveFromCache(tx)
+			status := TxStatus{Code: res.Code, Log: res.Log}
+			if postCheckErr != nil {
+				status.Log = postCheckErr.Error()
+			}
+			mem.setTxStatus(tx, TxStatusRejected, status)
 			mem.logger.Debug(
 				"rejected invalid transaction",
 				"tx", tx.Hash(),
@@ -322,21 +489,54 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 			return
 		}
 
-		// Check again that mempool isn't full, to reduce the chance of exceeding the limits.
-		if err := mem.isFull(len(tx)); err != nil {
-			mem.forceRemoveFromCache(tx) // mempool might have space later
-			mem.logger.Error(err.Error())
-			return
-		}
-
-		// Add tx to mempool and notify that new txs are available.
 		memTx := mempoolTx{
 			height:    mem.height.Load(),
+			timestamp: time.Now(),
 			gasWanted: res.GasWanted,
+			priority:  res.Priority,
 			tx:        tx,
 		}
-		if mem.addTx(&memTx, sender) {
+
+		if len(mem.lanes) > 0 {
+			l, err := mem.getLane(res.LaneId)
+			if err != nil {
+				mem.tryRemoveFromCache(tx)
+				mem.setTxStatus(tx, TxStatusRejected, TxStatus{Log: err.Error()})
+				mem.logger.Error("rejected transaction", "tx", tx.Hash(), "err", err)
+				mem.metrics.FailedTxs.Add(1)
+				return
+			}
+			memTx.lane = l
+		}
+
+		// Check again that mempool isn't full, and add tx to mempool while
+		// holding addMtx so that concurrent responses cannot exceed the limits.
+		mem.addMtx.Lock()
+		if err := mem.isFullFor(&memTx); err != nil {
+			if mem.evict != nil {
+				err = mem.evict(&memTx)
+			}
+			if err != nil {
+				mem.addMtx.Unlock()
+				mem.forceRemoveFromCache(tx) // mempool might have space later
+				mem.setTxStatus(tx, TxStatusEvicted, TxStatus{Reason: EvictionReasonFull})
+				mem.logger.Error(err.Error())
+				mem.metrics.RejectedTxs.Add(1)
+				return
+			}
+		}
+		added := mem.addTx(&memTx, sender)
+		mem.addMtx.Unlock()
+
+		// Notify that new txs are available.
+		if added {
+			if mem.recheckPolicy != nil {
+				mem.recheckPolicy.TxAdded(tx.Key(), res)
+			}
 			mem.notifyTxsAvailable()
+			if sender != "" && mem.onPeerTxAdded != nil {
+				mem.onPeerTxAdded(sender)
+			}
 
 			// update metrics
 			mem.metrics.Size.Set(float64(mem.Size()))
@@ -346,7 +546,7 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender p2p.ID) func(
 }
 
 // Called from:
-//   - handleCheckTxResponse (lock not held) if tx is valid
+//   - handleCheckTxResponse (addMtx held) if tx is valid
 func (mem *CListMempool) addTx(memTx *mempoolTx, sender p2p.ID) bool {
 	tx := memTx.tx
 	txKey := tx.Key()
@@ -373,11 +573,33 @@ func (mem *CListMempool) addTx(memTx *mempoolTx, sender p2p.ID) bool {
 
 	// Add new transaction.
 	_ = memTx.addSender(sender)
+	mem.txStatuses.remove(txKey)
+	if sender != "" {
+		memTx.sender = sender
+		mem.peerTxsMtx.Lock()
+		mem.peerTxs[sender]++
+		mem.peerTxsMtx.Unlock()
+	}
 	e := mem.txs.PushBack(memTx)
 	mem.txsMap.Store(txKey, e)
 	mem.txsBytes.Add(int64(len(tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(tx)))
 
+	if mem.journal != nil {
+		if err := mem.journal.add(tx); err != nil {
+			mem.logger.Error("failed to record transaction in mempool journal", "tx", tx.Hash(), "err", err)
+		}
+	}
+
+	if l := memTx.lane; l != nil {
+		memTx.laneElem = l.txs.PushBack(memTx)
+		l.txsBytes.Add(int64(len(tx)))
+		mem.metrics.LaneSize.With("lane", l.id).Set(float64(l.txs.Len()))
+		mem.metrics.LaneSizeBytes.With("lane", l.id).Set(float64(l.txsBytes.Load()))
+	}
+
+	mem.publishTxEvent(types.MempoolTxAdded, memTx, memTx.Height(), "")
+
 	mem.logger.Debug(
 		"added valid transaction",
 		"tx", tx.Hash(),
@@ -392,18 +614,60 @@ func (mem *CListMempool) addTx(memTx *mempoolTx, sender p2p.ID) bool {
 //   - Update (lock held) if tx was committed
 //   - handleRecheckTxResponse (lock not held) if tx was invalidated
 func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
+	_, err := mem.removeTx(txKey)
+	return err
+}
+
+// removeTx removes a transaction from the mempool by its TxKey index, and
+// returns the removed entry.
+func (mem *CListMempool) removeTx(txKey types.TxKey) (*mempoolTx, error) {
 	elem, ok := mem.getCElement(txKey)
 	if !ok {
-		return ErrTxNotFound
+		return nil, ErrTxNotFound
 	}
 
 	mem.txs.Remove(elem)
 	elem.DetachPrev()
 	mem.txsMap.Delete(txKey)
-	tx := elem.Value.(*mempoolTx).tx
+	memTx := elem.Value.(*mempoolTx)
+	tx := memTx.tx
 	mem.txsBytes.Add(int64(-len(tx)))
+
+	if memTx.sender != "" {
+		mem.peerTxsMtx.Lock()
+		if mem.peerTxs[memTx.sender]--; mem.peerTxs[memTx.sender] <= 0 {
+			delete(mem.peerTxs, memTx.sender)
+		}
+		mem.peerTxsMtx.Unlock()
+	}
+
+	if l := memTx.lane; l != nil {
+		l.txs.Remove(memTx.laneElem)
+		memTx.laneElem.DetachPrev()
+		l.txsBytes.Add(int64(-len(tx)))
+		mem.metrics.LaneSize.With("lane", l.id).Set(float64(l.txs.Len()))
+		mem.metrics.LaneSizeBytes.With("lane", l.id).Set(float64(l.txsBytes.Load()))
+	}
+
+	if mem.recheckPolicy != nil {
+		mem.recheckPolicy.TxRemoved(txKey)
+	}
+
+	if mem.journal != nil {
+		if err := mem.journal.remove(txKey); err != nil {
+			mem.logger.Error("failed to record transaction removal in mempool journal", "tx", tx.Hash(), "err", err)
+		}
+	}
 	mem.logger.Debug("removed transaction", "tx", tx.Hash(), "height", mem.height.Load(), "total", mem.Size())
-	return nil
+	return memTx, nil
+}
+
+// numPeerTxs returns the number of txs in the mempool first received from
+// peerID.
+func (mem *CListMempool) numPeerTxs(peerID p2p.ID) int {
+	mem.peerTxsMtx.Lock()
+	defer mem.peerTxsMtx.Unlock()
+	return mem.peerTxs[peerID]
 }
 
 func (mem *CListMempool) isFull(txSize int) error {
@@ -424,6 +688,18 @@ func (mem *CListMempool) isFull(txSize int) error {
 	return nil
 }
 
+// isFullFor returns an error if memTx does not fit in the mempool or in its
+// lane.
+func (mem *CListMempool) isFullFor(memTx *mempoolTx) error {
+	if err := mem.isFull(len(memTx.tx)); err != nil {
+		return err
+	}
+	if memTx.lane != nil {
+		return memTx.lane.isFull(len(memTx.tx))
+	}
+	return nil
+}
+
 // handleRecheckTxResponse handles CheckTx responses for transactions in the mempool that need to be
 // revalidated after a mempool update.
 func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Response) {
@@ -441,9 +717,8 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 		}
 		mem.metrics.RecheckTimes.Add(1)
 
-		// Check whether tx is still in the list of transactions that can be rechecked.
-		if !mem.recheck.findNextEntryMatching(&tx) {
-			// Reached the end of the list and didn't find a matching tx; rechecking has finished.
+		// Check whether tx is still pending to recheck.
+		if !mem.recheck.consume(tx.Key()) {
 			return
 		}
 
@@ -456,14 +731,24 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 		if (res.Code != abci.CodeTypeOK) || postCheckErr != nil {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Debug("tx is no longer valid", "tx", tx.Hash(), "res", res, "postCheckErr", postCheckErr)
-			if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+			if memTx, err := mem.removeTx(tx.Key()); err != nil {
 				mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
 			} else {
 				// update metrics
 				mem.metrics.Size.Set(float64(mem.Size()))
 				mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+				mem.txEvicted(memTx, EvictionReasonRecheck)
 			}
 			mem.tryRemoveFromCache(tx)
+			return
+		}
+
+		// The application may assign a different priority to the tx now that
+		// the state has changed.
+		if elem, ok := mem.getCElement(tx.Key()); ok {
+			mem.addMtx.Lock()
+			elem.Value.(*mempoolTx).priority = res.Priority
+			mem.addMtx.Unlock()
 		}
 	}
 }
@@ -486,30 +771,67 @@ func (mem *CListMempool) notifyTxsAvailable() {
 	}
 }
 
+// ReapMaxBytesMaxGas reaps transactions in the order in which they were added
+// to the mempool. If the mempool has lanes, it interleaves the transactions of
+// each lane using a weighted round-robin.
+//
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	mem.updateMtx.RLock()
 	defer mem.updateMtx.RUnlock()
 
+	return reapMaxBytesMaxGas(interleaveLanes(mem.laneTxs()), maxBytes, maxGas)
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return reapMaxTxs(interleaveLanes(mem.laneTxs()), max)
+}
+
+// laneTxs returns the transactions of each lane, in the order in which they
+// were added, together with the weight of each lane. If the mempool has no
+// lanes, it returns all transactions as a single lane.
+func (mem *CListMempool) laneTxs() ([][]*mempoolTx, []int) {
+	if len(mem.lanes) == 0 {
+		return [][]*mempoolTx{listMemTxs(mem.txs)}, []int{1}
+	}
+	laneTxs := make([][]*mempoolTx, 0, len(mem.lanes))
+	weights := make([]int, 0, len(mem.lanes))
+	for _, l := range mem.lanes {
+		laneTxs = append(laneTxs, listMemTxs(l.txs))
+		weights = append(weights, l.weight)
+	}
+	return laneTxs, weights
+}
+
+// listMemTxs returns the transactions in the list, in order.
+func listMemTxs(l *clist.CList) []*mempoolTx {
+	memTxs := make([]*mempoolTx, 0, l.Len())
+	for e := l.Front(); e != nil; e = e.Next() {
+		memTxs = append(memTxs, e.Value.(*mempoolTx))
+	}
+	return memTxs
+}
+
+// reapMaxBytesMaxGas returns the longest prefix of memTxs with total size at
+// most maxBytes and total gasWanted at most maxGas. A negative max means no
+// limit.
+func reapMaxBytesMaxGas(memTxs []*mempoolTx, maxBytes, maxGas int64) types.Txs {
 	var (
 		totalGas    int64
 		runningSize int64
 	)
 
-	// TODO: we will get a performance boost if we have a good estimate of avg
-	// size per tx, and set the initial capacity based off of that.
-	// txs := make([]types.Tx, 0, cmtmath.MinInt(mem.txs.Len(), max/mem.avgTxSize))
-	txs := make([]types.Tx, 0, mem.txs.Len())
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
-
-		txs = append(txs, memTx.tx)
-
+	txs := make([]types.Tx, 0, len(memTxs))
+	for _, memTx := range memTxs {
 		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
 
 		// Check total size requirement
 		if maxBytes > -1 && runningSize+dataSize > maxBytes {
-			return txs[:len(txs)-1]
+			return txs
 		}
 
 		runningSize += dataSize
@@ -520,30 +842,81 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 		// must be non-negative, it follows that this won't overflow.
 		newTotalGas := totalGas + memTx.gasWanted
 		if maxGas > -1 && newTotalGas > maxGas {
-			return txs[:len(txs)-1]
+			return txs
 		}
 		totalGas = newTotalGas
+
+		txs = append(txs, memTx.tx)
 	}
 	return txs
 }
 
-// Safe for concurrent use by multiple goroutines.
-func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
-	mem.updateMtx.RLock()
-	defer mem.updateMtx.RUnlock()
-
-	if max < 0 {
-		max = mem.txs.Len()
+// reapMaxTxs returns up to max transactions from the start of memTxs. If max
+// is negative, it returns all of them.
+func reapMaxTxs(memTxs []*mempoolTx, max int) types.Txs {
+	if max < 0 || max > len(memTxs) {
+		max = len(memTxs)
 	}
 
-	txs := make([]types.Tx, 0, cmtmath.MinInt(mem.txs.Len(), max))
-	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	txs := make([]types.Tx, 0, max)
+	for _, memTx := range memTxs[:max] {
 		txs = append(txs, memTx.tx)
 	}
 	return txs
 }
 
+// GetTxStatus returns the status of the transaction with the given key: pending
+// if it is in the mempool, or evicted or rejected if it was recently removed
+// from the mempool or rejected by CheckTx. Otherwise, the status is unknown.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) GetTxStatus(txKey types.TxKey) TxStatus {
+	if elem, ok := mem.getCElement(txKey); ok {
+		memTx := elem.Value.(*mempoolTx)
+		return TxStatus{
+			Status: TxStatusPending,
+			Height: memTx.Height(),
+			Time:   memTx.timestamp,
+			Sender: memTx.sender,
+		}
+	}
+	if status, ok := mem.txStatuses.get(txKey); ok {
+		return status
+	}
+	return TxStatus{Status: TxStatusUnknown}
+}
+
+// setTxStatus records that tx was evicted or rejected at the current height
+// and time. Other details are taken from status.
+func (mem *CListMempool) setTxStatus(tx types.Tx, s string, status TxStatus) {
+	status.Status = s
+	status.Height = mem.height.Load()
+	status.Time = time.Now()
+	mem.txStatuses.set(tx.Key(), status)
+}
+
+// txEvicted records that memTx was evicted from the mempool for the given
+// reason, and publishes the corresponding event.
+func (mem *CListMempool) txEvicted(memTx *mempoolTx, reason string) {
+	mem.setTxStatus(memTx.tx, TxStatusEvicted, TxStatus{Reason: reason})
+	mem.publishTxEvent(types.MempoolTxEvicted, memTx, mem.height.Load(), reason)
+}
+
+// publishTxEvent publishes a MempoolTx event for memTx.
+func (mem *CListMempool) publishTxEvent(action string, memTx *mempoolTx, height int64, reason string) {
+	err := mem.eventBus.PublishEventMempoolTx(types.EventDataMempoolTx{
+		Tx:        memTx.tx,
+		Action:    action,
+		Height:    height,
+		GasWanted: memTx.gasWanted,
+		Sender:    string(memTx.sender),
+		Reason:    reason,
+	})
+	if err != nil {
+		mem.logger.Error("failed publishing mempool tx event", "tx", memTx.tx.Hash(), "err", err)
+	}
+}
+
 // GetTxByHash returns the types.Tx with the given hash if found in the mempool, otherwise returns nil.
 func (mem *CListMempool) GetTxByHash(hash []byte) types.Tx {
 	if elem, ok := mem.getCElement(types.TxKey(hash)); ok {
@@ -552,12 +925,40 @@ func (mem *CListMempool) GetTxByHash(hash []byte) types.Tx {
 	return nil
 }
 
+// DumpTxs returns all the transactions in the mempool, in the order in which
+// they were added, along with their metadata.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) DumpTxs() []TxInfo {
+	infos := make([]TxInfo, 0, mem.Size())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		info := TxInfo{
+			Tx:        memTx.tx,
+			Height:    memTx.Height(),
+			Time:      memTx.timestamp,
+			GasWanted: memTx.gasWanted,
+		}
+		if memTx.sender != "" {
+			info.Senders = append(info.Senders, memTx.sender)
+		}
+		memTx.senders.Range(func(key, _ any) bool {
+			if peerID := key.(p2p.ID); peerID != memTx.sender {
+				info.Senders = append(info.Senders, peerID)
+			}
+			return true
+		})
+		infos = append(infos, info)
+	}
+	return infos
+}
+
 // Lock() must be help by the caller during execution.
 // TODO: this function always returns nil; remove the return value.
 func (mem *CListMempool) Update(
 	height int64,
 	txs types.Txs,
-	txResults []*abci.ExecTxResult,
+	abciResponse *abci.FinalizeBlockResponse,
 	preCheck PreCheckFunc,
 	postCheck PostCheckFunc,
 ) error {
@@ -575,7 +976,7 @@ func (mem *CListMempool) Update(
 	}
 
 	for i, tx := range txs {
-		if txResults[i].Code == abci.CodeTypeOK {
+		if abciResponse.TxResults[i].Code == abci.CodeTypeOK {
 			// Add valid committed tx to the cache (if missing).
 			_ = mem.addToCache(tx)
 		} else {
@@ -592,15 +993,23 @@ func (mem *CListMempool) Update(
 		// Mempool after:
 		//   100
 		// https://github.com/tendermint/tendermint/issues/3322.
-		if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+		if memTx, err := mem.removeTx(tx.Key()); err != nil {
 			mem.logger.Debug("Committed transaction not in local mempool (not an error)",
 				"tx", tx.Hash(),
 				"error", err.Error())
+		} else {
+			mem.publishTxEvent(types.MempoolTxCommitted, memTx, height, "")
 		}
 	}
 
+	// Remove txs that have been in the mempool for too long.
+	mem.purgeExpiredTxs(height)
+
 	// Recheck txs left in the mempool to remove them if they became invalid in the new state.
 	if mem.config.Recheck {
+		if mem.recheckPolicy != nil {
+			mem.recheckPolicy.BlockCommitted(height, txs, abciResponse)
+		}
 		mem.recheckTxs()
 	}
 
@@ -616,8 +1025,44 @@ func (mem *CListMempool) Update(
 	return nil
 }
 
-// recheckTxs sends all transactions in the mempool to the app for re-validation. When the function
-// returns, all recheck responses from the app have been processed.
+// purgeExpiredTxs removes from the mempool and the cache all transactions that
+// have exceeded the ttl_num_blocks or ttl_duration limits. The cache entries
+// are removed so that expired transactions can be submitted again.
+//
+// Lock() must be held by the caller during execution.
+func (mem *CListMempool) purgeExpiredTxs(blockHeight int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := time.Now()
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		expiredHeight := mem.config.TTLNumBlocks > 0 && blockHeight-memTx.Height() > mem.config.TTLNumBlocks
+		expiredTime := mem.config.TTLDuration > 0 && now.Sub(memTx.timestamp) > mem.config.TTLDuration
+		if !expiredHeight && !expiredTime {
+			continue
+		}
+
+		if err := mem.RemoveTxByKey(memTx.tx.Key()); err != nil {
+			mem.logger.Error("Expired transaction could not be removed from mempool", "tx", memTx.tx.Hash(), "err", err)
+			continue
+		}
+		mem.forceRemoveFromCache(memTx.tx)
+		mem.txEvicted(memTx, EvictionReasonTTL)
+		mem.metrics.ExpiredTxs.Add(1)
+		mem.logger.Debug("removed expired transaction",
+			"tx", memTx.tx.Hash(),
+			"height", memTx.Height(),
+			"timestamp", memTx.timestamp,
+		)
+	}
+}
+
+// recheckTxs sends the transactions in the mempool selected by the recheck policy, if any, to the
+// app for re-validation. Transactions are sent in batches of recheck_batch_size, by up to
+// recheck_concurrency batches at a time. When the function returns, all recheck responses from the
+// app have been processed, or recheck_timeout has passed.
 func (mem *CListMempool) recheckTxs() {
 	mem.logger.Debug("recheck txs", "height", mem.height.Load(), "num-txs", mem.Size())
 
@@ -625,27 +1070,57 @@ func (mem *CListMempool) recheckTxs() {
 		return
 	}
 
-	mem.recheck.init(mem.txs.Front(), mem.txs.Back())
-
-	// NOTE: CheckTx for new transactions cannot be executed concurrently
-	// because this function has the lock (via Update and Lock).
+	txs := make([]types.Tx, 0, mem.Size())
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		tx := e.Value.(*mempoolTx).tx
-		mem.recheck.numPendingTxs.Add(1)
-
-		// Send CheckTx request to the app to re-validate transaction.
-		resReq, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.CheckTxRequest{
-			Tx:   tx,
-			Type: abci.CHECK_TX_TYPE_RECHECK,
-		})
-		if err != nil {
-			panic(fmt.Errorf("(re-)CheckTx request for tx %s failed: %w", log.NewLazySprintf("%v", tx.Hash()), err))
+		if mem.recheckPolicy != nil && !mem.recheckPolicy.ShouldRecheck(tx.Key()) {
+			continue
 		}
-		resReq.SetCallback(mem.handleRecheckTxResponse(tx))
+		txs = append(txs, tx)
+	}
+	if skipped := mem.Size() - len(txs); skipped > 0 {
+		mem.metrics.RecheckSkippedTxs.Add(float64(skipped))
+	}
+	if len(txs) == 0 {
+		return
 	}
 
-	// Flush any pending asynchronous recheck requests to process.
-	mem.proxyAppConn.Flush(context.TODO())
+	start := time.Now()
+	mem.recheck.init(txs)
+
+	batchSize := mem.config.RecheckBatchSize
+	if batchSize <= 0 {
+		batchSize = len(txs)
+	}
+	batches := make(chan []types.Tx, (len(txs)+batchSize-1)/batchSize)
+	for i := 0; i < len(txs); i += batchSize {
+		batches <- txs[i:min(i+batchSize, len(txs))]
+	}
+	close(batches)
+
+	// NOTE: CheckTx for new transactions cannot be executed concurrently
+	// because this function has the lock (via Update and Lock).
+	var (
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		sendErr error
+	)
+	for i := 0; i < max(mem.config.RecheckConcurrency, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				if err := mem.sendRecheckBatch(batch); err != nil {
+					errOnce.Do(func() { sendErr = err })
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if sendErr != nil {
+		panic(sendErr)
+	}
 
 	// Give some time to finish processing the responses; then finish the rechecking process, even
 	// if not all txs were rechecked.
@@ -655,6 +1130,7 @@ func (mem *CListMempool) recheckTxs() {
 		mem.logger.Error("timed out waiting for recheck responses")
 	case <-mem.recheck.doneRechecking():
 	}
+	mem.metrics.RecheckDurationSeconds.Observe(time.Since(start).Seconds())
 
 	if n := mem.recheck.numPendingTxs.Load(); n > 0 {
 		mem.logger.Error("not all txs were rechecked", "not-rechecked", n)
@@ -662,17 +1138,36 @@ func (mem *CListMempool) recheckTxs() {
 	mem.logger.Debug("done rechecking txs", "height", mem.height.Load(), "num-txs", mem.Size())
 }
 
-// The cursor and end pointers define a dynamic list of transactions that could be rechecked. The
-// end pointer is fixed. When a recheck response for a transaction is received, cursor will point to
-// the entry in the mempool corresponding to that transaction, thus narrowing the list. Transactions
-// corresponding to entries between the old and current positions of cursor will be ignored for
-// rechecking. This is to guarantee that recheck responses are processed in the same sequential
-// order as they appear in the mempool.
+// sendRecheckBatch sends a CheckTx request to the app for each transaction in batch, and then
+// flushes the connection so that the app processes them.
+func (mem *CListMempool) sendRecheckBatch(batch []types.Tx) error {
+	for _, tx := range batch {
+		resReq, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.CheckTxRequest{
+			Tx:   tx,
+			Type: abci.CHECK_TX_TYPE_RECHECK,
+		})
+		if err != nil {
+			return fmt.Errorf("(re-)CheckTx request for tx %s failed: %w", log.NewLazySprintf("%v", tx.Hash()), err)
+		}
+		resReq.SetCallback(mem.handleRecheckTxResponse(tx))
+	}
+
+	// Flush any pending asynchronous recheck requests to process.
+	if err := mem.proxyAppConn.Flush(context.TODO()); err != nil {
+		mem.logger.Error("failed to flush recheck requests", "err", err)
+	}
+	return nil
+}
+
+// recheck keeps track of the transactions whose recheck response is still pending. Responses may
+// arrive in any order, because batches of transactions are rechecked concurrently. Responses for
+// transactions that are not pending, because they arrive late or were already processed, are
+// ignored.
 type recheck struct {
-	cursor        *clist.CElement // next expected recheck response
-	end           *clist.CElement // last entry in the mempool to recheck
-	doneCh        chan struct{}   // to signal that rechecking has finished successfully (for async app connections)
-	numPendingTxs atomic.Int32    // number of transactions still pending to recheck
+	mtx           cmtsync.Mutex
+	pending       map[types.TxKey]struct{} // txs still pending to recheck; nil when not rechecking
+	doneCh        chan struct{}            // to signal that rechecking has finished successfully (for async app connections)
+	numPendingTxs atomic.Int32             // number of transactions still pending to recheck
 }
 
 func newRecheck() *recheck {
@@ -681,72 +1176,62 @@ func newRecheck() *recheck {
 	}
 }
 
-func (rc *recheck) init(first, last *clist.CElement) {
-	if !rc.done() {
+func (rc *recheck) init(txs []types.Tx) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+
+	if rc.pending != nil {
 		panic("Having more than one rechecking process at a time is not possible.")
 	}
-	rc.cursor = first
-	rc.end = last
-	rc.numPendingTxs.Store(0)
+	rc.pending = make(map[types.TxKey]struct{}, len(txs))
+	for _, tx := range txs {
+		rc.pending[tx.Key()] = struct{}{}
+	}
+	rc.numPendingTxs.Store(int32(len(rc.pending)))
+
+	// Drain a signal left by a previous rechecking process that timed out.
+	select {
+	case <-rc.doneCh:
+	default:
+	}
 }
 
 // done returns true when there is no recheck response to process.
 func (rc *recheck) done() bool {
-	return rc.cursor == nil
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	return rc.pending == nil
 }
 
 // setDone registers that rechecking has finished.
 func (rc *recheck) setDone() {
-	rc.cursor = nil
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	rc.pending = nil
 }
 
-// setNextEntry sets cursor to the next entry in the list. If there is no next, cursor will be nil.
-func (rc *recheck) setNextEntry() {
-	rc.cursor = rc.cursor.Next()
-}
+// consume registers that the recheck response for the transaction with the given key was
+// received. It returns false if the transaction was not pending to recheck. When no transaction is
+// pending anymore, it notifies that rechecking has finished.
+func (rc *recheck) consume(txKey types.TxKey) bool {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
 
-// tryFinish will check if the cursor is at the end of the list and notify the channel that
-// rechecking has finished. It returns true iff it's done rechecking.
-func (rc *recheck) tryFinish() bool {
-	if rc.cursor == rc.end {
-		// Reached end of the list without finding a matching tx.
-		rc.setDone()
+	if _, ok := rc.pending[txKey]; !ok {
+		return false
 	}
-	if rc.done() {
+	delete(rc.pending, txKey)
+	rc.numPendingTxs.Add(-1)
+
+	if len(rc.pending) == 0 {
+		rc.pending = nil
 		// Notify that recheck has finished.
 		select {
 		case rc.doneCh <- struct{}{}:
 		default:
 		}
-		return true
 	}
-	return false
-}
-
-// findNextEntryMatching searches for the next transaction matching the given transaction, which
-// corresponds to the recheck response to be processed next. Then it checks if it has reached the
-// end of the list, so it can finish rechecking.
-//
-// The goal is to guarantee that transactions are rechecked in the order in which they are in the
-// mempool. Transactions whose recheck response arrive late or don't arrive at all are skipped and
-// not rechecked.
-func (rc *recheck) findNextEntryMatching(tx *types.Tx) bool {
-	found := false
-	for ; !rc.done(); rc.setNextEntry() {
-		expectedTx := rc.cursor.Value.(*mempoolTx).tx
-		if bytes.Equal(*tx, expectedTx) {
-			// Found an entry in the list of txs to recheck that matches tx.
-			found = true
-			rc.numPendingTxs.Add(-1)
-			break
-		}
-	}
-
-	if !rc.tryFinish() {
-		// Not finished yet; set the cursor for processing the next recheck response.
-		rc.setNextEntry()
-	}
-	return found
+	return true
 }
 
 // doneRechecking returns the channel used to signal that rechecking has finished.
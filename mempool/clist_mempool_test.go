@@ -28,6 +28,7 @@ import (
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -265,6 +266,137 @@ func TestMempoolUpdate(t *testing.T) {
 	}
 }
 
+func TestMempoolTTL(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+
+	t.Run("num blocks", func(t *testing.T) {
+		cfg := test.ResetTestRoot("mempool_test")
+		cfg.Mempool.TTLNumBlocks = 2
+		mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+		defer cleanup()
+
+		tx1 := kvstore.NewTxFromID(1)
+		_, err := mp.CheckTx(tx1, "")
+		require.NoError(t, err)
+
+		// At height 1, tx1 is still below the limit.
+		require.NoError(t, mp.Update(1, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		tx2 := kvstore.NewTxFromID(2)
+		_, err = mp.CheckTx(tx2, "")
+		require.NoError(t, err)
+		require.NoError(t, mp.Update(2, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		require.Equal(t, 2, mp.Size())
+
+		// At height 3, tx1 has been in the mempool for more than 2 blocks.
+		require.NoError(t, mp.Update(3, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		require.Equal(t, 1, mp.Size())
+		require.False(t, mp.InMempool(types.Tx(tx1).Key()))
+		require.True(t, mp.InMempool(types.Tx(tx2).Key()))
+
+		// The expired tx is removed from the cache, so it can be resubmitted.
+		_, err = mp.CheckTx(tx1, "")
+		require.NoError(t, err)
+		require.Equal(t, 2, mp.Size())
+
+		require.NoError(t, mp.Update(4, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		require.Equal(t, 1, mp.Size())
+		require.True(t, mp.InMempool(types.Tx(tx1).Key()))
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		cfg := test.ResetTestRoot("mempool_test")
+		cfg.Mempool.TTLDuration = 100 * time.Millisecond
+		mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+		defer cleanup()
+
+		tx1 := kvstore.NewTxFromID(1)
+		_, err := mp.CheckTx(tx1, "")
+		require.NoError(t, err)
+		require.NoError(t, mp.Update(1, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		require.Equal(t, 1, mp.Size())
+
+		time.Sleep(150 * time.Millisecond)
+		tx2 := kvstore.NewTxFromID(2)
+		_, err = mp.CheckTx(tx2, "")
+		require.NoError(t, err)
+
+		require.NoError(t, mp.Update(2, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+		require.Equal(t, 1, mp.Size())
+		require.False(t, mp.InMempool(types.Tx(tx1).Key()))
+		require.False(t, mp.cache.Has(tx1))
+		require.True(t, mp.InMempool(types.Tx(tx2).Key()))
+	})
+}
+
+func TestMempoolTxEvents(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryMempoolTx, 10)
+	require.NoError(t, err)
+
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.TTLNumBlocks = 1
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), cfg)
+	defer cleanup()
+	mp.eventBus = eventBus
+
+	nextEvent := func() types.EventDataMempoolTx {
+		t.Helper()
+		select {
+		case msg := <-sub.Out():
+			return msg.Data().(types.EventDataMempoolTx)
+		case <-time.After(time.Second):
+			t.Fatal("did not receive a mempool tx event after 1 sec.")
+		}
+		return types.EventDataMempoolTx{}
+	}
+
+	tx0, tx1 := types.Tx(kvstore.NewTx("k0", "v")), types.Tx(kvstore.NewTx("k1", "v"))
+	_, err = mp.CheckTx(tx0, "peer")
+	require.NoError(t, err)
+	_, err = mp.CheckTx(tx1, "")
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataMempoolTx{Tx: tx0, Action: types.MempoolTxAdded, GasWanted: 1, Sender: "peer"}, nextEvent())
+	require.Equal(t, types.EventDataMempoolTx{Tx: tx1, Action: types.MempoolTxAdded, GasWanted: 1}, nextEvent())
+
+	require.NoError(t, mp.Update(1, types.Txs{tx0}, abciResponses(1, abci.CodeTypeOK), nil, nil))
+	require.Equal(t, types.EventDataMempoolTx{Tx: tx0, Action: types.MempoolTxCommitted, Height: 1, GasWanted: 1, Sender: "peer"}, nextEvent())
+
+	require.NoError(t, mp.Update(2, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	require.Equal(t, types.EventDataMempoolTx{Tx: tx1, Action: types.MempoolTxEvicted, Height: 2, GasWanted: 1, Reason: EvictionReasonTTL}, nextEvent())
+}
+
+func TestMempoolDumpTxs(t *testing.T) {
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()))
+	defer cleanup()
+	require.Empty(t, mp.DumpTxs())
+
+	require.NoError(t, mp.Update(1, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	tx0, tx1 := types.Tx(kvstore.NewTx("k0", "v")), types.Tx(kvstore.NewTx("k1", "v"))
+	_, err := mp.CheckTx(tx0, "a")
+	require.NoError(t, err)
+	_, err = mp.CheckTx(tx0, "b")
+	require.ErrorIs(t, err, ErrTxInCache)
+	_, err = mp.CheckTx(tx1, "")
+	require.NoError(t, err)
+
+	infos := mp.DumpTxs()
+	require.Len(t, infos, 2)
+	require.Equal(t, tx0, infos[0].Tx)
+	require.EqualValues(t, 1, infos[0].Height)
+	require.EqualValues(t, 1, infos[0].GasWanted)
+	require.False(t, infos[0].Time.IsZero())
+	require.Equal(t, []p2p.ID{"a", "b"}, infos[0].Senders)
+	require.Equal(t, tx1, infos[1].Tx)
+	require.Empty(t, infos[1].Senders)
+}
+
 // Test dropping CheckTx requests when rechecking transactions. It mocks an asynchronous connection
 // to the app.
 func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
@@ -338,7 +470,7 @@ func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 		})
 		require.NoError(t, err)
 		err = mp.Update(1, []types.Tx{a, b},
-			[]*abci.ExecTxResult{{Code: abci.CodeTypeOK}, {Code: 2}}, nil, nil)
+			&abci.FinalizeBlockResponse{TxResults: []*abci.ExecTxResult{{Code: abci.CodeTypeOK}, {Code: 2}}}, nil, nil)
 		require.NoError(t, err)
 
 		// a must be added to the cache
@@ -883,8 +1015,7 @@ func TestMempoolAsyncRecheckTxReturnError(t *testing.T) {
 
 	// Check that recheck has not started.
 	require.True(t, mp.recheck.done())
-	require.Nil(t, mp.recheck.cursor)
-	require.Nil(t, mp.recheck.end)
+	require.Nil(t, mp.recheck.pending)
 	mockClient.AssertExpectations(t)
 
 	// For rechecking, there will be one call to CheckTxAsync per tx.
@@ -910,9 +1041,7 @@ func TestMempoolAsyncRecheckTxReturnError(t *testing.T) {
 	// mp.recheck.done() should be true only before and after calling recheckTxs.
 	mp.recheckTxs()
 	require.True(t, mp.recheck.done())
-	require.Nil(t, mp.recheck.cursor)
-	require.NotNil(t, mp.recheck.end)
-	require.Equal(t, mp.recheck.end, mp.txs.Back())
+	require.Nil(t, mp.recheck.pending)
 	require.Equal(t, len(txs)-1, mp.Size()) // one invalid tx was removed
 	require.Equal(t, int32(2), mp.recheck.numPendingTxs.Load())
 
@@ -942,10 +1071,10 @@ func TestMempoolRecheckRace(t *testing.T) {
 
 	// Recheck has finished
 	require.True(t, mp.recheck.done())
-	require.Nil(t, mp.recheck.cursor)
+	require.Nil(t, mp.recheck.pending)
 
 	// Add again the same transaction that was updated. Recheck has finished so adding this tx
-	// should not result in a data race on the variable recheck.cursor.
+	// should not result in a data race on the variable recheck.pending.
 	_, err = mp.CheckTx(txs[:1][0], "")
 	require.Equal(t, err, ErrTxInCache)
 	require.Zero(t, mp.recheck.numPendingTxs.Load())
@@ -1029,12 +1158,12 @@ func newReqRes(tx types.Tx, code uint32, requestType abci.CheckTxType) *abciclie
 	return reqRes
 }
 
-func abciResponses(n int, code uint32) []*abci.ExecTxResult {
+func abciResponses(n int, code uint32) *abci.FinalizeBlockResponse {
 	responses := make([]*abci.ExecTxResult, 0, n)
 	for i := 0; i < n; i++ {
 		responses = append(responses, &abci.ExecTxResult{Code: code})
 	}
-	return responses
+	return &abci.FinalizeBlockResponse{TxResults: responses}
 }
 
 func doCommit(t require.TestingT, mp Mempool, app abci.Application, txs types.Txs, height int64) {
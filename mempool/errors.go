@@ -82,3 +82,44 @@ func (e ErrFlushAppConn) Error() string {
 func (e ErrFlushAppConn) Unwrap() error {
 	return e.Err
 }
+
+// ErrLaneIsFull defines an error where a mempool lane has reached its limits.
+type ErrLaneIsFull struct {
+	Lane        string
+	NumTxs      int
+	MaxTxs      int
+	TxsBytes    int64
+	MaxTxsBytes int64
+}
+
+func (e ErrLaneIsFull) Error() string {
+	return fmt.Sprintf(
+		"mempool lane %q is full: number of txs %d (max: %d), total txs bytes %d (max: %d)",
+		e.Lane,
+		e.NumTxs,
+		e.MaxTxs,
+		e.TxsBytes,
+		e.MaxTxsBytes,
+	)
+}
+
+// ErrUnknownLane is returned when the application assigns a transaction to a
+// lane that is not configured.
+type ErrUnknownLane struct {
+	Lane string
+}
+
+func (e ErrUnknownLane) Error() string {
+	return fmt.Sprintf("unknown mempool lane %q", e.Lane)
+}
+
+// ErrPeerQuotaExceeded is returned when a peer sends more transactions than
+// allowed by one of its admission quotas.
+type ErrPeerQuotaExceeded struct {
+	Quota string
+	Limit int64
+}
+
+func (e ErrPeerQuotaExceeded) Error() string {
+	return fmt.Sprintf("peer exceeded mempool quota %s (max: %d)", e.Quota, e.Limit)
+}
@@ -0,0 +1,158 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+// journal persists the transactions in the mempool to a database, so that
+// they can be restored after the node restarts.
+//
+// Each transaction is stored under its TxKey. The value is the sequence number
+// of the transaction, as an 8-byte big-endian integer, followed by the raw
+// transaction. The sequence number preserves the order in which transactions
+// were added to the mempool.
+//
+// Writes are not synced to disk: after a crash, the most recent additions and
+// removals may be lost, and entries that cannot be decoded are skipped on
+// replay.
+type journal struct {
+	db  dbm.DB
+	seq atomic.Uint64
+}
+
+func newJournal(db dbm.DB) *journal {
+	return &journal{db: db}
+}
+
+// add records that tx was added to the mempool.
+func (j *journal) add(tx types.Tx) error {
+	key := tx.Key()
+	return j.db.Set(key[:], j.entry(tx))
+}
+
+// entry returns the value recording tx, with the next sequence number.
+func (j *journal) entry(tx types.Tx) []byte {
+	value := make([]byte, 8+len(tx))
+	binary.BigEndian.PutUint64(value, j.seq.Add(1))
+	copy(value[8:], tx)
+	return value
+}
+
+// remove records that the transaction with the given key was removed from the
+// mempool.
+func (j *journal) remove(txKey types.TxKey) error {
+	return j.db.Delete(txKey[:])
+}
+
+// load returns the transactions in the journal, in the order in which they were
+// added, up to a total of maxTxsBytes bytes. It also returns the number of
+// entries that could not be decoded.
+func (j *journal) load(maxTxsBytes int64) (types.Txs, int, error) {
+	iter, err := j.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+
+	type entry struct {
+		seq uint64
+		tx  types.Tx
+	}
+	var (
+		entries []entry
+		corrupt int
+	)
+	for ; iter.Valid(); iter.Next() {
+		value := iter.Value()
+		if len(value) <= 8 {
+			corrupt++
+			continue
+		}
+		tx := types.Tx(value[8:])
+		if key := tx.Key(); string(key[:]) != string(iter.Key()) {
+			corrupt++
+			continue
+		}
+		entries = append(entries, entry{
+			seq: binary.BigEndian.Uint64(value),
+			tx:  append(types.Tx(nil), tx...),
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, corrupt, err
+	}
+
+	sort.Slice(entries, func(i, k int) bool {
+		return entries[i].seq < entries[k].seq
+	})
+
+	var (
+		txs      types.Txs
+		txsBytes int64
+	)
+	for _, e := range entries {
+		txsBytes += int64(len(e.tx))
+		if txsBytes > maxTxsBytes {
+			break
+		}
+		txs = append(txs, e.tx)
+	}
+	return txs, corrupt, nil
+}
+
+// reset removes all entries from the journal.
+func (j *journal) reset() error {
+	return j.rewrite(nil)
+}
+
+// rewrite atomically replaces the entries of the journal with txs, which are
+// recorded in the given order.
+func (j *journal) rewrite(txs types.Txs) error {
+	keep := make(map[types.TxKey]struct{}, len(txs))
+	for _, tx := range txs {
+		keep[tx.Key()] = struct{}{}
+	}
+
+	iter, err := j.db.Iterator(nil, nil)
+	if err != nil {
+		return fmt.Errorf("database error: %v", err)
+	}
+	batch := j.db.NewBatch()
+	defer batch.Close()
+	for ; iter.Valid(); iter.Next() {
+		var key types.TxKey
+		if len(iter.Key()) == len(key) {
+			copy(key[:], iter.Key())
+			if _, ok := keep[key]; ok {
+				continue
+			}
+		}
+		if err := batch.Delete(iter.Key()); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		iter.Close()
+		return err
+	}
+	// Close the iterator before writing, as some backends do not allow
+	// concurrent iteration and writes.
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		key := tx.Key()
+		if err := batch.Set(key[:], j.entry(tx)); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
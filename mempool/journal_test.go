@@ -0,0 +1,99 @@
+package mempool
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newMempoolWithJournal(t *testing.T, db dbm.DB, maxTxsBytes int64) *CListMempool {
+	t.Helper()
+
+	conf := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(conf.RootDir) })
+	conf.Mempool.MaxTxsBytes = maxTxsBytes
+
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	appConnMem, err := cc.NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mp := NewCListMempool(conf.Mempool, appConnMem, 0, WithJournal(db))
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func TestMempoolJournalReplay(t *testing.T) {
+	db := dbm.NewMemDB()
+	mp := newMempoolWithJournal(t, db, 1024)
+	require.NoError(t, mp.ReplayJournal())
+	require.Zero(t, mp.Size())
+
+	txs := types.Txs{kvstore.NewTx("k0", "v"), kvstore.NewTx("k1", "v"), kvstore.NewTx("k2", "v")}
+	callCheckTx(t, mp, txs)
+	require.Equal(t, len(txs), mp.Size())
+
+	// Committed txs are removed from the journal.
+	mp.Lock()
+	err := mp.Update(1, txs[1:2], abciResponses(1, abci.CodeTypeOK), nil, nil)
+	mp.Unlock()
+	require.NoError(t, err)
+
+	// Entries that cannot be decoded are skipped.
+	require.NoError(t, db.Set([]byte("corrupted"), []byte{1, 2}))
+
+	// A new mempool restores the remaining txs, in their original order.
+	mp = newMempoolWithJournal(t, db, 1024)
+	require.NoError(t, mp.ReplayJournal())
+	require.Equal(t, types.Txs{txs[0], txs[2]}, mp.ReapMaxTxs(-1))
+
+	// The journal is rewritten with the replayed txs only.
+	journaled, corrupt, err := newJournal(db).load(1024)
+	require.NoError(t, err)
+	require.Zero(t, corrupt)
+	require.Equal(t, types.Txs{txs[0], txs[2]}, journaled)
+
+	// Txs beyond max_txs_bytes are dropped.
+	mp = newMempoolWithJournal(t, db, int64(len(txs[0])))
+	require.NoError(t, mp.ReplayJournal())
+	require.Equal(t, types.Txs{txs[0]}, mp.ReapMaxTxs(-1))
+
+	// Flushing the mempool clears the journal.
+	mp.Flush()
+	mp = newMempoolWithJournal(t, db, 1024)
+	require.NoError(t, mp.ReplayJournal())
+	require.Zero(t, mp.Size())
+}
+
+func TestMempoolJournalReplayKeepsEntriesUntilDone(t *testing.T) {
+	db := dbm.NewMemDB()
+	mp := newMempoolWithJournal(t, db, 1024)
+	txs := types.Txs{kvstore.NewTx("k0", "v"), kvstore.NewTx("k1", "v")}
+	callCheckTx(t, mp, txs)
+
+	// The entries are still in the journal while the txs are re-admitted.
+	mp = newMempoolWithJournal(t, db, 1024)
+	mp.postCheck = func(types.Tx, *abci.CheckTxResponse) error {
+		journaled, _, err := newJournal(db).load(1024)
+		require.NoError(t, err)
+		require.Equal(t, txs, journaled)
+		return nil
+	}
+	require.NoError(t, mp.ReplayJournal())
+	require.Equal(t, txs, mp.ReapMaxTxs(-1))
+}
@@ -0,0 +1,94 @@
+package mempool
+
+import (
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/clist"
+)
+
+// lane is a partition of the mempool, holding the transactions that the
+// application assigned to it in CheckTx. Each lane has its own capacity and
+// gossip queue, and lanes are reaped in a weighted round-robin order.
+//
+// Transactions in a lane are also in the main list of the mempool, which keeps
+// the order in which all transactions were added.
+type lane struct {
+	id          string
+	weight      int
+	maxSize     int   // 0 means no limit other than the mempool size
+	maxTxsBytes int64 // 0 means no limit other than the mempool max_txs_bytes
+
+	txs      *clist.CList
+	txsBytes atomic.Int64
+}
+
+// newLanes returns the lanes defined in the config, in the same order, and the
+// default lane. It returns no lanes if none are configured.
+func newLanes(cfg *config.MempoolConfig) ([]*lane, *lane) {
+	var (
+		lanes       = make([]*lane, 0, len(cfg.Lanes))
+		defaultLane *lane
+	)
+	for _, laneCfg := range cfg.Lanes {
+		l := &lane{
+			id:          laneCfg.ID,
+			weight:      laneCfg.Weight,
+			maxSize:     laneCfg.Size,
+			maxTxsBytes: laneCfg.MaxTxsBytes,
+			txs:         clist.New(),
+		}
+		lanes = append(lanes, l)
+		if l.id == cfg.DefaultLane {
+			defaultLane = l
+		}
+	}
+	return lanes, defaultLane
+}
+
+// isFull returns ErrLaneIsFull if a tx of the given size does not fit in the
+// lane.
+func (l *lane) isFull(txSize int) error {
+	var (
+		laneSize = l.txs.Len()
+		txsBytes = l.txsBytes.Load()
+	)
+
+	if (l.maxSize > 0 && laneSize >= l.maxSize) ||
+		(l.maxTxsBytes > 0 && int64(txSize)+txsBytes > l.maxTxsBytes) {
+		return ErrLaneIsFull{
+			Lane:        l.id,
+			NumTxs:      laneSize,
+			MaxTxs:      l.maxSize,
+			TxsBytes:    txsBytes,
+			MaxTxsBytes: l.maxTxsBytes,
+		}
+	}
+
+	return nil
+}
+
+// interleaveLanes merges the transactions of each lane using a weighted
+// round-robin: in each round, it takes up to weights[i] transactions from
+// laneTxs[i], for each lane in order, until all lanes are exhausted.
+func interleaveLanes(laneTxs [][]*mempoolTx, weights []int) []*mempoolTx {
+	if len(laneTxs) == 1 {
+		return laneTxs[0]
+	}
+
+	total := 0
+	for _, txs := range laneTxs {
+		total += len(txs)
+	}
+
+	memTxs := make([]*mempoolTx, 0, total)
+	next := make([]int, len(laneTxs))
+	for len(memTxs) < total {
+		for i, txs := range laneTxs {
+			n := min(weights[i], len(txs)-next[i])
+			memTxs = append(memTxs, txs[next[i]:next[i]+n]...)
+			next[i] += n
+		}
+	}
+	return memTxs
+}
@@ -0,0 +1,157 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// laneApp is a kvstore application that assigns a "lane/key=value" transaction
+// to lane.
+type laneApp struct {
+	*kvstore.Application
+}
+
+func (app *laneApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	res, err := app.Application.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	if i := bytes.IndexByte(req.Tx, '/'); i > 0 {
+		res.LaneId = string(req.Tx[:i])
+	}
+	return res, nil
+}
+
+func newMempoolWithLanes(t *testing.T, lanes []config.MempoolLaneConfig, defaultLane string) *CListMempool {
+	t.Helper()
+
+	conf := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(conf.RootDir) })
+	conf.Mempool.Lanes = lanes
+	conf.Mempool.DefaultLane = defaultLane
+	require.NoError(t, conf.Mempool.ValidateBasic())
+
+	cc := proxy.NewLocalClientCreator(&laneApp{kvstore.NewInMemoryApplication()})
+	appConnMem, err := cc.NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mp := NewCListMempool(conf.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func laneTx(lane string, id int) types.Tx {
+	if lane == "" {
+		return kvstore.NewTx(fmt.Sprintf("k%d", id), "v")
+	}
+	return kvstore.NewTx(fmt.Sprintf("%s/k%d", lane, id), "v")
+}
+
+func TestInterleaveLanes(t *testing.T) {
+	txs := func(n int) []*mempoolTx {
+		memTxs := make([]*mempoolTx, n)
+		for i := range memTxs {
+			memTxs[i] = &mempoolTx{}
+		}
+		return memTxs
+	}
+	a, b, c := txs(5), txs(1), txs(0)
+
+	memTxs := interleaveLanes([][]*mempoolTx{a, b, c}, []int{2, 3, 1})
+	require.Equal(t, []*mempoolTx{a[0], a[1], b[0], a[2], a[3], a[4]}, memTxs)
+
+	require.Empty(t, interleaveLanes([][]*mempoolTx{c, c}, []int{1, 1}))
+}
+
+func TestMempoolLanesReap(t *testing.T) {
+	mp := newMempoolWithLanes(t, []config.MempoolLaneConfig{
+		{ID: "high", Weight: 2},
+		{ID: "low", Weight: 1},
+	}, "low")
+
+	// Txs without a lane go to the default lane.
+	txs := types.Txs{laneTx("", 0), laneTx("", 1), laneTx("high", 2), laneTx("high", 3), laneTx("high", 4), laneTx("low", 5)}
+	for _, tx := range txs {
+		_, err := mp.CheckTx(tx, "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(txs), mp.Size())
+	require.Equal(t, 3, mp.lanes[0].txs.Len())
+	require.Equal(t, 3, mp.lanes[1].txs.Len())
+
+	expected := types.Txs{txs[2], txs[3], txs[0], txs[4], txs[1], txs[5]}
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected[:4], mp.ReapMaxTxs(4))
+
+	// Removing a tx removes it from its lane.
+	require.NoError(t, mp.RemoveTxByKey(txs[2].Key()))
+	require.Equal(t, 2, mp.lanes[0].txs.Len())
+	require.Equal(t, int64(len(txs[3])+len(txs[4])), mp.lanes[0].txsBytes.Load())
+
+	mp.Flush()
+	require.Zero(t, mp.lanes[0].txs.Len())
+	require.Zero(t, mp.lanes[1].txsBytes.Load())
+}
+
+func TestMempoolLanesCapacity(t *testing.T) {
+	mp := newMempoolWithLanes(t, []config.MempoolLaneConfig{
+		{ID: "a", Weight: 1, Size: 2},
+		{ID: "b", Weight: 1},
+	}, "b")
+
+	for i := 0; i < 3; i++ {
+		_, err := mp.CheckTx(laneTx("a", i), "")
+		require.NoError(t, err)
+	}
+	// The third tx of lane "a" does not fit in its lane.
+	require.Equal(t, 2, mp.Size())
+	require.False(t, mp.InMempool(laneTx("a", 2).Key()))
+	require.False(t, mp.cache.Has(laneTx("a", 2)))
+
+	// Other lanes are not affected.
+	_, err := mp.CheckTx(laneTx("b", 3), "")
+	require.NoError(t, err)
+	require.Equal(t, 3, mp.Size())
+
+	// Txs for unknown lanes are rejected.
+	_, err = mp.CheckTx(laneTx("c", 4), "")
+	require.NoError(t, err)
+	require.False(t, mp.InMempool(laneTx("c", 4).Key()))
+	require.Equal(t, 3, mp.Size())
+}
+
+func TestMempoolLanesGossipQueues(t *testing.T) {
+	mp := newMempoolWithLanes(t, nil, "")
+	require.Len(t, mp.gossipQueues(), 1)
+
+	mp = newMempoolWithLanes(t, []config.MempoolLaneConfig{
+		{ID: "a", Weight: 1},
+		{ID: "b", Weight: 1},
+	}, "a")
+	queues := mp.gossipQueues()
+	require.Len(t, queues, 2)
+
+	_, err := mp.CheckTx(laneTx("b", 0), "")
+	require.NoError(t, err)
+	require.Zero(t, queues[0].Len())
+	require.Equal(t, 1, queues[1].Len())
+}
@@ -3,6 +3,7 @@ package mempool
 import (
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -13,6 +14,10 @@ import (
 const (
 	MempoolChannel = byte(0x30)
 
+	// MempoolControlChannel carries tx announcements and requests in the
+	// "have_want" gossip mode. It is only advertised by nodes in this mode.
+	MempoolControlChannel = byte(0x31)
+
 	// PeerCatchupSleepIntervalMS defines how much time to sleep if a peer is behind.
 	PeerCatchupSleepIntervalMS = 100
 )
@@ -49,6 +54,14 @@ type Mempool interface {
 	// otherwise returns nil.
 	GetTxByHash(hash []byte) types.Tx
 
+	// GetTxStatus returns the status of the transaction with the given key, as
+	// known by the mempool.
+	GetTxStatus(txKey types.TxKey) TxStatus
+
+	// DumpTxs returns all the transactions in the mempool, in the order in
+	// which they were added, along with their metadata.
+	DumpTxs() []TxInfo
+
 	// Lock locks the mempool. The consensus must be able to hold lock to safely
 	// update.
 	Lock()
@@ -57,7 +70,8 @@ type Mempool interface {
 	Unlock()
 
 	// Update informs the mempool that the given txs were committed and can be
-	// discarded.
+	// discarded. abciResponse is the response of the application to the
+	// FinalizeBlock request of the block, which holds the results of the txs.
 	//
 	// NOTE:
 	// 1. This should be called *after* block is committed by consensus.
@@ -65,7 +79,7 @@ type Mempool interface {
 	Update(
 		blockHeight int64,
 		blockTxs types.Txs,
-		deliverTxResponses []*abci.ExecTxResult,
+		abciResponse *abci.FinalizeBlockResponse,
 		newPreFn PreCheckFunc,
 		newPostFn PostCheckFunc,
 	) error
@@ -98,6 +112,19 @@ type Mempool interface {
 	SizeBytes() int64
 }
 
+// TxInfo is a transaction in the mempool, along with its metadata.
+type TxInfo struct {
+	Tx types.Tx
+	// Height and time at which the transaction was added to the mempool.
+	Height int64
+	Time   time.Time
+	// Gas wanted, as returned by CheckTx.
+	GasWanted int64
+	// Peers that sent the transaction, starting with the first one. It is
+	// empty if the transaction was received via RPC.
+	Senders []p2p.ID
+}
+
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
 // transaction if false is returned. An example would be to ensure that a
 // transaction doesn't exceeded the block size.
@@ -3,16 +3,28 @@ package mempool
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/cometbft/cometbft/internal/clist"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/types"
 )
 
 // mempoolTx is an entry in the mempool.
 type mempoolTx struct {
-	height    int64    // height that this tx had been validated in
-	gasWanted int64    // amount of gas this tx states it will require
-	tx        types.Tx // validated by the application
+	height    int64     // height that this tx had been validated in
+	timestamp time.Time // time when this tx was added to the mempool
+	gasWanted int64     // amount of gas this tx states it will require
+	priority  int64     // priority assigned by the application in CheckTx
+	tx        types.Tx  // validated by the application
+
+	// lane this tx belongs to and its element in the lane, if the mempool has
+	// lanes.
+	lane     *lane
+	laneElem *clist.CElement
+
+	// id of the peer that first sent us this tx, if any.
+	sender p2p.ID
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> struct{}
@@ -46,18 +46,80 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "rejected_txs",
 			Help:      "Number of rejected transactions.",
 		}, labels).With(labelsAndValues...),
+		EvictedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "evicted_txs",
+			Help:      "Number of evicted transactions.",
+		}, labels).With(labelsAndValues...),
+		LaneSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_size",
+			Help:      "Number of uncommitted transactions in each mempool lane.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		LaneSizeBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "lane_size_bytes",
+			Help:      "Total size in bytes of the transactions in each mempool lane.",
+		}, append(labels, "lane")).With(labelsAndValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_txs",
+			Help:      "Number of expired transactions.",
+		}, labels).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "recheck_times",
 			Help:      "Number of times transactions are rechecked in the mempool.",
 		}, labels).With(labelsAndValues...),
+		RecheckDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "recheck_duration_seconds",
+			Help:      "Time spent rechecking the transactions in the mempool after a block is committed.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 10, 8),
+		}, labels).With(labelsAndValues...),
+		RecheckSkippedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "recheck_skipped_txs",
+			Help:      "Number of transactions skipped when rechecking.",
+		}, labels).With(labelsAndValues...),
 		AlreadyReceivedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "already_received_txs",
 			Help:      "Number of duplicate transaction reception.",
 		}, labels).With(labelsAndValues...),
+		TxAnnouncements: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "tx_announcements",
+			Help:      "Number of transaction announcements received.",
+		}, labels).With(labelsAndValues...),
+		DuplicateTxAnnouncements: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "duplicate_tx_announcements",
+			Help:      "Number of duplicate transaction announcements received.",
+		}, labels).With(labelsAndValues...),
+		RequestedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "requested_txs",
+			Help:      "Number of transactions requested from peers in the have/want gossip mode.",
+		}, labels).With(labelsAndValues...),
+		PeerQuotaExceededTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_quota_exceeded_txs",
+			Help:      "Number of transactions dropped due to peer quotas.",
+		}, labels).With(labelsAndValues...),
 		ActiveOutboundConnections: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -74,8 +136,18 @@ func NopMetrics() *Metrics {
 		TxSizeBytes:               discard.NewHistogram(),
 		FailedTxs:                 discard.NewCounter(),
 		RejectedTxs:               discard.NewCounter(),
+		EvictedTxs:                discard.NewCounter(),
+		LaneSize:                  discard.NewGauge(),
+		LaneSizeBytes:             discard.NewGauge(),
+		ExpiredTxs:                discard.NewCounter(),
 		RecheckTimes:              discard.NewCounter(),
+		RecheckDurationSeconds:    discard.NewHistogram(),
+		RecheckSkippedTxs:         discard.NewCounter(),
 		AlreadyReceivedTxs:        discard.NewCounter(),
+		TxAnnouncements:           discard.NewCounter(),
+		DuplicateTxAnnouncements:  discard.NewCounter(),
+		RequestedTxs:              discard.NewCounter(),
+		PeerQuotaExceededTxs:      discard.NewCounter(),
 		ActiveOutboundConnections: discard.NewGauge(),
 	}
 }
@@ -34,13 +34,55 @@ type Metrics struct {
 	// metrics:Number of rejected transactions.
 	RejectedTxs metrics.Counter
 
+	// EvictedTxs defines the number of valid transactions that were removed
+	// from the mempool to make room for transactions with higher priority.
+	// metrics:Number of evicted transactions.
+	EvictedTxs metrics.Counter
+
+	// Number of uncommitted transactions in each mempool lane.
+	LaneSize metrics.Gauge `metrics_labels:"lane"`
+
+	// Total size in bytes of the transactions in each mempool lane.
+	LaneSizeBytes metrics.Gauge `metrics_labels:"lane"`
+
+	// ExpiredTxs defines the number of transactions that were removed from the
+	// mempool because they exceeded the ttl_num_blocks or ttl_duration limits.
+	// metrics:Number of expired transactions.
+	ExpiredTxs metrics.Counter
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
+	// Time spent rechecking the transactions in the mempool after a block is
+	// committed.
+	RecheckDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.001, 10, 8" metrics_buckettype:"exprange"`
+
+	// Number of transactions not rechecked after a block was committed
+	// because the recheck policy did not select them.
+	// metrics:Number of transactions skipped when rechecking.
+	RecheckSkippedTxs metrics.Counter
+
 	// Number of times transactions were received more than once.
 	// metrics:Number of duplicate transaction reception.
 	AlreadyReceivedTxs metrics.Counter
 
+	// Number of tx keys announced by peers in the have/want gossip mode.
+	// metrics:Number of transaction announcements received.
+	TxAnnouncements metrics.Counter
+
+	// Number of tx keys announced by peers in the have/want gossip mode for
+	// transactions that were already seen or requested.
+	// metrics:Number of duplicate transaction announcements received.
+	DuplicateTxAnnouncements metrics.Counter
+
+	// Number of transactions requested from peers in the have/want gossip mode.
+	RequestedTxs metrics.Counter
+
+	// Number of transactions received from peers that were dropped because the
+	// peer exceeded its admission quotas.
+	// metrics:Number of transactions dropped due to peer quotas.
+	PeerQuotaExceededTxs metrics.Counter
+
 	// Number of connections being actively used for gossiping transactions
 	// (experimental feature).
 	ActiveOutboundConnections metrics.Gauge
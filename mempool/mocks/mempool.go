@@ -50,6 +50,26 @@ func (_m *Mempool) CheckTx(tx types.Tx, sender p2p.ID) (*abcicli.ReqRes, error)
 	return r0, r1
 }
 
+// DumpTxs provides a mock function with given fields:
+func (_m *Mempool) DumpTxs() []mempool.TxInfo {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DumpTxs")
+	}
+
+	var r0 []mempool.TxInfo
+	if rf, ok := ret.Get(0).(func() []mempool.TxInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mempool.TxInfo)
+		}
+	}
+
+	return r0
+}
+
 // EnableTxsAvailable provides a mock function with given fields:
 func (_m *Mempool) EnableTxsAvailable() {
 	_m.Called()
@@ -98,6 +118,24 @@ func (_m *Mempool) GetTxByHash(hash []byte) types.Tx {
 	return r0
 }
 
+// GetTxStatus provides a mock function with given fields: txKey
+func (_m *Mempool) GetTxStatus(txKey types.TxKey) mempool.TxStatus {
+	ret := _m.Called(txKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTxStatus")
+	}
+
+	var r0 mempool.TxStatus
+	if rf, ok := ret.Get(0).(func(types.TxKey) mempool.TxStatus); ok {
+		r0 = rf(txKey)
+	} else {
+		r0 = ret.Get(0).(mempool.TxStatus)
+	}
+
+	return r0
+}
+
 // Lock provides a mock function with given fields:
 func (_m *Mempool) Lock() {
 	_m.Called()
@@ -222,17 +260,17 @@ func (_m *Mempool) Unlock() {
 	_m.Called()
 }
 
-// Update provides a mock function with given fields: blockHeight, blockTxs, deliverTxResponses, newPreFn, newPostFn
-func (_m *Mempool) Update(blockHeight int64, blockTxs types.Txs, deliverTxResponses []*v1.ExecTxResult, newPreFn mempool.PreCheckFunc, newPostFn mempool.PostCheckFunc) error {
-	ret := _m.Called(blockHeight, blockTxs, deliverTxResponses, newPreFn, newPostFn)
+// Update provides a mock function with given fields: blockHeight, blockTxs, abciResponse, newPreFn, newPostFn
+func (_m *Mempool) Update(blockHeight int64, blockTxs types.Txs, abciResponse *v1.FinalizeBlockResponse, newPreFn mempool.PreCheckFunc, newPostFn mempool.PostCheckFunc) error {
+	ret := _m.Called(blockHeight, blockTxs, abciResponse, newPreFn, newPostFn)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, types.Txs, []*v1.ExecTxResult, mempool.PreCheckFunc, mempool.PostCheckFunc) error); ok {
-		r0 = rf(blockHeight, blockTxs, deliverTxResponses, newPreFn, newPostFn)
+	if rf, ok := ret.Get(0).(func(int64, types.Txs, *v1.FinalizeBlockResponse, mempool.PreCheckFunc, mempool.PostCheckFunc) error); ok {
+		r0 = rf(blockHeight, blockTxs, abciResponse, newPreFn, newPostFn)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -38,6 +38,12 @@ func (*NopMempool) ReapMaxTxs(int) types.Txs { return nil }
 // GetTxByHash always returns nil.
 func (*NopMempool) GetTxByHash([]byte) types.Tx { return nil }
 
+// GetTxStatus always returns an unknown status.
+func (*NopMempool) GetTxStatus(types.TxKey) TxStatus { return TxStatus{Status: TxStatusUnknown} }
+
+// DumpTxs always returns nil.
+func (*NopMempool) DumpTxs() []TxInfo { return nil }
+
 // Lock does nothing.
 func (*NopMempool) Lock() {}
 
@@ -48,7 +54,7 @@ func (*NopMempool) Unlock() {}
 func (*NopMempool) Update(
 	int64,
 	types.Txs,
-	[]*abci.ExecTxResult,
+	*abci.FinalizeBlockResponse,
 	PreCheckFunc,
 	PostCheckFunc,
 ) error {
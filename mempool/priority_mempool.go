@@ -0,0 +1,157 @@
+package mempool
+
+import (
+	"sort"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// PriorityMempool is a CListMempool that orders transactions by the priority
+// the application assigns to them in CheckTx responses.
+//
+// Reaping returns transactions with higher priority first; transactions with
+// the same priority are returned in the order in which they were added to the
+// mempool. When the mempool is full, a new transaction evicts transactions
+// with strictly lower priority, starting from the lowest, if that frees
+// enough room for it. Otherwise the new transaction is rejected.
+//
+// Transactions are still gossiped to peers in the order in which they were
+// added to the mempool.
+type PriorityMempool struct {
+	*CListMempool
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	cfg *config.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...CListMempoolOption,
+) *PriorityMempool {
+	mp := &PriorityMempool{
+		CListMempool: NewCListMempool(cfg, proxyAppConn, height, options...),
+	}
+	mp.CListMempool.evict = mp.evictLowerPriority
+	return mp
+}
+
+// evictLowerPriority removes from the mempool the transactions with the lowest
+// priority, as long as it is lower than the priority of memTx, until there is
+// enough room for memTx. If the lane of memTx is full, only transactions from
+// that lane are considered. If there are not enough of these transactions, it
+// does not remove any transaction and returns the error of isFullFor.
+//
+// Among transactions with the same priority, the most recent ones are evicted
+// first.
+//
+// Called from handleCheckTxResponse with addMtx held.
+func (mem *PriorityMempool) evictLowerPriority(memTx *mempoolTx) error {
+	l := memTx.lane
+	laneFull := l != nil && l.isFull(len(memTx.tx)) != nil
+
+	var candidates []*mempoolTx
+	for e := mem.txs.Back(); e != nil; e = e.Prev() {
+		tx := e.Value.(*mempoolTx)
+		if tx.priority < memTx.priority && (!laneFull || tx.lane == l) {
+			candidates = append(candidates, tx)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].priority < candidates[j].priority
+	})
+
+	var (
+		numTxs       = mem.Size()
+		txsBytes     = mem.SizeBytes()
+		laneNumTxs   int
+		laneTxsBytes int64
+		txSize       = int64(len(memTx.tx))
+		victims      []*mempoolTx
+	)
+	if l != nil {
+		laneNumTxs, laneTxsBytes = l.txs.Len(), l.txsBytes.Load()
+	}
+	fits := func() bool {
+		if numTxs >= mem.config.Size || txsBytes+txSize > mem.config.MaxTxsBytes {
+			return false
+		}
+		return l == nil ||
+			((l.maxSize == 0 || laneNumTxs < l.maxSize) &&
+				(l.maxTxsBytes == 0 || laneTxsBytes+txSize <= l.maxTxsBytes))
+	}
+	for _, tx := range candidates {
+		if fits() {
+			break
+		}
+		victims = append(victims, tx)
+		numTxs--
+		txsBytes -= int64(len(tx.tx))
+		if tx.lane == l {
+			laneNumTxs--
+			laneTxsBytes -= int64(len(tx.tx))
+		}
+	}
+	if !fits() {
+		return mem.isFullFor(memTx)
+	}
+
+	for _, tx := range victims {
+		if err := mem.RemoveTxByKey(tx.tx.Key()); err != nil {
+			// The tx was removed concurrently, e.g., by a recheck.
+			continue
+		}
+		// The evicted tx may be valid, so allow it to be resubmitted.
+		mem.forceRemoveFromCache(tx.tx)
+		mem.txEvicted(tx, EvictionReasonFull)
+		mem.metrics.EvictedTxs.Add(1)
+		mem.logger.Debug(
+			"evicted transaction",
+			"tx", tx.tx.Hash(),
+			"priority", tx.priority,
+			"new-tx", memTx.tx.Hash(),
+			"new-priority", memTx.priority,
+		)
+	}
+	return nil
+}
+
+// sortedTxs returns all transactions in the mempool, sorted by decreasing
+// priority. Transactions with the same priority keep their insertion order.
+// If the mempool has lanes, the transactions of each lane are sorted
+// separately and then interleaved using a weighted round-robin.
+func (mem *PriorityMempool) sortedTxs() []*mempoolTx {
+	laneTxs, weights := mem.laneTxs()
+	for _, memTxs := range laneTxs {
+		sort.SliceStable(memTxs, func(i, j int) bool {
+			return memTxs[i].priority > memTxs[j].priority
+		})
+	}
+	return interleaveLanes(laneTxs, weights)
+}
+
+// ReapMaxBytesMaxGas reaps transactions in order of decreasing priority, with
+// the same limits as CListMempool.ReapMaxBytesMaxGas.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return reapMaxBytesMaxGas(mem.sortedTxs(), maxBytes, maxGas)
+}
+
+// ReapMaxTxs reaps up to max transactions in order of decreasing priority. If
+// max is negative, all transactions are returned.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	return reapMaxTxs(mem.sortedTxs(), max)
+}
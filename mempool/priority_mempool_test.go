@@ -0,0 +1,213 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// priorityApp is a kvstore application that sets the priority of a "key=value"
+// transaction to value. Like laneApp, it assigns a "lane/key=value" transaction
+// to lane.
+type priorityApp struct {
+	laneApp
+}
+
+func (app *priorityApp) CheckTx(ctx context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	res, err := app.laneApp.CheckTx(ctx, req)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+	parts := bytes.Split(req.Tx, []byte("="))
+	res.Priority, err = strconv.ParseInt(string(parts[len(parts)-1]), 10, 64)
+	if err != nil {
+		return &abci.CheckTxResponse{Code: kvstore.CodeTypeInvalidTxFormat}, nil
+	}
+	return res, nil
+}
+
+func newPriorityMempool(t *testing.T, size int, lanes ...config.MempoolLaneConfig) *PriorityMempool {
+	t.Helper()
+
+	conf := test.ResetTestRoot("mempool_test")
+	t.Cleanup(func() { os.RemoveAll(conf.RootDir) })
+	conf.Mempool.Size = size
+	if len(lanes) > 0 {
+		conf.Mempool.Lanes = lanes
+		conf.Mempool.DefaultLane = lanes[0].ID
+	}
+
+	cc := proxy.NewLocalClientCreator(&priorityApp{laneApp{kvstore.NewInMemoryApplication()}})
+	appConnMem, err := cc.NewABCIMempoolClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		if err := appConnMem.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mp := NewPriorityMempool(conf.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+func priorityTx(id int, priority int64) types.Tx {
+	return kvstore.NewTx(fmt.Sprintf("tx%d", id), strconv.FormatInt(priority, 10))
+}
+
+func TestPriorityMempoolReapOrder(t *testing.T) {
+	mp := newPriorityMempool(t, 10)
+
+	priorities := []int64{5, 1, 9, 5, 3}
+	for i, p := range priorities {
+		_, err := mp.CheckTx(priorityTx(i, p), "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(priorities), mp.Size())
+
+	// Ties are broken by insertion order.
+	expected := types.Txs{priorityTx(2, 9), priorityTx(0, 5), priorityTx(3, 5), priorityTx(4, 3), priorityTx(1, 1)}
+	require.Equal(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected, mp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], mp.ReapMaxTxs(2))
+
+	// Each kvstore tx wants 1 gas.
+	require.Equal(t, expected[:3], mp.ReapMaxBytesMaxGas(-1, 3))
+
+	txSize := types.ComputeProtoSizeForTxs(types.Txs{expected[0]})
+	require.Equal(t, expected[:1], mp.ReapMaxBytesMaxGas(txSize, -1))
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	mp := newPriorityMempool(t, 3)
+
+	for i, p := range []int64{2, 4, 2} {
+		_, err := mp.CheckTx(priorityTx(i, p), "")
+		require.NoError(t, err)
+	}
+
+	// A tx with a priority that is not higher than any other tx is rejected.
+	_, err := mp.CheckTx(priorityTx(3, 2), "")
+	require.NoError(t, err)
+	require.Equal(t, 3, mp.Size())
+	require.False(t, mp.InMempool(priorityTx(3, 2).Key()))
+
+	// A tx with higher priority evicts the most recent tx with the lowest priority.
+	_, err = mp.CheckTx(priorityTx(4, 3), "")
+	require.NoError(t, err)
+	require.Equal(t, 3, mp.Size())
+	require.True(t, mp.InMempool(priorityTx(4, 3).Key()))
+	require.False(t, mp.InMempool(priorityTx(2, 2).Key()))
+	require.Equal(t, types.Txs{priorityTx(1, 4), priorityTx(4, 3), priorityTx(0, 2)}, mp.ReapMaxTxs(-1))
+
+	// The evicted tx is removed from the cache, so it can be resubmitted.
+	require.False(t, mp.cache.Has(priorityTx(2, 2)))
+}
+
+func TestPriorityMempoolEvictionMaxTxsBytes(t *testing.T) {
+	mp := newPriorityMempool(t, 10)
+
+	txs := types.Txs{priorityTx(0, 1), priorityTx(1, 1)}
+	mp.config.MaxTxsBytes = int64(len(txs[0]) + len(txs[1]))
+	for _, tx := range txs {
+		_, err := mp.CheckTx(tx, "")
+		require.NoError(t, err)
+	}
+
+	// Evicting a single tx is enough to make room for a tx of the same size.
+	_, err := mp.CheckTx(priorityTx(2, 5), "")
+	require.NoError(t, err)
+	require.Equal(t, 2, mp.Size())
+	require.Equal(t, mp.config.MaxTxsBytes, mp.SizeBytes())
+
+	// No tx is evicted if there is not enough room even after evicting all
+	// txs with lower priority.
+	huge := types.Tx(kvstore.NewTx("huge-tx-that-does-not-fit", "9"))
+	_, err = mp.CheckTx(huge, "")
+	require.NoError(t, err)
+	require.False(t, mp.InMempool(huge.Key()))
+	require.Equal(t, 2, mp.Size())
+}
+
+func TestPriorityMempoolEvictionWithLanes(t *testing.T) {
+	mp := newPriorityMempool(t, 4,
+		config.MempoolLaneConfig{ID: "a", Weight: 1, Size: 2},
+		config.MempoolLaneConfig{ID: "b", Weight: 1},
+	)
+
+	txs := types.Txs{
+		kvstore.NewTx("a/0", "5"),
+		kvstore.NewTx("a/1", "3"),
+		kvstore.NewTx("b/2", "1"),
+	}
+	for _, tx := range txs {
+		_, err := mp.CheckTx(tx, "")
+		require.NoError(t, err)
+	}
+
+	// Lane "a" is full, so a tx in that lane evicts the lowest priority tx of
+	// the lane, even if there are txs with lower priority in other lanes.
+	tx := types.Tx(kvstore.NewTx("a/3", "4"))
+	_, err := mp.CheckTx(tx, "")
+	require.NoError(t, err)
+	require.True(t, mp.InMempool(tx.Key()))
+	require.False(t, mp.InMempool(txs[1].Key()))
+	require.True(t, mp.InMempool(txs[2].Key()))
+
+	// Reaping sorts each lane by priority and interleaves lanes.
+	require.Equal(t, types.Txs{txs[0], txs[2], tx}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolRecheckUpdatesPriority(t *testing.T) {
+	mp := newPriorityMempool(t, 10)
+
+	for i, p := range []int64{5, 3} {
+		_, err := mp.CheckTx(priorityTx(i, p), "")
+		require.NoError(t, err)
+	}
+
+	// After a block, the application assigns a higher priority to tx1.
+	mp.recheck.init(types.Txs{priorityTx(0, 5), priorityTx(1, 3)})
+	mp.handleRecheckTxResponse(priorityTx(0, 5))(abci.ToCheckTxResponse(&abci.CheckTxResponse{Priority: 5}))
+	mp.handleRecheckTxResponse(priorityTx(1, 3))(abci.ToCheckTxResponse(&abci.CheckTxResponse{Priority: 7}))
+	require.True(t, mp.recheck.done())
+
+	require.Equal(t, types.Txs{priorityTx(1, 3), priorityTx(0, 5)}, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolConcurrentCheckTxResponses(t *testing.T) {
+	mp := newPriorityMempool(t, 5)
+
+	// Handle the responses concurrently, as the ABCI client may do.
+	const numTxs = 50
+	var wg sync.WaitGroup
+	for i := 0; i < numTxs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tx := priorityTx(i, int64(i))
+			mp.handleCheckTxResponse(tx, "")(abci.ToCheckTxResponse(&abci.CheckTxResponse{Priority: int64(i)}))
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, 5, mp.Size())
+	for i := numTxs - 5; i < numTxs; i++ {
+		require.True(t, mp.InMempool(priorityTx(i, int64(i)).Key()))
+	}
+}
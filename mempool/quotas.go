@@ -0,0 +1,100 @@
+package mempool
+
+import (
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// peerQuotas enforces the admission quotas of the txs received from each peer:
+// the rate limits peer_max_txs_per_second and peer_max_bytes_per_second, and
+// the maximum number of txs from a single peer in the mempool, peer_max_txs.
+//
+// The rate limits are implemented as token buckets that can hold up to one
+// second worth of txs or bytes.
+type peerQuotas struct {
+	config *cfg.MempoolConfig
+
+	mtx   cmtsync.Mutex
+	peers map[p2p.ID]*peerQuota
+}
+
+type peerQuota struct {
+	txTokens   float64
+	byteTokens float64
+	lastRefill time.Time
+
+	// Bounds of the current streak of violations, that is, of violations less
+	// than a second apart.
+	firstViolation time.Time
+	lastViolation  time.Time
+}
+
+func newPeerQuotas(config *cfg.MempoolConfig) *peerQuotas {
+	return &peerQuotas{
+		config: config,
+		peers:  make(map[p2p.ID]*peerQuota),
+	}
+}
+
+// enabled returns true if any quota is configured.
+func (q *peerQuotas) enabled() bool {
+	return q.config.PeerMaxTxsPerSecond > 0 || q.config.PeerMaxBytesPerSecond > 0 || q.config.PeerMaxTxs > 0
+}
+
+// allow checks whether a tx of size txSize from peerID can be admitted, given
+// that numTxs txs from this peer are already in the mempool, and consumes the
+// corresponding tokens. If not, it returns an ErrPeerQuotaExceeded, along with
+// true if the peer has been exceeding its quotas for longer than
+// peer_quota_grace_period.
+func (q *peerQuotas) allow(peerID p2p.ID, txSize int, numTxs int, now time.Time) (persistent bool, err error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	pq, ok := q.peers[peerID]
+	if !ok {
+		pq = &peerQuota{
+			txTokens:   float64(q.config.PeerMaxTxsPerSecond),
+			byteTokens: float64(q.config.PeerMaxBytesPerSecond),
+			lastRefill: now,
+		}
+		q.peers[peerID] = pq
+	}
+
+	// Refill the buckets.
+	elapsed := now.Sub(pq.lastRefill).Seconds()
+	pq.lastRefill = now
+	pq.txTokens = min(pq.txTokens+elapsed*float64(q.config.PeerMaxTxsPerSecond), float64(q.config.PeerMaxTxsPerSecond))
+	pq.byteTokens = min(pq.byteTokens+elapsed*float64(q.config.PeerMaxBytesPerSecond), float64(q.config.PeerMaxBytesPerSecond))
+
+	switch {
+	case q.config.PeerMaxTxs > 0 && numTxs >= q.config.PeerMaxTxs:
+		err = ErrPeerQuotaExceeded{Quota: "peer_max_txs", Limit: int64(q.config.PeerMaxTxs)}
+	case q.config.PeerMaxTxsPerSecond > 0 && pq.txTokens < 1:
+		err = ErrPeerQuotaExceeded{Quota: "peer_max_txs_per_second", Limit: int64(q.config.PeerMaxTxsPerSecond)}
+	case q.config.PeerMaxBytesPerSecond > 0 && pq.byteTokens < float64(txSize):
+		err = ErrPeerQuotaExceeded{Quota: "peer_max_bytes_per_second", Limit: q.config.PeerMaxBytesPerSecond}
+	}
+	if err == nil {
+		pq.txTokens--
+		pq.byteTokens -= float64(txSize)
+		return false, nil
+	}
+
+	if pq.lastViolation.IsZero() || now.Sub(pq.lastViolation) > time.Second {
+		pq.firstViolation = now
+	}
+	pq.lastViolation = now
+	persistent = q.config.PeerQuotaGracePeriod > 0 && now.Sub(pq.firstViolation) >= q.config.PeerQuotaGracePeriod
+	return persistent, err
+}
+
+// removePeer forgets the state of the quotas of peerID.
+func (q *peerQuotas) removePeer(peerID p2p.ID) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	delete(q.peers, peerID)
+}
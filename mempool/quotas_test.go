@@ -0,0 +1,103 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestPeerQuotasRateLimits(t *testing.T) {
+	config := cfg.TestMempoolConfig()
+	config.PeerMaxTxsPerSecond = 2
+	config.PeerMaxBytesPerSecond = 100
+	config.PeerQuotaGracePeriod = 3 * time.Second
+	quotas := newPeerQuotas(config)
+	require.True(t, quotas.enabled())
+
+	const peerA, peerB = p2p.ID("a"), p2p.ID("b")
+	now := time.Now()
+
+	// A burst of up to one second worth of txs is allowed.
+	for i := 0; i < 2; i++ {
+		persistent, err := quotas.allow(peerA, 10, 0, now)
+		require.NoError(t, err)
+		require.False(t, persistent)
+	}
+	persistent, err := quotas.allow(peerA, 10, 0, now)
+	require.ErrorIs(t, err, ErrPeerQuotaExceeded{Quota: "peer_max_txs_per_second", Limit: 2})
+	require.False(t, persistent)
+
+	// Other peers have their own quotas.
+	_, err = quotas.allow(peerB, 10, 0, now)
+	require.NoError(t, err)
+
+	// Tokens are refilled over time.
+	now = now.Add(500 * time.Millisecond)
+	_, err = quotas.allow(peerA, 10, 0, now)
+	require.NoError(t, err)
+
+	// The bytes rate limit applies too.
+	now = now.Add(time.Second)
+	_, err = quotas.allow(peerA, 101, 0, now)
+	require.ErrorIs(t, err, ErrPeerQuotaExceeded{Quota: "peer_max_bytes_per_second", Limit: 100})
+
+	// A peer that keeps exceeding its quotas for longer than the grace period
+	// is reported.
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		persistent, err = quotas.allow(peerA, 101, 0, now)
+		require.Error(t, err)
+		require.Equal(t, i == 2, persistent)
+	}
+
+	// Pausing for more than a second starts a new streak of violations.
+	now = now.Add(2 * time.Second)
+	persistent, err = quotas.allow(peerA, 101, 0, now)
+	require.Error(t, err)
+	require.False(t, persistent)
+
+	quotas.removePeer(peerA)
+	require.NotContains(t, quotas.peers, peerA)
+}
+
+func TestPeerQuotasMaxTxs(t *testing.T) {
+	config := cfg.TestMempoolConfig()
+	config.PeerMaxTxs = 2
+	quotas := newPeerQuotas(config)
+
+	_, err := quotas.allow("a", 10, 1, time.Now())
+	require.NoError(t, err)
+	_, err = quotas.allow("a", 10, 2, time.Now())
+	require.ErrorIs(t, err, ErrPeerQuotaExceeded{Quota: "peer_max_txs", Limit: 2})
+
+	require.False(t, newPeerQuotas(cfg.TestMempoolConfig()).enabled())
+}
+
+func TestMempoolNumPeerTxs(t *testing.T) {
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()))
+	defer cleanup()
+
+	tx0, tx1 := types.Tx(kvstore.NewTx("k0", "v")), types.Tx(kvstore.NewTx("k1", "v"))
+	_, err := mp.CheckTx(tx0, "a")
+	require.NoError(t, err)
+	_, err = mp.CheckTx(tx1, "a")
+	require.NoError(t, err)
+	// Only the first sender of a tx is accounted for.
+	_, err = mp.CheckTx(tx1, "b")
+	require.ErrorIs(t, err, ErrTxInCache)
+	require.Equal(t, 2, mp.numPeerTxs("a"))
+	require.Zero(t, mp.numPeerTxs("b"))
+
+	require.NoError(t, mp.RemoveTxByKey(tx0.Key()))
+	require.Equal(t, 1, mp.numPeerTxs("a"))
+
+	mp.Flush()
+	require.Zero(t, mp.numPeerTxs("a"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -17,6 +18,10 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
+// maxTxKeysPerMsg is the maximum number of tx keys in a HaveTxs or WantTxs
+// message.
+const maxTxKeysPerMsg = 1000
+
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
@@ -33,6 +38,12 @@ type Reactor struct {
 	// connections for different groups of peers.
 	activePersistentPeersSemaphore    *semaphore.Weighted
 	activeNonPersistentPeersSemaphore *semaphore.Weighted
+
+	// Txs requested from peers, in the have/want gossip mode.
+	requests *txRequests
+
+	// Admission quotas of the txs received from each peer.
+	quotas *peerQuotas
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
@@ -41,8 +52,13 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool, waitSync bool)
 		config:   config,
 		mempool:  mempool,
 		waitSync: atomic.Bool{},
+		quotas:   newPeerQuotas(config),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
+	if memR.haveWant() {
+		memR.requests = newTxRequests(TxRequestTimeout)
+	}
+	mempool.onPeerTxAdded = memR.rewardSender
 	if waitSync {
 		memR.waitSync.Store(true)
 		memR.waitSyncCh = make(chan struct{})
@@ -67,9 +83,17 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	if memR.requests != nil {
+		go memR.retryTxRequestsRoutine()
+	}
 	return nil
 }
 
+// haveWant returns true if the reactor uses the have/want gossip mode.
+func (memR *Reactor) haveWant() bool {
+	return memR.config.GossipMode == cfg.MempoolGossipModeHaveWant
+}
+
 // GetChannels implements Reactor by returning the list of channels for this
 // reactor.
 func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
@@ -80,7 +104,7 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 		},
 	}
 
-	return []*p2p.ChannelDescriptor{
+	chs := []*p2p.ChannelDescriptor{
 		{
 			ID:                  MempoolChannel,
 			Priority:            5,
@@ -88,6 +112,23 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			MessageType:         &protomem.Message{},
 		},
 	}
+	if memR.haveWant() {
+		keysMsg := protomem.Message{
+			Sum: &protomem.Message_HaveTxs{
+				HaveTxs: &protomem.HaveTxs{TxKeys: make([][]byte, maxTxKeysPerMsg)},
+			},
+		}
+		for i := range keysMsg.GetHaveTxs().TxKeys {
+			keysMsg.GetHaveTxs().TxKeys[i] = make([]byte, types.TxKeySize)
+		}
+		chs = append(chs, &p2p.ChannelDescriptor{
+			ID:                  MempoolControlChannel,
+			Priority:            5,
+			RecvMessageCapacity: keysMsg.Size(),
+			MessageType:         &protomem.Message{},
+		})
+	}
+	return chs
 }
 
 // AddPeer implements Reactor.
@@ -128,11 +169,28 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 
 			memR.mempool.metrics.ActiveOutboundConnections.Add(1)
 			defer memR.mempool.metrics.ActiveOutboundConnections.Add(-1)
-			memR.broadcastTxRoutine(peer)
+
+			// Gossip each lane independently, so that a backlog of txs in one
+			// lane does not delay the txs of other lanes.
+			var wg sync.WaitGroup
+			for _, txs := range memR.mempool.gossipQueues() {
+				wg.Add(1)
+				go func(txs *clist.CList) {
+					defer wg.Done()
+					memR.broadcastTxRoutine(peer, txs)
+				}(txs)
+			}
+			wg.Wait()
 		}()
 	}
 }
 
+// RemovePeer implements Reactor.
+func (memR *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	memR.quotas.removePeer(peer.ID())
+	// broadcast routines check if peer is gone and return
+}
+
 // Receive implements Reactor.
 // It adds any received transactions to the mempool.
 func (memR *Reactor) Receive(e p2p.Envelope) {
@@ -152,15 +210,58 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 
 		for _, txBytes := range protoTxs {
 			tx := types.Tx(txBytes)
+			if memR.quotas.enabled() {
+				persistent, err := memR.quotas.allow(e.Src.ID(), len(tx), memR.mempool.numPeerTxs(e.Src.ID()), time.Now())
+				if persistent {
+					memR.Switch.StopPeerForError(e.Src, err)
+					return
+				}
+				if err != nil {
+					memR.Logger.Debug("Dropped tx", "tx", tx.Hash(), "src", e.Src, "err", err)
+					memR.mempool.metrics.PeerQuotaExceededTxs.Add(1)
+					continue
+				}
+			}
+			// The peer is rewarded once the tx is admitted into the mempool
+			// (see rewardSender). Honest peers may send txs we already
+			// received from other peers, so these are not penalized.
 			_, err := memR.mempool.CheckTx(tx, e.Src.ID())
 			if errors.Is(err, ErrTxInCache) {
 				memR.Logger.Debug("Tx already exists in cache", "tx", tx.Hash())
 			} else if err != nil {
 				memR.Logger.Info("Could not check tx", "tx", tx.Hash(), "err", err)
 			}
+			if memR.requests != nil {
+				memR.requests.remove(tx.Key())
+			}
 		}
+	case *protomem.HaveTxs:
+		if memR.WaitSync() {
+			memR.Logger.Debug("Ignored message received while syncing", "msg", msg)
+			return
+		}
+		keys, err := txKeys(msg.GetTxKeys())
+		if err != nil {
+			memR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
+			memR.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+		memR.handleHaveTxs(e.Src, keys)
+	case *protomem.WantTxs:
+		if memR.WaitSync() {
+			memR.Logger.Debug("Ignored message received while syncing", "msg", msg)
+			return
+		}
+		keys, err := txKeys(msg.GetTxKeys())
+		if err != nil {
+			memR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
+			memR.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+		memR.handleWantTxs(e.Src, keys)
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
+		memR.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
 		return
 	}
@@ -168,6 +269,128 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 	// broadcasting happens from go routines per peer
 }
 
+// rewardSender rewards the peer that sent a tx admitted into the mempool.
+func (memR *Reactor) rewardSender(sender p2p.ID) {
+	if memR.Switch == nil {
+		return
+	}
+	if peer := memR.Switch.Peers().Get(sender); peer != nil {
+		memR.Switch.ReportPeerEvent(peer, p2p.PeerEventTx)
+	}
+}
+
+// handleHaveTxs requests from peer the announced txs that have not been seen
+// or requested yet, and records peer as an announcer of the txs already
+// requested from another peer, to request them from it if that peer does not
+// send them in time.
+func (memR *Reactor) handleHaveTxs(peer p2p.Peer, keys []types.TxKey) {
+	if memR.requests == nil {
+		// We did not advertise the control channel, so the peer should not
+		// announce txs to us.
+		memR.Logger.Debug("Ignored tx announcements in push gossip mode", "src", peer)
+		return
+	}
+
+	var wanted []types.TxKey
+	for _, key := range keys {
+		memR.mempool.metrics.TxAnnouncements.Add(1)
+
+		if elem, ok := memR.mempool.getCElement(key); ok {
+			// Do not announce the tx back to the peer.
+			elem.Value.(*mempoolTx).addSender(peer.ID())
+			memR.mempool.metrics.DuplicateTxAnnouncements.Add(1)
+			continue
+		}
+		if memR.mempool.cache.HasKey(key) || !memR.requests.add(key, peer.ID()) {
+			memR.mempool.metrics.DuplicateTxAnnouncements.Add(1)
+			continue
+		}
+		wanted = append(wanted, key)
+	}
+	memR.sendWantTxs(peer, wanted)
+}
+
+// sendWantTxs requests the txs with the given keys from peer. If the request
+// cannot be sent, the txs are requested from another peer that announced them
+// once the request times out.
+func (memR *Reactor) sendWantTxs(peer p2p.Peer, keys []types.TxKey) {
+	for len(keys) > 0 {
+		n := min(len(keys), maxTxKeysPerMsg)
+		wanted := make([][]byte, n)
+		for i, key := range keys[:n] {
+			wanted[i] = key[:]
+		}
+		keys = keys[n:]
+
+		// Do not block the receive routine of the peer.
+		if !peer.TrySend(p2p.Envelope{
+			ChannelID: MempoolControlChannel,
+			Message:   &protomem.WantTxs{TxKeys: wanted},
+		}) {
+			memR.Logger.Debug("Could not request txs", "peer", peer, "num_txs", n)
+			continue
+		}
+		memR.mempool.metrics.RequestedTxs.Add(float64(n))
+	}
+}
+
+// retryTxRequestsRoutine requests the txs whose request timed out from the
+// next peer that announced them.
+func (memR *Reactor) retryTxRequestsRoutine() {
+	ticker := time.NewTicker(memR.requests.timeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			for peerID, keys := range memR.requests.retry(now) {
+				// If the peer is gone, the request times out again and the
+				// txs are requested from the next announcer, if any.
+				if peer := memR.Switch.Peers().Get(peerID); peer != nil {
+					memR.sendWantTxs(peer, keys)
+				}
+			}
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// handleWantTxs sends to peer the requested txs that are in the mempool.
+func (memR *Reactor) handleWantTxs(peer p2p.Peer, keys []types.TxKey) {
+	for _, key := range keys {
+		elem, ok := memR.mempool.getCElement(key)
+		if !ok {
+			// The tx was removed from the mempool since it was announced.
+			continue
+		}
+		if !peer.TrySend(p2p.Envelope{
+			ChannelID: MempoolChannel,
+			Message:   &protomem.Txs{Txs: [][]byte{elem.Value.(*mempoolTx).tx}},
+		}) {
+			memR.Logger.Debug("Could not send requested tx", "tx", key, "peer", peer)
+		}
+	}
+}
+
+// txKeys converts the keys of a HaveTxs or WantTxs message into TxKeys.
+func txKeys(protoKeys [][]byte) ([]types.TxKey, error) {
+	if len(protoKeys) == 0 {
+		return nil, errors.New("received empty list of tx keys")
+	}
+	if len(protoKeys) > maxTxKeysPerMsg {
+		return nil, fmt.Errorf("received %d tx keys, more than the maximum %d", len(protoKeys), maxTxKeysPerMsg)
+	}
+	keys := make([]types.TxKey, len(protoKeys))
+	for i, key := range protoKeys {
+		if len(key) != types.TxKeySize {
+			return nil, fmt.Errorf("received tx key of size %d, expected %d", len(key), types.TxKeySize)
+		}
+		keys[i] = types.TxKey(key)
+	}
+	return keys, nil
+}
+
 func (memR *Reactor) EnableInOutTxs() {
 	memR.Logger.Info("enabling inbound and outbound transactions")
 	if !memR.waitSync.CompareAndSwap(true, false) {
@@ -189,10 +412,14 @@ type PeerState interface {
 	GetHeight() int64
 }
 
-// Send new mempool txs to peer.
-func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
+// Send new mempool txs from the given list to peer.
+func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer, txs *clist.CList) {
 	var next *clist.CElement
 
+	// In the have/want gossip mode, only announce txs to peers that can
+	// request them. Other peers still get the txs.
+	announce := memR.haveWant() && peerHasControlChannel(peer)
+
 	// If the node is catching up, don't start this routine immediately.
 	if memR.WaitSync() {
 		select {
@@ -214,8 +441,8 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		// start from the beginning.
 		if next == nil {
 			select {
-			case <-memR.mempool.TxsWaitChan(): // Wait until a tx is available
-				if next = memR.mempool.TxsFront(); next == nil {
+			case <-txs.WaitChan(): // Wait until a tx is available
+				if next = txs.Front(); next == nil {
 					continue
 				}
 			case <-peer.Quit():
@@ -253,11 +480,18 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		// https://github.com/tendermint/tendermint/issues/5796
 
 		if !memTx.isSender(peer.ID()) {
-			success := peer.Send(p2p.Envelope{
+			envelope := p2p.Envelope{
 				ChannelID: MempoolChannel,
 				Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
-			})
-			if !success {
+			}
+			if announce {
+				key := memTx.tx.Key()
+				envelope = p2p.Envelope{
+					ChannelID: MempoolControlChannel,
+					Message:   &protomem.HaveTxs{TxKeys: [][]byte{key[:]}},
+				}
+			}
+			if success := peer.Send(envelope); !success {
 				time.Sleep(PeerCatchupSleepIntervalMS * time.Millisecond)
 				continue
 			}
@@ -274,3 +508,10 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		}
 	}
 }
+
+// peerHasControlChannel returns true if peer advertises MempoolControlChannel,
+// that is, if it supports the have/want gossip mode.
+func peerHasControlChannel(peer p2p.Peer) bool {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(MempoolControlChannel)
+}
@@ -109,7 +109,7 @@ func TestReactorConcurrency(t *testing.T) {
 
 			reactors[1].mempool.Lock()
 			defer reactors[1].mempool.Unlock()
-			err := reactors[1].mempool.Update(1, []types.Tx{}, make([]*abci.ExecTxResult, 0), nil, nil)
+			err := reactors[1].mempool.Update(1, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil)
 			require.NoError(t, err)
 		}()
 
@@ -153,6 +153,158 @@ func TestReactorNoBroadcastToSender(t *testing.T) {
 	ensureNoTxs(t, reactors[1], 100*time.Millisecond)
 }
 
+// The peers are only rewarded for the txs admitted into the mempool.
+func TestReactorRewardsAdmittedTxsOnly(t *testing.T) {
+	config := cfg.TestConfig()
+	reactors, _ := makeAndConnectReactors(config, 2)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+
+	sw := reactors[0].Switch
+	peer := sw.Peers().Get(reactors[1].Switch.NodeInfo().ID())
+	require.NotNil(t, peer)
+
+	// The kvstore app rejects txs that are not key=value pairs.
+	reactors[0].Receive(p2p.Envelope{
+		Src:       peer,
+		ChannelID: MempoolChannel,
+		Message:   &memproto.Txs{Txs: [][]byte{[]byte("invalid")}},
+	})
+	require.NoError(t, reactors[0].mempool.FlushAppConn())
+	assert.Zero(t, reactors[0].mempool.Size())
+	assert.Zero(t, sw.PeerScore(peer.ID()))
+
+	reactors[0].Receive(p2p.Envelope{
+		Src:       peer,
+		ChannelID: MempoolChannel,
+		Message:   &memproto.Txs{Txs: [][]byte{kvstore.NewTx("key", "value")}},
+	})
+	require.NoError(t, reactors[0].mempool.FlushAppConn())
+	assert.Equal(t, 1, reactors[0].mempool.Size())
+	assert.Positive(t, sw.PeerScore(peer.ID()))
+}
+
+// Send a bunch of txs to the first reactor's mempool and wait for them to be
+// received by the others, with a mix of have/want and push gossip modes.
+func TestReactorHaveWantGossipMode(t *testing.T) {
+	modes := []string{cfg.MempoolGossipModeHaveWant, cfg.MempoolGossipModeHaveWant, cfg.MempoolGossipModePush}
+	reactors := make([]*Reactor, len(modes))
+	for i, mode := range modes {
+		config := cfg.TestConfig()
+		config.Mempool.GossipMode = mode
+		mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()))
+		defer cleanup()
+		reactors[i] = NewReactor(config.Mempool, mempool, false)
+		reactors[i].SetLogger(mempoolLogger().With("validator", i))
+	}
+	p2p.MakeConnectedSwitches(cfg.TestConfig().P2P, len(reactors), func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("MEMPOOL", reactors[i])
+		return s
+	}, p2p.Connect2Switches)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+			// Only peers in have/want mode advertise the control channel.
+			require.Equal(t, peer.ID() != reactors[2].Switch.NodeInfo().ID(), peerHasControlChannel(peer))
+		}
+	}
+
+	txs := checkTxs(t, reactors[0].mempool, 100)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+
+	// Txs are also gossiped from a node in push mode.
+	txs = append(txs, checkTxs(t, reactors[2].mempool, 100)...)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+}
+
+func TestTxRequests(t *testing.T) {
+	requests := newTxRequests(50 * time.Millisecond)
+	key := types.Tx("tx").Key()
+
+	require.True(t, requests.add(key, "peer1"))
+	// The tx is not requested again until the request times out, but the
+	// other peers announcing it are recorded, once.
+	require.False(t, requests.add(key, "peer1"))
+	require.False(t, requests.add(key, "peer2"))
+	require.False(t, requests.add(key, "peer3"))
+	require.False(t, requests.add(key, "peer2"))
+	assert.Empty(t, requests.retry(time.Now()))
+
+	// Timed out requests are retried from the next announcer.
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, map[p2p.ID][]types.TxKey{"peer2": {key}}, requests.retry(time.Now()))
+	require.False(t, requests.add(key, "peer4"))
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, map[p2p.ID][]types.TxKey{"peer3": {key}}, requests.retry(time.Now()))
+
+	// An announcer may take over a timed out request.
+	time.Sleep(60 * time.Millisecond)
+	require.True(t, requests.add(key, "peer4"))
+	assert.Empty(t, requests.retry(time.Now()))
+
+	// Received txs can be requested again, e.g. after a recheck removed them.
+	requests.remove(key)
+	require.True(t, requests.add(key, "peer1"))
+
+	// Timed out requests that no other peer announced are forgotten.
+	time.Sleep(60 * time.Millisecond)
+	assert.Empty(t, requests.retry(time.Now()))
+	require.Empty(t, requests.requested)
+}
+
+// A tx announced by several peers is requested from the next one if the first
+// never sends it.
+func TestReactorHaveWantUnresponsiveAnnouncer(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.GossipMode = cfg.MempoolGossipModeHaveWant
+	// The first reactor is connected to the two others, which are not
+	// connected to each other.
+	reactors, _ := makeAndConnectReactorsStar(config, 0, 3)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	// The second reactor announces a tx, which it does not have.
+	tx := types.Tx(kvstore.NewTx("key", "value"))
+	key := tx.Key()
+	unresponsive := reactors[0].Switch.Peers().Get(reactors[1].Switch.NodeInfo().ID())
+	require.NotNil(t, unresponsive)
+	reactors[0].Receive(p2p.Envelope{
+		Src:       unresponsive,
+		ChannelID: MempoolControlChannel,
+		Message:   &memproto.HaveTxs{TxKeys: [][]byte{key[:]}},
+	})
+
+	// The third reactor announces it while it is requested from the second.
+	_, err := reactors[2].mempool.CheckTx(tx, "")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return reactors[0].mempool.InMempool(key)
+	}, 5*TxRequestTimeout, 50*time.Millisecond)
+}
+
 func TestReactor_MaxTxBytes(t *testing.T) {
 	config := cfg.TestConfig()
 
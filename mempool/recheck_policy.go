@@ -0,0 +1,128 @@
+package mempool
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// RecheckPolicy selects which transactions are rechecked after a block is
+// committed. Without a policy, all transactions in the mempool are rechecked.
+//
+// TxAdded and TxRemoved may be called concurrently with each other and with
+// ShouldRecheck, so implementations must be thread-safe.
+type RecheckPolicy interface {
+	// TxAdded is called when a transaction is added to the mempool, with its
+	// CheckTx response.
+	TxAdded(txKey types.TxKey, res *abci.CheckTxResponse)
+
+	// TxRemoved is called when a transaction is removed from the mempool.
+	TxRemoved(txKey types.TxKey)
+
+	// BlockCommitted is called when a block is committed, before rechecking,
+	// with the transactions of the block and the response of the application
+	// to the FinalizeBlock request, which holds their results.
+	BlockCommitted(height int64, txs types.Txs, abciResponse *abci.FinalizeBlockResponse)
+
+	// ShouldRecheck returns true if the transaction must be rechecked after
+	// the last committed block.
+	ShouldRecheck(txKey types.TxKey) bool
+}
+
+// eventRecheckPolicy is a RecheckPolicy that rechecks only the transactions
+// touched by the committed block, based on the values of a set of event keys.
+type eventRecheckPolicy struct {
+	keys map[string]struct{}
+
+	mtx      cmtsync.Mutex
+	txValues map[types.TxKey][]string // values of the keys in the CheckTx events of each tx
+	touched  map[string]struct{}      // values of the keys in the events of the last committed block and its txs
+}
+
+var _ RecheckPolicy = (*eventRecheckPolicy)(nil)
+
+// NewEventRecheckPolicy returns a RecheckPolicy that rechecks a transaction
+// only if one of the values of the given event keys in its CheckTx response
+// also appears in the events of the committed block or of one of its
+// transactions, e.g. the same "transfer.sender". Keys are composite event keys of the form
+// "{event.type}.{attribute.key}". Transactions whose CheckTx response has none
+// of these keys are always rechecked.
+func NewEventRecheckPolicy(keys []string) RecheckPolicy {
+	p := &eventRecheckPolicy{
+		keys:     make(map[string]struct{}, len(keys)),
+		txValues: make(map[types.TxKey][]string),
+		touched:  make(map[string]struct{}),
+	}
+	for _, key := range keys {
+		p.keys[key] = struct{}{}
+	}
+	return p
+}
+
+// values returns the values of the policy's keys in events, each prefixed by
+// its key so that equal values of different keys do not match.
+func (p *eventRecheckPolicy) values(events []abci.Event) []string {
+	var values []string
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			key := event.Type + "." + attr.Key
+			if _, ok := p.keys[key]; ok {
+				values = append(values, key+"="+attr.Value)
+			}
+		}
+	}
+	return values
+}
+
+// TxAdded implements RecheckPolicy.
+func (p *eventRecheckPolicy) TxAdded(txKey types.TxKey, res *abci.CheckTxResponse) {
+	values := p.values(res.Events)
+	if len(values) == 0 {
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.txValues[txKey] = values
+}
+
+// TxRemoved implements RecheckPolicy.
+func (p *eventRecheckPolicy) TxRemoved(txKey types.TxKey) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	delete(p.txValues, txKey)
+}
+
+// BlockCommitted implements RecheckPolicy.
+func (p *eventRecheckPolicy) BlockCommitted(_ int64, _ types.Txs, abciResponse *abci.FinalizeBlockResponse) {
+	touched := make(map[string]struct{})
+	for _, value := range p.values(abciResponse.Events) {
+		touched[value] = struct{}{}
+	}
+	for _, res := range abciResponse.TxResults {
+		for _, value := range p.values(res.Events) {
+			touched[value] = struct{}{}
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.touched = touched
+}
+
+// ShouldRecheck implements RecheckPolicy.
+func (p *eventRecheckPolicy) ShouldRecheck(txKey types.TxKey) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	values, ok := p.txValues[txKey]
+	if !ok {
+		return true
+	}
+	for _, value := range values {
+		if _, ok := p.touched[value]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestEventRecheckPolicy(t *testing.T) {
+	policy := NewEventRecheckPolicy([]string{"transfer.sender", "transfer.recipient"})
+
+	transfer := func(attrs ...string) []abci.Event {
+		event := abci.Event{Type: "transfer"}
+		for i := 0; i < len(attrs); i += 2 {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+		}
+		return []abci.Event{event}
+	}
+
+	tx0, tx1, tx2 := types.Tx("tx0").Key(), types.Tx("tx1").Key(), types.Tx("tx2").Key()
+	policy.TxAdded(tx0, &abci.CheckTxResponse{Events: transfer("sender", "alice")})
+	policy.TxAdded(tx1, &abci.CheckTxResponse{Events: transfer("sender", "bob", "amount", "10")})
+	// tx2 has none of the keys, so it is always rechecked.
+	policy.TxAdded(tx2, &abci.CheckTxResponse{Events: transfer("amount", "10")})
+
+	// Only the txs with a value touched by the block are rechecked.
+	policy.BlockCommitted(1, nil, &abci.FinalizeBlockResponse{
+		TxResults: []*abci.ExecTxResult{{Events: transfer("recipient", "carol")}, {Events: transfer("sender", "alice")}},
+	})
+	require.True(t, policy.ShouldRecheck(tx0))
+	require.False(t, policy.ShouldRecheck(tx1))
+	require.True(t, policy.ShouldRecheck(tx2))
+
+	// Equal values of different keys do not match.
+	policy.BlockCommitted(2, nil, &abci.FinalizeBlockResponse{
+		TxResults: []*abci.ExecTxResult{{Events: transfer("recipient", "alice")}},
+	})
+	require.False(t, policy.ShouldRecheck(tx0))
+	require.False(t, policy.ShouldRecheck(tx1))
+
+	// The block-level events are matched too, e.g. those of begin and end
+	// block logic.
+	policy.BlockCommitted(3, nil, &abci.FinalizeBlockResponse{Events: transfer("sender", "bob")})
+	require.False(t, policy.ShouldRecheck(tx0))
+	require.True(t, policy.ShouldRecheck(tx1))
+
+	// Removed txs are forgotten.
+	policy.TxRemoved(tx1)
+	require.True(t, policy.ShouldRecheck(tx1))
+}
+
+// keysRecheckPolicy rechecks only the txs in keys.
+type keysRecheckPolicy map[types.TxKey]bool
+
+func (keysRecheckPolicy) TxAdded(types.TxKey, *abci.CheckTxResponse)                   {}
+func (keysRecheckPolicy) TxRemoved(types.TxKey)                                        {}
+func (keysRecheckPolicy) BlockCommitted(int64, types.Txs, *abci.FinalizeBlockResponse) {}
+func (p keysRecheckPolicy) ShouldRecheck(txKey types.TxKey) bool                       { return p[txKey] }
+
+func TestMempoolRecheckBatches(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.RecheckBatchSize = 3
+	cfg.Mempool.RecheckConcurrency = 2
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), cfg)
+	defer cleanup()
+
+	txs := make(types.Txs, 10)
+	for i := range txs {
+		txs[i] = kvstore.NewTxFromID(i)
+		_, err := mp.CheckTx(txs[i], "")
+		require.NoError(t, err)
+	}
+	require.Equal(t, len(txs), mp.Size())
+
+	// Only the txs selected by the policy are rechecked, and thus removed.
+	policy := keysRecheckPolicy{}
+	for _, tx := range txs[:7] {
+		policy[tx.Key()] = true
+	}
+	mp.recheckPolicy = policy
+	require.NoError(t, mp.Update(1, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, PostCheckMaxGas(0)))
+	require.True(t, mp.recheck.done())
+	require.Zero(t, mp.recheck.numPendingTxs.Load())
+	require.Equal(t, 3, mp.Size())
+	for _, tx := range txs[7:] {
+		require.True(t, mp.InMempool(tx.Key()))
+	}
+
+	// Without a policy, all txs are rechecked.
+	mp.recheckPolicy = nil
+	require.NoError(t, mp.Update(2, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	require.Zero(t, mp.Size())
+}
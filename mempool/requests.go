@@ -0,0 +1,103 @@
+package mempool
+
+import (
+	"slices"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxRequestTimeout is how long the reactor waits for a peer to send a tx
+// requested in the have/want gossip mode, before requesting it again from
+// another peer that announced it.
+const TxRequestTimeout = time.Second
+
+// maxTxAnnouncers is the maximum number of peers recorded as having announced
+// a requested tx, besides the peer it is requested from.
+const maxTxAnnouncers = 10
+
+// txRequest is a tx requested from a peer.
+type txRequest struct {
+	peer       p2p.ID    // peer the tx is requested from
+	time       time.Time // time of the request
+	announcers []p2p.ID  // other peers that announced the tx, in order
+}
+
+// txRequests keeps track of the txs requested from peers in the have/want
+// gossip mode, so that the same tx is not requested from several peers at the
+// same time, and of the other peers that announced them, so that they are
+// requested from the next one if a peer does not send them in time.
+type txRequests struct {
+	mtx       cmtsync.Mutex
+	timeout   time.Duration
+	requested map[types.TxKey]*txRequest
+}
+
+func newTxRequests(timeout time.Duration) *txRequests {
+	return &txRequests{
+		timeout:   timeout,
+		requested: make(map[types.TxKey]*txRequest),
+	}
+}
+
+// add marks the tx with the given key, announced by peer, as requested from
+// peer and returns true, unless it has already been requested and the request
+// has not timed out yet, in which case peer is recorded as an announcer of the
+// tx to request it from later (see retry).
+func (r *txRequests) add(key types.TxKey, peer p2p.ID) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := time.Now()
+	req, ok := r.requested[key]
+	if !ok || now.Sub(req.time) >= r.timeout {
+		if ok {
+			req.announcers = slices.DeleteFunc(req.announcers, func(id p2p.ID) bool { return id == peer })
+		} else {
+			req = &txRequest{}
+			r.requested[key] = req
+		}
+		req.peer, req.time = peer, now
+		return true
+	}
+
+	if peer != req.peer && len(req.announcers) < maxTxAnnouncers && !slices.Contains(req.announcers, peer) {
+		req.announcers = append(req.announcers, peer)
+	}
+	return false
+}
+
+// retry marks the txs whose request timed out at now as requested from the
+// next peer that announced them, and returns their keys by peer. The txs that
+// no other peer announced are forgotten, so that they can be requested again
+// if they are announced again.
+func (r *txRequests) retry(now time.Time) map[p2p.ID][]types.TxKey {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	retries := make(map[p2p.ID][]types.TxKey)
+	for key, req := range r.requested {
+		if now.Sub(req.time) < r.timeout {
+			continue
+		}
+		if len(req.announcers) == 0 {
+			delete(r.requested, key)
+			continue
+		}
+		req.peer, req.announcers = req.announcers[0], req.announcers[1:]
+		req.time = now
+		retries[req.peer] = append(retries[req.peer], key)
+	}
+	return retries
+}
+
+// remove forgets the request for the tx with the given key, because the tx
+// was received.
+func (r *txRequests) remove(key types.TxKey) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.requested, key)
+}
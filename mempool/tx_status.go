@@ -0,0 +1,131 @@
+package mempool
+
+import (
+	"container/list"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Statuses of a transaction, as known by the mempool.
+const (
+	// The mempool has not seen the transaction, or has forgotten about it.
+	TxStatusUnknown = "unknown"
+	// The transaction is in the mempool.
+	TxStatusPending = "pending"
+	// The transaction was removed from the mempool, or could not be added to
+	// it because the mempool was full.
+	TxStatusEvicted = "evicted"
+	// The transaction did not pass CheckTx.
+	TxStatusRejected = "rejected"
+)
+
+// Reasons why a transaction was evicted from the mempool.
+const (
+	// The transaction was invalid when rechecked after a block was committed.
+	EvictionReasonRecheck = "recheck"
+	// The mempool, or the lane of the transaction, was full.
+	EvictionReasonFull = "full"
+	// The transaction exceeded ttl_num_blocks or ttl_duration.
+	EvictionReasonTTL = "ttl"
+)
+
+// TxStatus is the status of a transaction, as known by the mempool.
+type TxStatus struct {
+	Status string
+
+	// Height and time at which the transaction was added to the mempool, if
+	// pending, or at which it was evicted or rejected.
+	Height int64
+	Time   time.Time
+
+	// Peer that first sent the transaction, if pending. It is empty if the
+	// transaction was received via RPC.
+	Sender p2p.ID
+
+	// Reason why the transaction was evicted, if evicted.
+	Reason string
+
+	// CheckTx response code and log, if rejected.
+	Code uint32
+	Log  string
+}
+
+// txStatusCache keeps the status of the most recently evicted and rejected
+// transactions. It is an LRU cache of limited size, so old statuses are
+// forgotten.
+type txStatusCache struct {
+	mtx      cmtsync.Mutex
+	size     int
+	statuses map[types.TxKey]*list.Element
+	list     *list.List
+}
+
+type txStatusEntry struct {
+	key    types.TxKey
+	status TxStatus
+}
+
+func newTxStatusCache(size int) *txStatusCache {
+	return &txStatusCache{
+		size:     size,
+		statuses: make(map[types.TxKey]*list.Element, size),
+		list:     list.New(),
+	}
+}
+
+// set records the status of the transaction with the given key.
+func (c *txStatusCache) set(key types.TxKey, status TxStatus) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.statuses[key]; ok {
+		e.Value.(*txStatusEntry).status = status
+		c.list.MoveToBack(e)
+		return
+	}
+
+	if c.list.Len() >= c.size {
+		front := c.list.Front()
+		delete(c.statuses, front.Value.(*txStatusEntry).key)
+		c.list.Remove(front)
+	}
+	c.statuses[key] = c.list.PushBack(&txStatusEntry{key: key, status: status})
+}
+
+// get returns the status of the transaction with the given key, if recorded.
+func (c *txStatusCache) get(key types.TxKey) (TxStatus, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.statuses[key]; ok {
+		return e.Value.(*txStatusEntry).status, true
+	}
+	return TxStatus{}, false
+}
+
+// remove forgets the status of the transaction with the given key.
+func (c *txStatusCache) remove(key types.TxKey) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.statuses[key]; ok {
+		delete(c.statuses, key)
+		c.list.Remove(e)
+	}
+}
+
+// reset forgets all statuses.
+func (c *txStatusCache) reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	clear(c.statuses)
+	c.list.Init()
+}
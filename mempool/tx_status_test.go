@@ -0,0 +1,77 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestMempoolTxStatus(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 2
+	cfg.Mempool.TTLNumBlocks = 2
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication()), cfg)
+	defer cleanup()
+
+	tx0, tx1, tx2 := types.Tx(kvstore.NewTx("k0", "v")), types.Tx(kvstore.NewTx("k1", "v")), types.Tx(kvstore.NewTx("k2", "v"))
+	require.Equal(t, TxStatusUnknown, mp.GetTxStatus(tx0.Key()).Status)
+
+	// Pending txs report the height at which they were received and the sender.
+	require.NoError(t, mp.Update(1, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	_, err := mp.CheckTx(tx0, "a")
+	require.NoError(t, err)
+	status := mp.GetTxStatus(tx0.Key())
+	require.Equal(t, TxStatusPending, status.Status)
+	require.EqualValues(t, 1, status.Height)
+	require.EqualValues(t, "a", status.Sender)
+	require.False(t, status.Time.IsZero())
+
+	// Invalid txs are rejected.
+	invalidTx := types.Tx("invalid")
+	_, err = mp.CheckTx(invalidTx, "")
+	require.NoError(t, err)
+	status = mp.GetTxStatus(invalidTx.Key())
+	require.Equal(t, TxStatusRejected, status.Status)
+	require.Equal(t, kvstore.CodeTypeInvalidTxFormat, status.Code)
+
+	// Txs that do not fit are evicted.
+	_, err = mp.CheckTx(tx1, "")
+	require.NoError(t, err)
+	_, err = mp.CheckTx(tx2, "")
+	require.ErrorAs(t, err, &ErrMempoolIsFull{})
+	status = mp.GetTxStatus(tx2.Key())
+	require.Equal(t, TxStatusEvicted, status.Status)
+	require.Equal(t, EvictionReasonFull, status.Reason)
+
+	// Expired txs are evicted.
+	for h := int64(2); h <= 4; h++ {
+		require.NoError(t, mp.Update(h, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	}
+	status = mp.GetTxStatus(tx0.Key())
+	require.Equal(t, TxStatusEvicted, status.Status)
+	require.Equal(t, EvictionReasonTTL, status.Reason)
+	require.EqualValues(t, 4, status.Height)
+
+	// Txs that are invalid on recheck are evicted.
+	_, err = mp.CheckTx(tx2, "")
+	require.NoError(t, err)
+	require.NoError(t, mp.Update(5, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, PostCheckMaxGas(0)))
+	status = mp.GetTxStatus(tx2.Key())
+	require.Equal(t, TxStatusEvicted, status.Status)
+	require.Equal(t, EvictionReasonRecheck, status.Reason)
+
+	// Resubmitted txs are pending again.
+	require.NoError(t, mp.Update(6, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, PostCheckMaxGas(-1)))
+	_, err = mp.CheckTx(tx0, "")
+	require.NoError(t, err)
+	require.Equal(t, TxStatusPending, mp.GetTxStatus(tx0.Key()).Status)
+
+	mp.Flush()
+	require.Equal(t, TxStatusUnknown, mp.GetTxStatus(tx1.Key()).Status)
+}
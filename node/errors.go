@@ -18,6 +18,8 @@ var (
 	ErrPassedGenesisHashMismatch = errors.New("genesis doc hash in db does not match passed --genesis_hash value")
 	// ErrLoadedGenesisDocHashMismatch is returned when the genesis doc hash in the database does not match the loaded genesis doc.
 	ErrLoadedGenesisDocHashMismatch = errors.New("genesis doc hash in db does not match loaded genesis doc")
+	// ErrOptimisticExecutionUnsupported is returned when optimistic execution is enabled but the application does not support it.
+	ErrOptimisticExecutionUnsupported = errors.New("optimistic execution is enabled but the application does not support it (see InfoResponse.optimistic_execution)")
 )
 
 // ErrCreateBlockStore is returned when the node fails to create the blockstore.
@@ -142,6 +144,45 @@ func (e ErrCreateAddrBook) Unwrap() error {
 	return e.Err
 }
 
+// ErrCreatePEXReactor is returned when the node fails to create the PEX reactor.
+type ErrCreatePEXReactor struct {
+	Err error
+}
+
+func (e ErrCreatePEXReactor) Error() string {
+	return fmt.Sprintf("could not create pex reactor: %v", e.Err)
+}
+
+func (e ErrCreatePEXReactor) Unwrap() error {
+	return e.Err
+}
+
+// ErrLoadPeerScores is returned when the node fails to load the peer scores.
+type ErrLoadPeerScores struct {
+	Err error
+}
+
+func (e ErrLoadPeerScores) Error() string {
+	return fmt.Sprintf("could not load peer scores: %v", e.Err)
+}
+
+func (e ErrLoadPeerScores) Unwrap() error {
+	return e.Err
+}
+
+// ErrLoadBanList is returned when the node fails to load the ban list.
+type ErrLoadBanList struct {
+	Err error
+}
+
+func (e ErrLoadBanList) Error() string {
+	return fmt.Sprintf("could not load ban list: %v", e.Err)
+}
+
+func (e ErrLoadBanList) Unwrap() error {
+	return e.Err
+}
+
 // ErrDialPeers is returned when the node fails to dial peers from the persistent_peers field.
 type ErrDialPeers struct {
 	Err error
@@ -16,6 +16,8 @@ import (
 
 	_ "net/http/pprof" //nolint: gosec
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	cfg "github.com/cometbft/cometbft/config"
 	bc "github.com/cometbft/cometbft/internal/blocksync"
 	cs "github.com/cometbft/cometbft/internal/consensus"
@@ -54,7 +56,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   nodeTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -69,6 +71,8 @@ type Node struct {
 	bcReactor         p2p.Reactor        // for block-syncing
 	mempoolReactor    waitSyncP2PReactor // for gossipping transactions
 	mempool           mempl.Mempool
+	mempoolDB         dbm.DB                  // mempool journal, if enabled
+	addrBookDB        dbm.DB                  // address book, if stored in a database
 	stateSync         bool                    // whether the node should state sync on startup
 	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
 	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
@@ -84,6 +88,8 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
+
+	validatorUptime *sm.ValidatorUptimeTracker // nil if validator uptime tracking is disabled
 }
 
 type waitSyncP2PReactor interface {
@@ -92,6 +98,15 @@ type waitSyncP2PReactor interface {
 	WaitSync() bool
 }
 
+// nodeTransport is the p2p transport of the node: a MultiplexTransport or, with
+// the QUIC transport, a DualTransport.
+type nodeTransport interface {
+	p2p.Transport
+	Listen(addr p2p.NetAddress) error
+	Close() error
+	AddChannel(chID byte)
+}
+
 // Option sets a parameter for the node.
 type Option func(*Node)
 
@@ -320,6 +335,11 @@ func NewNode(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if config.Consensus.OptimisticExecution {
+		if err := checkOptimisticExecution(ctx, proxyApp); err != nil {
+			return nil, err
+		}
+	}
 
 	// EventBus and IndexerService must be started before the handshake because
 	// we might need to index the txs of the replayed block as this might not have happened
@@ -382,7 +402,13 @@ func NewNode(ctx context.Context,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, waitSync, memplMetrics, logger)
+	mempool, mempoolReactor, mempoolDB, err := createMempoolAndMempoolReactor(config, dbProvider, proxyApp, state, waitSync, eventBus, memplMetrics, logger)
+	if err != nil {
+		if mempoolDB != nil {
+			_ = mempoolDB.Close()
+		}
+		return nil, err
+	}
 
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
 	if err != nil {
@@ -402,6 +428,14 @@ func NewNode(ctx context.Context,
 		return nil, ErrCreatePruner{Err: err}
 	}
 
+	// track which validators sign each block, if enabled
+	var validatorUptime *sm.ValidatorUptimeTracker
+	if window := config.Instrumentation.ValidatorUptimeWindow; window > 0 {
+		validatorUptime = sm.NewValidatorUptimeTracker(window, smMetrics)
+		// The last commit stored is the one of the height before the last.
+		validatorUptime.Load(stateStore, blockStore, state.LastBlockHeight-1)
+	}
+
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
 		stateStore,
@@ -412,6 +446,7 @@ func NewNode(ctx context.Context,
 		blockStore,
 		sm.BlockExecutorWithPruner(pruner),
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithValidatorUptime(validatorUptime),
 	)
 
 	offlineStateSyncHeight := int64(0)
@@ -453,11 +488,21 @@ func NewNode(ctx context.Context,
 		return nil, err
 	}
 
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	banList := p2p.NewBanList(config.P2P.BanListFile())
+	if err := banList.Load(); err != nil {
+		return nil, ErrLoadBanList{Err: err}
+	}
+
+	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp, banList)
+
+	peerScores := p2p.NewPeerScores(config.P2P.PeerScoresFile())
+	if err := peerScores.Load(); err != nil {
+		return nil, ErrLoadPeerScores{Err: err}
+	}
 
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
-		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
+		config, transport, p2pMetrics, peerFilters, peerScores, banList, mempoolReactor, bcReactor,
 		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
 	)
 
@@ -471,10 +516,14 @@ func NewNode(ctx context.Context,
 		return nil, ErrAddUnconditionalPeerIDs{Err: err}
 	}
 
-	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	addrBook, addrBookDB, err := createAddrBookAndSetOnSwitch(config, dbProvider, sw, p2pLogger, nodeKey)
 	if err != nil {
+		if addrBookDB != nil {
+			_ = addrBookDB.Close()
+		}
 		return nil, ErrCreateAddrBook{Err: err}
 	}
+	addrBook.SetBanList(banList)
 
 	// Optionally, start the pex reactor
 	//
@@ -490,7 +539,10 @@ func NewNode(ctx context.Context,
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
 	if config.P2P.PexReactor {
-		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+		pexReactor, err = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+		if err != nil {
+			return nil, ErrCreatePEXReactor{Err: err}
+		}
 	}
 
 	// Add private IDs to addrbook to block those peers being added
@@ -510,9 +562,12 @@ func NewNode(ctx context.Context,
 		stateStore:       stateStore,
 		blockStore:       blockStore,
 		pruner:           pruner,
+		validatorUptime:  validatorUptime,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		mempoolDB:        mempoolDB,
+		addrBookDB:       addrBookDB,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
@@ -679,6 +734,18 @@ func (n *Node) OnStop() {
 			n.Logger.Error("problem closing evidencestore", "err", err)
 		}
 	}
+	if n.mempoolDB != nil {
+		n.Logger.Info("Closing mempool journal")
+		if err := n.mempoolDB.Close(); err != nil {
+			n.Logger.Error("problem closing mempool journal", "err", err)
+		}
+	}
+	if n.addrBookDB != nil {
+		n.Logger.Info("Closing address book")
+		if err := n.addrBookDB.Close(); err != nil {
+			n.Logger.Error("problem closing address book", "err", err)
+		}
+	}
 }
 
 // ConfigureRPC makes sure RPC has all the objects it needs to operate.
@@ -707,6 +774,8 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
 
+		ValidatorUptimeTracker: n.validatorUptime,
+
 		Logger: n.Logger.With("module", "rpc"),
 
 		Config: *n.config.RPC,
@@ -825,6 +894,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.MempoolService.Enabled {
+			opts = append(opts, grpcserver.WithMempoolService(n.eventBus, n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -1006,10 +1078,22 @@ func makeNodeInfo(
 		},
 	}
 
+	if config.Mempool.Type != cfg.MempoolTypeNop && config.Mempool.GossipMode == cfg.MempoolGossipModeHaveWant {
+		nodeInfo.Channels = append(nodeInfo.Channels, mempl.MempoolControlChannel)
+	}
+
+	if config.Consensus.CompactBlocks {
+		nodeInfo.Channels = append(nodeInfo.Channels, cs.CompactBlockChannel)
+	}
+
 	if config.P2P.PexReactor {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
 
+	if config.P2P.Transport == cfg.P2PTransportQUIC {
+		nodeInfo.Transports = []string{p2p.TransportTCP, p2p.TransportQUIC}
+	}
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
@@ -17,6 +17,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -30,6 +31,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/conn"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
@@ -81,6 +83,20 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeDBAddrBook(t *testing.T) {
+	config := test.ResetTestRoot("node_db_addr_book_test")
+	defer os.RemoveAll(config.RootDir)
+	config.P2P.AddrBookBackend = cfg.AddrBookBackendDB
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.IsType(t, &pex.DBAddrBook{}, n.addrBook)
+
+	require.NoError(t, n.Start())
+	require.NoError(t, n.Stop())
+	assert.NoFileExists(t, config.P2P.AddrBookFile())
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -152,6 +168,44 @@ func TestNodeSetAppVersion(t *testing.T) {
 	assert.Equal(t, n.nodeInfo.(p2p.DefaultNodeInfo).ProtocolVersion.App, appVersion)
 }
 
+// optimisticApp is a kvstore app advertising support for optimistic execution.
+type optimisticApp struct {
+	*kvstore.Application
+}
+
+func (app optimisticApp) Info(ctx context.Context, req *abci.InfoRequest) (*abci.InfoResponse, error) {
+	res, err := app.Application.Info(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res.OptimisticExecution = true
+	return res, nil
+}
+
+func TestNodeOptimisticExecution(t *testing.T) {
+	config := test.ResetTestRoot("node_optimistic_execution_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.OptimisticExecution = true
+
+	// The kvstore app does not support optimistic execution.
+	_, err := DefaultNewNode(config, log.TestingLogger())
+	require.ErrorIs(t, err, ErrOptimisticExecutionUnsupported)
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	require.NoError(t, err)
+	_, err = NewNode(context.Background(),
+		config,
+		privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(optimisticApp{kvstore.NewInMemoryApplication()}),
+		DefaultGenesisDocProviderFunc(config),
+		cfg.DefaultDBProvider,
+		DefaultMetricsProvider(config.Instrumentation),
+		log.TestingLogger(),
+	)
+	require.NoError(t, err)
+}
+
 func TestPprofServer(t *testing.T) {
 	config := test.ResetTestRoot("node_pprof_test")
 	defer os.RemoveAll(config.RootDir)
@@ -3,6 +3,7 @@ package node
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"net"
@@ -18,6 +19,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/blocksync"
 	cs "github.com/cometbft/cometbft/internal/consensus"
@@ -146,6 +148,19 @@ func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.L
 	return proxyApp, nil
 }
 
+// checkOptimisticExecution returns an error if the application does not
+// advertise, in its Info response, that it supports optimistic execution.
+func checkOptimisticExecution(ctx context.Context, proxyApp proxy.AppConns) error {
+	res, err := proxyApp.Query().Info(ctx, proxy.InfoRequest)
+	if err != nil {
+		return fmt.Errorf("error calling Info: %v", err)
+	}
+	if !res.OptimisticExecution {
+		return ErrOptimisticExecutionUnsupported
+	}
+	return nil
+}
+
 func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	eventBus := types.NewEventBus()
 	eventBus.SetLogger(logger.With("module", "events"))
@@ -238,42 +253,74 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 }
 
 // createMempoolAndMempoolReactor creates a mempool and a mempool reactor based on the config.
+//
+// If the mempool journal is enabled, the mempool database is opened with
+// dbProvider and returned, and the transactions it contains are replayed
+// into the mempool.
 func createMempoolAndMempoolReactor(
 	config *cfg.Config,
+	dbProvider cfg.DBProvider,
 	proxyApp proxy.AppConns,
 	state sm.State,
 	waitSync bool,
+	eventBus *types.EventBus,
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
-) (mempl.Mempool, waitSyncP2PReactor) {
+) (mempl.Mempool, waitSyncP2PReactor, dbm.DB, error) {
 	switch config.Mempool.Type {
 	// allow empty string for backward compatibility
-	case cfg.MempoolTypeFlood, "":
+	case cfg.MempoolTypeFlood, cfg.MempoolTypePriority, "":
 		logger = logger.With("module", "mempool")
-		mp := mempl.NewCListMempool(
-			config.Mempool,
-			proxyApp.Mempool(),
-			state.LastBlockHeight,
+		options := []mempl.CListMempoolOption{
 			mempl.WithMetrics(memplMetrics),
 			mempl.WithPreCheck(sm.TxPreCheck(state)),
 			mempl.WithPostCheck(sm.TxPostCheck(state)),
+			mempl.WithEventBus(eventBus),
+		}
+		if len(config.Mempool.RecheckEventKeys) > 0 {
+			options = append(options, mempl.WithRecheckPolicy(mempl.NewEventRecheckPolicy(config.Mempool.RecheckEventKeys)))
+		}
+		var journalDB dbm.DB
+		if config.Mempool.Journal {
+			var err error
+			journalDB, err = dbProvider(&cfg.DBContext{ID: "mempool", Config: config})
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			options = append(options, mempl.WithJournal(journalDB))
+		}
+		var (
+			mp      mempl.Mempool
+			clistMp *mempl.CListMempool
 		)
-		mp.SetLogger(logger)
+		if config.Mempool.Type == cfg.MempoolTypePriority {
+			priorityMp := mempl.NewPriorityMempool(config.Mempool, proxyApp.Mempool(), state.LastBlockHeight, options...)
+			mp, clistMp = priorityMp, priorityMp.CListMempool
+		} else {
+			clistMp = mempl.NewCListMempool(config.Mempool, proxyApp.Mempool(), state.LastBlockHeight, options...)
+			mp = clistMp
+		}
+		clistMp.SetLogger(logger)
+		// Replay before the reactor is started, so that restored txs are
+		// gossiped like any other tx.
+		if err := clistMp.ReplayJournal(); err != nil {
+			return nil, nil, journalDB, err
+		}
 		reactor := mempl.NewReactor(
 			config.Mempool,
-			mp,
+			clistMp,
 			waitSync,
 		)
 		if config.Consensus.WaitForTxs() {
-			mp.EnableTxsAvailable()
+			clistMp.EnableTxsAvailable()
 		}
 		reactor.SetLogger(logger)
 
-		return mp, reactor
+		return mp, reactor, journalDB, nil
 	case cfg.MempoolTypeNop:
 		// Strictly speaking, there's no need to have a `mempl.NopMempoolReactor`, but
 		// adding it leads to a cleaner code.
-		return &mempl.NopMempool{}, mempl.NewNopMempoolReactor()
+		return &mempl.NopMempool{}, mempl.NewNopMempoolReactor(), nil, nil
 	default:
 		panic(fmt.Sprintf("unknown mempool type: %q", config.Mempool.Type))
 	}
@@ -345,7 +392,7 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics))
+	consensusReactor := cs.NewReactor(consensusState, waitSync, cs.ReactorMetrics(csMetrics), cs.ReactorMempool(mempool))
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -358,15 +405,16 @@ func createTransport(
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	banList *p2p.BanList,
 ) (
-	*p2p.MultiplexTransport,
+	nodeTransport,
 	[]p2p.PeerFilterFunc,
 ) {
 	var (
-		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
-		connFilters = []p2p.ConnFilterFunc{}
-		peerFilters = []p2p.PeerFilterFunc{}
+		mConnConfig  = p2p.MConnConfig(config.P2P)
+		tcpTransport = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		connFilters  = []p2p.ConnFilterFunc{banList.ConnFilter()}
+		peerFilters  = []p2p.PeerFilterFunc{}
 	)
 
 	if !config.P2P.AllowDuplicateIP {
@@ -413,19 +461,31 @@ func createTransport(
 		)
 	}
 
-	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
+	p2p.MultiplexTransportConnFilters(connFilters...)(tcpTransport)
 
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
-	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
+	p2p.MultiplexTransportMaxIncomingConnections(max)(tcpTransport)
 
-	return transport, peerFilters
+	if config.P2P.Transport != cfg.P2PTransportQUIC {
+		return tcpTransport, peerFilters
+	}
+
+	// QUIC, with TCP for the peers that do not support it. The limit of
+	// incoming connections applies to each transport.
+	quicTransport := p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig)
+	p2p.QUICTransportConnFilters(connFilters...)(quicTransport)
+	p2p.QUICTransportMaxIncomingConnections(max)(quicTransport)
+
+	return p2p.NewDualTransport(tcpTransport, quicTransport), peerFilters
 }
 
 func createSwitch(config *cfg.Config,
 	transport p2p.Transport,
 	p2pMetrics *p2p.Metrics,
 	peerFilters []p2p.PeerFilterFunc,
+	peerScores *p2p.PeerScores,
+	banList *p2p.BanList,
 	mempoolReactor p2p.Reactor,
 	bcReactor p2p.Reactor,
 	stateSyncReactor *statesync.Reactor,
@@ -440,6 +500,8 @@ func createSwitch(config *cfg.Config,
 		transport,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
+		p2p.SwitchPeerScores(peerScores),
+		p2p.SwitchBanList(banList),
 	)
 	sw.SetLogger(p2pLogger)
 	if config.Mempool.Type != cfg.MempoolTypeNop {
@@ -457,41 +519,74 @@ func createSwitch(config *cfg.Config,
 	return sw
 }
 
-func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
+// createAddrBookAndSetOnSwitch creates the address book of the configured
+// backend and sets it on the switch.
+//
+// With the "db" backend, the address book database is opened with dbProvider
+// and returned.
+func createAddrBookAndSetOnSwitch(config *cfg.Config, dbProvider cfg.DBProvider, sw *p2p.Switch,
 	p2pLogger log.Logger, nodeKey *p2p.NodeKey,
-) (pex.AddrBook, error) {
-	addrBook := pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
-	addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+) (pex.AddrBook, dbm.DB, error) {
+	var (
+		addrBook   pex.AddrBook
+		addrBookDB dbm.DB
+	)
+	if config.P2P.AddrBookBackend == cfg.AddrBookBackendDB {
+		var err error
+		addrBookDB, err = dbProvider(&cfg.DBContext{ID: "addrbook", Config: config})
+		if err != nil {
+			return nil, nil, err
+		}
+		addrBook = pex.NewDBAddrBook(addrBookDB, config.P2P.AddrBookStrict)
+		addrBook.SetLogger(p2pLogger.With("book", "addrbook.db"))
+	} else {
+		addrBook = pex.NewAddrBook(config.P2P.AddrBookFile(), config.P2P.AddrBookStrict)
+		addrBook.SetLogger(p2pLogger.With("book", config.P2P.AddrBookFile()))
+	}
 
 	// Add ourselves to addrbook to prevent dialing ourselves
 	if config.P2P.ExternalAddress != "" {
 		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ExternalAddress))
 		if err != nil {
-			return nil, fmt.Errorf("p2p.external_address is incorrect: %w", err)
+			return nil, addrBookDB, fmt.Errorf("p2p.external_address is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
 	if config.P2P.ListenAddress != "" {
 		addr, err := p2p.NewNetAddressString(p2p.IDAddressString(nodeKey.ID(), config.P2P.ListenAddress))
 		if err != nil {
-			return nil, fmt.Errorf("p2p.laddr is incorrect: %w", err)
+			return nil, addrBookDB, fmt.Errorf("p2p.laddr is incorrect: %w", err)
 		}
 		addrBook.AddOurAddress(addr)
 	}
 
 	sw.SetAddrBook(addrBook)
 
-	return addrBook, nil
+	return addrBook, addrBookDB, nil
 }
 
 func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 	sw *p2p.Switch, logger log.Logger,
-) *pex.Reactor {
+) (*pex.Reactor, error) {
+	var dnsSeedsPubKey crypto.PubKey
+	if config.P2P.DNSSeeds != "" {
+		pubKey, err := base64.StdEncoding.DecodeString(config.P2P.DNSSeedsPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dns_seeds_pub_key: %w", err)
+		}
+		if len(pubKey) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("invalid dns_seeds_pub_key: expected %d bytes, got %d", ed25519.PubKeySize, len(pubKey))
+		}
+		dnsSeedsPubKey = ed25519.PubKey(pubKey)
+	}
+
 	// TODO persistent peers ? so we can have their DNS addrs saved
 	pexReactor := pex.NewReactor(addrBook,
 		&pex.ReactorConfig{
-			Seeds:    splitAndTrimEmpty(config.P2P.Seeds, ",", " "),
-			SeedMode: config.P2P.SeedMode,
+			Seeds:          splitAndTrimEmpty(config.P2P.Seeds, ",", " "),
+			DNSSeeds:       splitAndTrimEmpty(config.P2P.DNSSeeds, ",", " "),
+			DNSSeedsPubKey: dnsSeedsPubKey,
+			SeedMode:       config.P2P.SeedMode,
 			// See consensus/reactor.go: blocksToContributeToBecomeGoodPeer 10000
 			// blocks assuming 10s blocks ~ 28 hours.
 			// TODO (melekes): make it dynamic based on the actual block latencies
@@ -502,7 +597,7 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
-	return pexReactor
+	return pexReactor, nil
 }
 
 // startStateSync starts an asynchronous state sync process, then switches to block sync mode.
@@ -0,0 +1,259 @@
+package p2p
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/internal/tempfile"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// Ban is a ban, set by the operator, of a node ID, an IP or a CIDR range.
+type Ban struct {
+	// Target is the banned node ID, IP or CIDR range.
+	Target string `json:"target"`
+	Reason string `json:"reason"`
+	// ExpiresAt is the time the ban expires at, zero if it never expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// ipNet is the banned range, nil if Target is a node ID.
+	ipNet *net.IPNet
+}
+
+// expired returns whether the ban expired at now.
+func (b *Ban) expired(now time.Time) bool {
+	return !b.ExpiresAt.IsZero() && !now.Before(b.ExpiresAt)
+}
+
+// parseBanTarget returns target in canonical form, along with the range it
+// bans, nil if target is a node ID.
+func parseBanTarget(target string) (string, *net.IPNet, error) {
+	target = strings.TrimSpace(target)
+	if ip := net.ParseIP(target); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return ip.String(), &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	if _, ipNet, err := net.ParseCIDR(target); err == nil {
+		return ipNet.String(), ipNet, nil
+	}
+	id := ID(strings.ToLower(target))
+	if err := validateID(id); err != nil {
+		return "", nil, ErrInvalidBanTarget{Target: target, Err: err}
+	}
+	return string(id), nil, nil
+}
+
+// BanList keeps the bans set by the operator, of node IDs, IPs and CIDR
+// ranges. Peers matching a ban are neither accepted nor dialed, and their
+// addresses are not gossiped.
+//
+// The bans are persisted to a file, if any, whenever they change.
+type BanList struct {
+	mtx      cmtsync.Mutex
+	bans     map[string]*Ban // by target
+	filePath string
+}
+
+// NewBanList returns a ban list persisted to filePath. If filePath is empty,
+// the bans are kept in memory only.
+func NewBanList(filePath string) *BanList {
+	return &BanList{
+		bans:     make(map[string]*Ban),
+		filePath: filePath,
+	}
+}
+
+// Ban bans target, a node ID, an IP or a CIDR range, for duration, or forever
+// if duration is zero. Banning an already banned target replaces its ban. The
+// ban is not set if it cannot be persisted.
+func (bl *BanList) Ban(target, reason string, duration time.Duration) (Ban, error) {
+	if duration < 0 {
+		return Ban{}, fmt.Errorf("negative ban duration %v", duration)
+	}
+	target, ipNet, err := parseBanTarget(target)
+	if err != nil {
+		return Ban{}, err
+	}
+
+	ban := &Ban{Target: target, Reason: reason, ipNet: ipNet}
+	if duration > 0 {
+		ban.ExpiresAt = time.Now().Add(duration).UTC()
+	}
+
+	bl.mtx.Lock()
+	prev, replaced := bl.bans[target]
+	bl.bans[target] = ban
+	bl.mtx.Unlock()
+
+	if err := bl.save(); err != nil {
+		bl.mtx.Lock()
+		if replaced {
+			bl.bans[target] = prev
+		} else {
+			delete(bl.bans, target)
+		}
+		bl.mtx.Unlock()
+		return Ban{}, err
+	}
+	return *ban, nil
+}
+
+// Unban lifts the ban of target.
+func (bl *BanList) Unban(target string) error {
+	target, _, err := parseBanTarget(target)
+	if err != nil {
+		return err
+	}
+
+	bl.mtx.Lock()
+	ban, ok := bl.bans[target]
+	if ok {
+		delete(bl.bans, target)
+	}
+	bl.mtx.Unlock()
+
+	if !ok || ban.expired(time.Now()) {
+		return ErrBanNotFound{Target: target}
+	}
+	if err := bl.save(); err != nil {
+		bl.mtx.Lock()
+		bl.bans[target] = ban
+		bl.mtx.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Bans returns the bans in effect, sorted by target.
+func (bl *BanList) Bans() []Ban {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	now := time.Now()
+	bans := make([]Ban, 0, len(bl.bans))
+	for _, ban := range bl.bans {
+		if !ban.expired(now) {
+			bans = append(bans, *ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Target < bans[j].Target })
+	return bans
+}
+
+// IDBan returns the ban of the node id, if any.
+func (bl *BanList) IDBan(id ID) (Ban, bool) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	ban, ok := bl.bans[string(id)]
+	if !ok || ban.expired(time.Now()) {
+		return Ban{}, false
+	}
+	return *ban, true
+}
+
+// IPBan returns a ban of a range including ip, if any.
+func (bl *BanList) IPBan(ip net.IP) (Ban, bool) {
+	if ip == nil {
+		return Ban{}, false
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	now := time.Now()
+	for _, ban := range bl.bans {
+		if ban.ipNet != nil && ban.ipNet.Contains(ip) && !ban.expired(now) {
+			return *ban, true
+		}
+	}
+	return Ban{}, false
+}
+
+// AddressBan returns the ban of the node ID or the IP of addr, if any.
+func (bl *BanList) AddressBan(addr *NetAddress) (Ban, bool) {
+	if ban, ok := bl.IDBan(addr.ID); ok {
+		return ban, true
+	}
+	return bl.IPBan(addr.IP)
+}
+
+// IsAddressBanned returns whether the node ID or the IP of addr is banned.
+func (bl *BanList) IsAddressBanned(addr *NetAddress) bool {
+	_, ok := bl.AddressBan(addr)
+	return ok
+}
+
+// ConnFilter returns a filter rejecting the connections from banned IPs.
+func (bl *BanList) ConnFilter() ConnFilterFunc {
+	return func(_ ConnSet, c net.Conn, ips []net.IP) error {
+		for _, ip := range ips {
+			if ban, ok := bl.IPBan(ip); ok {
+				return ErrRejected{
+					conn:       c,
+					err:        ErrIPBanned{IP: ip, Reason: ban.Reason},
+					isFiltered: true,
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// save writes the bans in effect to the file.
+func (bl *BanList) save() error {
+	if bl.filePath == "" {
+		return nil
+	}
+
+	jsonBytes, err := json.MarshalIndent(bl.Bans(), "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return tempfile.WriteFileAtomic(bl.filePath, jsonBytes, 0o644)
+}
+
+// Load reads the bans from the file, if it exists.
+func (bl *BanList) Load() error {
+	if bl.filePath == "" {
+		return nil
+	}
+
+	jsonBytes, err := os.ReadFile(bl.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var bans []*Ban
+	if err := json.Unmarshal(jsonBytes, &bans); err != nil {
+		return fmt.Errorf("error reading file %s: %w", bl.filePath, err)
+	}
+
+	byTarget := make(map[string]*Ban, len(bans))
+	for _, ban := range bans {
+		target, ipNet, err := parseBanTarget(ban.Target)
+		if err != nil {
+			return fmt.Errorf("error reading file %s: %w", bl.filePath, err)
+		}
+		ban.Target, ban.ipNet = target, ipNet
+		byTarget[target] = ban
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	bl.bans = byTarget
+	return nil
+}
@@ -0,0 +1,128 @@
+package p2p
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBanTarget(t *testing.T) {
+	testCases := []struct {
+		target    string
+		canonical string
+		isRange   bool
+		isErr     bool
+	}{
+		{"DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", false, false},
+		{"1.2.3.4", "1.2.3.4", true, false},
+		{" ::ffff:1.2.3.4 ", "1.2.3.4", true, false},
+		{"2001:db8::1", "2001:db8::1", true, false},
+		{"10.1.2.3/8", "10.0.0.0/8", true, false},
+		{"2001:db8::/32", "2001:db8::/32", true, false},
+		{"", "", false, true},
+		{"deadbeef", "", false, true},
+		{"1.2.3.4:26656", "", false, true},
+		{"10.0.0.0/33", "", false, true},
+	}
+
+	for _, tc := range testCases {
+		canonical, ipNet, err := parseBanTarget(tc.target)
+		if tc.isErr {
+			require.Error(t, err, tc.target)
+			assert.ErrorAs(t, err, &ErrInvalidBanTarget{})
+			continue
+		}
+		require.NoError(t, err, tc.target)
+		assert.Equal(t, tc.canonical, canonical)
+		assert.Equal(t, tc.isRange, ipNet != nil)
+	}
+}
+
+func TestBanList(t *testing.T) {
+	bl := NewBanList("")
+	var (
+		id      = ID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+		otherID = ID("baadf00dbaadf00dbaadf00dbaadf00dbaadf00d")
+	)
+
+	_, err := bl.Ban(string(id), "spam", 0)
+	require.NoError(t, err)
+	_, err = bl.Ban("10.0.0.0/8", "flood", time.Hour)
+	require.NoError(t, err)
+	_, err = bl.Ban("1.2.3.4", "expired", time.Nanosecond)
+	require.NoError(t, err)
+	_, err = bl.Ban("1.2.3.5", "negative", -time.Hour)
+	require.Error(t, err)
+
+	time.Sleep(time.Millisecond)
+	bans := bl.Bans()
+	require.Len(t, bans, 2)
+	assert.Equal(t, "10.0.0.0/8", bans[0].Target)
+	assert.False(t, bans[0].ExpiresAt.IsZero())
+	assert.Equal(t, string(id), bans[1].Target)
+	assert.True(t, bans[1].ExpiresAt.IsZero())
+
+	ban, ok := bl.IDBan(id)
+	require.True(t, ok)
+	assert.Equal(t, "spam", ban.Reason)
+	_, ok = bl.IDBan(otherID)
+	assert.False(t, ok)
+
+	ban, ok = bl.IPBan(net.ParseIP("10.20.30.40"))
+	require.True(t, ok)
+	assert.Equal(t, "flood", ban.Reason)
+	_, ok = bl.IPBan(net.ParseIP("1.2.3.4"))
+	assert.False(t, ok, "expired ban")
+
+	assert.True(t, bl.IsAddressBanned(&NetAddress{ID: id, IP: net.ParseIP("8.8.8.8"), Port: 26656}))
+	assert.True(t, bl.IsAddressBanned(&NetAddress{ID: otherID, IP: net.ParseIP("10.0.0.1"), Port: 26656}))
+	assert.False(t, bl.IsAddressBanned(&NetAddress{ID: otherID, IP: net.ParseIP("8.8.8.8"), Port: 26656}))
+
+	require.NoError(t, bl.Unban("10.0.0.0/8"))
+	_, ok = bl.IPBan(net.ParseIP("10.20.30.40"))
+	assert.False(t, ok)
+	assert.ErrorAs(t, bl.Unban("10.0.0.0/8"), &ErrBanNotFound{})
+	assert.ErrorAs(t, bl.Unban("1.2.3.4"), &ErrBanNotFound{})
+}
+
+func TestBanListConnFilter(t *testing.T) {
+	bl := NewBanList("")
+	_, err := bl.Ban("127.0.0.0/8", "local", 0)
+	require.NoError(t, err)
+
+	filter := bl.ConnFilter()
+	require.NoError(t, filter(NewConnSet(), nil, []net.IP{net.ParseIP("8.8.8.8")}))
+
+	err = filter(NewConnSet(), nil, []net.IP{net.ParseIP("127.0.0.1")})
+	var e ErrRejected
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.IsFiltered())
+	assert.ErrorAs(t, e.err, &ErrIPBanned{})
+}
+
+func TestBanListSaveLoad(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "ban_list.json")
+	id := ID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+
+	bl := NewBanList(filePath)
+	require.NoError(t, bl.Load()) // no file yet
+	_, err := bl.Ban(string(id), "spam", 0)
+	require.NoError(t, err)
+	_, err = bl.Ban("10.0.0.0/8", "flood", time.Hour)
+	require.NoError(t, err)
+	_, err = bl.Ban("1.2.3.4", "lifted", 0)
+	require.NoError(t, err)
+	require.NoError(t, bl.Unban("1.2.3.4"))
+
+	loaded := NewBanList(filePath)
+	require.NoError(t, loaded.Load())
+	assert.Equal(t, bl.Bans(), loaded.Bans())
+	_, ok := loaded.IPBan(net.ParseIP("10.1.1.1"))
+	assert.True(t, ok)
+	_, ok = loaded.IDBan(id)
+	assert.True(t, ok)
+}
@@ -0,0 +1,448 @@
+package conn
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	flow "github.com/cometbft/cometbft/internal/flowrate"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// QUICErrorCodeNoError is the QUIC application error code with which a
+// QUICConnection is closed.
+const QUICErrorCodeNoError quic.ApplicationErrorCode = 0
+
+/*
+QUICConnection sends and receives the messages of multiple channels over a QUIC
+connection, as MConnection does over a single TCP connection.
+
+Each side sends the messages of each channel on its own unidirectional QUIC
+stream, opened upon the first message of the channel and starting with the
+channel ID. The messages are prefixed with their length as a uvarint. Thus, a
+message of a channel is not delayed by the messages of the other channels, as
+they are when multiplexed on a TCP connection.
+
+The messages of a channel are received in order, but the messages of distinct
+channels may be received concurrently.
+
+Keep-alives and idle timeouts are handled by QUIC, hence there are no pings.
+*/
+type QUICConnection struct {
+	service.BaseService
+
+	conn        quic.Connection
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	channels    []*quicChannel
+	channelsIdx map[byte]*quicChannel
+	onReceive   receiveCbFunc
+	onError     errorCbFunc
+	errored     uint32
+	config      MConnConfig
+
+	// closed by stopServices; the send routines then return, after sending
+	// the messages queued if flush is closed too.
+	stopMtx cmtsync.Mutex
+	quit    chan struct{}
+	flush   chan struct{}
+	sendWg  sync.WaitGroup
+
+	created time.Time // time of creation
+}
+
+// NewQUICConnection wraps a QUIC connection to send and receive the messages
+// of the channels described by chDescs.
+func NewQUICConnection(
+	conn quic.Connection,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) *QUICConnection {
+	qc := &QUICConnection{
+		conn:        conn,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		channelsIdx: make(map[byte]*quicChannel),
+		onReceive:   onReceive,
+		onError:     onError,
+		config:      config,
+		created:     time.Now(),
+	}
+	for _, desc := range chDescs {
+		ch := &quicChannel{
+			conn:      qc,
+			desc:      desc.FillDefaults(),
+			sendQueue: make(chan []byte, desc.FillDefaults().SendQueueCapacity),
+		}
+		qc.channels = append(qc.channels, ch)
+		qc.channelsIdx[ch.desc.ID] = ch
+	}
+	qc.BaseService = *service.NewBaseService(nil, "QUICConnection", qc)
+	return qc
+}
+
+// SetLogger implements BaseService.
+func (c *QUICConnection) SetLogger(l log.Logger) {
+	c.BaseService.SetLogger(l)
+	for _, ch := range c.channels {
+		ch.Logger = l
+	}
+}
+
+// OnStart implements BaseService.
+func (c *QUICConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	c.quit = make(chan struct{})
+	c.flush = make(chan struct{})
+	for _, ch := range c.channels {
+		c.sendWg.Add(1)
+		go ch.sendRoutine()
+	}
+	go c.acceptStreams()
+	return nil
+}
+
+// stopServices stops the BaseService and the send routines. It returns true
+// if they were already stopped.
+func (c *QUICConnection) stopServices(flush bool) (alreadyStopped bool) {
+	c.stopMtx.Lock()
+	defer c.stopMtx.Unlock()
+
+	select {
+	case <-c.quit:
+		return true
+	default:
+	}
+
+	c.BaseService.OnStop()
+	if flush {
+		close(c.flush)
+	}
+	close(c.quit)
+	return false
+}
+
+// FlushStop stops the connection once all the messages successfully queued
+// by Send are sent.
+func (c *QUICConnection) FlushStop() {
+	if c.stopServices(true) {
+		return
+	}
+	c.sendWg.Wait()
+	_ = c.conn.CloseWithError(QUICErrorCodeNoError, "")
+}
+
+// OnStop implements BaseService.
+func (c *QUICConnection) OnStop() {
+	if c.stopServices(false) {
+		return
+	}
+	_ = c.conn.CloseWithError(QUICErrorCodeNoError, "")
+}
+
+func (c *QUICConnection) String() string {
+	return fmt.Sprintf("QUICConn{%v}", c.conn.RemoteAddr())
+}
+
+// Catch panics, usually caused by remote disconnects or by the reactors.
+func (c *QUICConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("QUICConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *QUICConnection) stopForError(r any) {
+	if err := c.Stop(); err != nil && !errors.Is(err, service.ErrAlreadyStopped) {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if atomic.CompareAndSwapUint32(&c.errored, 0, 1) {
+		if c.onError != nil {
+			c.onError(r)
+		}
+	}
+}
+
+// stopping returns whether the connection is being stopped, in which case the
+// errors of the streams are expected.
+func (c *QUICConnection) stopping() bool {
+	select {
+	case <-c.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// Send queues a message to be sent on a channel. It blocks until the message
+// is queued, or the queue is still full after a timeout.
+func (c *QUICConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		atomic.AddInt32(&ch.sendQueueSize, 1)
+		return true
+	case <-time.After(defaultSendTimeout):
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c)
+		return false
+	case <-c.quit:
+		return false
+	}
+}
+
+// TrySend queues a message to be sent on a channel. It returns false
+// immediately if the queue of the channel is full.
+func (c *QUICConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		atomic.AddInt32(&ch.sendQueueSize, 1)
+		return true
+	default:
+		return false
+	}
+}
+
+// CanSend returns true if you can send more data onto the chID, false
+// otherwise.
+func (c *QUICConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return atomic.LoadInt32(&ch.sendQueueSize) < int32(ch.desc.SendQueueCapacity)
+}
+
+// Status returns the status of the connection and of its channels.
+func (c *QUICConnection) Status() ConnectionStatus {
+	status := ConnectionStatus{
+		Duration:    time.Since(c.created),
+		SendMonitor: c.sendMonitor.Status(),
+		RecvMonitor: c.recvMonitor.Status(),
+		Channels:    make([]ChannelStatus, len(c.channels)),
+	}
+	for i, ch := range c.channels {
+		status.Channels[i] = ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&ch.sendQueueSize)),
+			Priority:          ch.desc.Priority,
+			RecentlySent:      atomic.LoadInt64(&ch.recentlySent),
+		}
+	}
+	return status
+}
+
+// acceptStreams accepts the streams of the channels opened by the peer, and
+// receives their messages.
+func (c *QUICConnection) acceptStreams() {
+	defer c._recover()
+
+	for {
+		stream, err := c.conn.AcceptUniStream(context.Background())
+		if err != nil {
+			if !c.stopping() && c.IsRunning() {
+				c.Logger.Info("Connection is closed @ acceptStreams", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		go c.recvRoutine(stream)
+	}
+}
+
+// recvRoutine receives the messages of the channel of stream.
+func (c *QUICConnection) recvRoutine(stream quic.ReceiveStream) {
+	defer c._recover()
+
+	r := bufio.NewReaderSize(stream, minReadBufferSize)
+	chID, err := r.ReadByte()
+	if err != nil {
+		c.recvFailed(err)
+		return
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			c.recvFailed(err)
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) {
+			c.stopForError(fmt.Errorf("received message exceeds available capacity: %v < %v",
+				ch.desc.RecvMessageCapacity, size))
+			return
+		}
+
+		msgBytes := make([]byte, size)
+		for read := 0; read < len(msgBytes); {
+			n := c.recvMonitor.Limit(len(msgBytes)-read, atomic.LoadInt64(&c.config.RecvRate), true)
+			n, err = io.ReadFull(r, msgBytes[read:read+n])
+			c.recvMonitor.Update(n)
+			if err != nil {
+				c.recvFailed(err)
+				return
+			}
+			read += n
+		}
+
+		c.Logger.Debug("Received bytes", "chID", chID, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+		c.onReceive(chID, msgBytes)
+	}
+}
+
+func (c *QUICConnection) recvFailed(err error) {
+	if c.stopping() || !c.IsRunning() {
+		return
+	}
+	if errors.Is(err, io.EOF) {
+		c.Logger.Info("Stream is closed @ recvRoutine (likely by the other side)", "conn", c)
+	} else {
+		c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+	}
+	c.stopForError(err)
+}
+
+// -----------------------------------------------------------------------------
+
+// quicChannel is a channel of a QUICConnection, whose messages are sent on a
+// stream of their own.
+type quicChannel struct {
+	conn          *QUICConnection
+	desc          ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize int32 // atomic.
+	recentlySent  int64 // exponential moving average
+
+	Logger log.Logger
+}
+
+// sendRoutine sends the messages queued on the channel, opening its stream
+// upon the first message.
+func (ch *quicChannel) sendRoutine() {
+	c := ch.conn
+	defer c.sendWg.Done()
+	defer c._recover()
+
+	var (
+		stream quic.SendStream
+		w      *bufio.Writer
+	)
+	for {
+		var msgBytes []byte
+		select {
+		case msgBytes = <-ch.sendQueue:
+		case <-c.quit:
+			select {
+			case <-c.flush:
+			default:
+				return
+			}
+			// Send the messages still queued before returning.
+			select {
+			case msgBytes = <-ch.sendQueue:
+			default:
+				if stream != nil {
+					_ = stream.Close()
+				}
+				return
+			}
+		}
+		atomic.AddInt32(&ch.sendQueueSize, -1)
+
+		if stream == nil {
+			var err error
+			stream, err = c.conn.OpenUniStreamSync(context.Background())
+			if err != nil {
+				ch.sendFailed(err)
+				return
+			}
+			w = bufio.NewWriterSize(stream, minWriteBufferSize)
+			if err := w.WriteByte(ch.desc.ID); err != nil {
+				ch.sendFailed(err)
+				return
+			}
+		}
+
+		if err := ch.writeMsg(w, msgBytes); err != nil {
+			ch.sendFailed(err)
+			return
+		}
+		// Flush unless more messages are queued, to batch them.
+		if len(ch.sendQueue) == 0 {
+			if err := w.Flush(); err != nil {
+				ch.sendFailed(err)
+				return
+			}
+		}
+	}
+}
+
+// writeMsg writes msgBytes, prefixed with its length, within the send rate.
+func (ch *quicChannel) writeMsg(w *bufio.Writer, msgBytes []byte) error {
+	c := ch.conn
+	n, err := w.Write(binary.AppendUvarint(nil, uint64(len(msgBytes))))
+	c.sendMonitor.Update(n)
+	if err != nil {
+		return err
+	}
+	for written := 0; written < len(msgBytes); {
+		n := c.sendMonitor.Limit(len(msgBytes)-written, c.config.SendRate, true)
+		n, err := w.Write(msgBytes[written : written+n])
+		c.sendMonitor.Update(n)
+		if err != nil {
+			return err
+		}
+		written += n
+	}
+	atomic.AddInt64(&ch.recentlySent, int64(len(msgBytes)))
+	ch.Logger.Debug("Sent bytes", "chID", ch.desc.ID, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+	return nil
+}
+
+func (ch *quicChannel) sendFailed(err error) {
+	c := ch.conn
+	if c.stopping() {
+		return
+	}
+	c.Logger.Debug("Connection failed @ sendRoutine", "conn", c, "err", err)
+	c.stopForError(err)
+}
@@ -155,6 +155,51 @@ func (ErrTransportClosed) Error() string {
 	return "transport has been closed"
 }
 
+// ErrPeerBanned is raised when a peer is banned.
+type ErrPeerBanned struct {
+	ID     ID
+	Reason string
+}
+
+func (e ErrPeerBanned) Error() string {
+	return fmt.Sprintf("peer %v is banned: %s", e.ID, e.Reason)
+}
+
+// ErrIPBanned is raised when a connection comes from a banned IP.
+type ErrIPBanned struct {
+	IP     net.IP
+	Reason string
+}
+
+func (e ErrIPBanned) Error() string {
+	return fmt.Sprintf("ip %v is banned: %s", e.IP, e.Reason)
+}
+
+// ErrInvalidBanTarget is raised when the target of a ban is neither a node
+// ID, an IP nor a CIDR range.
+type ErrInvalidBanTarget struct {
+	Target string
+	Err    error
+}
+
+func (e ErrInvalidBanTarget) Error() string {
+	return fmt.Sprintf("invalid ban target %q: not a node ID, an IP or a CIDR range: %v", e.Target, e.Err)
+}
+
+func (e ErrInvalidBanTarget) Unwrap() error {
+	return e.Err
+}
+
+// ErrBanNotFound is raised when lifting the ban of a target that is not
+// banned.
+type ErrBanNotFound struct {
+	Target string
+}
+
+func (e ErrBanNotFound) Error() string {
+	return fmt.Sprintf("%s is not banned", e.Target)
+}
+
 // ErrPeerRemoval is raised when attempting to remove a peer results in an error.
 type ErrPeerRemoval struct{}
 
@@ -250,6 +295,31 @@ func (e ErrChannelsTooLong) Error() string {
 	return fmt.Sprintf("channels is too long (max: %d, got: %d)", e.Max, e.Length)
 }
 
+type ErrTransportsTooLong struct {
+	Length int
+	Max    int
+}
+
+func (e ErrTransportsTooLong) Error() string {
+	return fmt.Sprintf("transports is too long (max: %d, got: %d)", e.Max, e.Length)
+}
+
+type ErrInvalidTransport struct {
+	Transport string
+}
+
+func (e ErrInvalidTransport) Error() string {
+	return fmt.Sprintf("unknown transport %q", e.Transport)
+}
+
+type ErrDuplicateTransport struct {
+	Transport string
+}
+
+func (e ErrDuplicateTransport) Error() string {
+	return fmt.Sprintf("transports contains duplicate transport %q", e.Transport)
+}
+
 type ErrInvalidMoniker struct {
 	Moniker string
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"reflect"
+	"slices"
 
 	tmp2p "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
 	cmtstrings "github.com/cometbft/cometbft/internal/strings"
@@ -12,8 +13,16 @@ import (
 )
 
 const (
-	maxNodeInfoSize = 10240 // 10KB
-	maxNumChannels  = 16    // plenty of room for upgrades, for now
+	maxNodeInfoSize  = 10240 // 10KB
+	maxNumChannels   = 16    // plenty of room for upgrades, for now
+	maxNumTransports = 4
+)
+
+// Transports a node accepts connections over, as advertised in its
+// DefaultNodeInfo.
+const (
+	TransportTCP  = "tcp"
+	TransportQUIC = "quic"
 )
 
 // Max size of the NodeInfo struct.
@@ -92,6 +101,10 @@ type DefaultNodeInfo struct {
 	// ASCIIText fields
 	Moniker string               `json:"moniker"` // arbitrary moniker
 	Other   DefaultNodeInfoOther `json:"other"`   // other application specific data
+
+	// Transports the node accepts connections over. Empty for nodes that only
+	// support TCP.
+	Transports []string `json:"transports,omitempty"`
 }
 
 // DefaultNodeInfoOther is the misc. application specific data.
@@ -149,6 +162,23 @@ func (info DefaultNodeInfo) Validate() error {
 		channels[ch] = struct{}{}
 	}
 
+	// Validate Transports - ensure max and check for unknown and duplicates.
+	if len(info.Transports) > maxNumTransports {
+		return ErrTransportsTooLong{Length: len(info.Transports), Max: maxNumTransports}
+	}
+	transports := make(map[string]struct{})
+	for _, transport := range info.Transports {
+		switch transport {
+		case TransportTCP, TransportQUIC:
+		default:
+			return ErrInvalidTransport{Transport: transport}
+		}
+		if _, ok := transports[transport]; ok {
+			return ErrDuplicateTransport{Transport: transport}
+		}
+		transports[transport] = struct{}{}
+	}
+
 	// Validate Moniker.
 	if !cmtstrings.IsASCIIText(info.Moniker) || cmtstrings.ASCIITrim(info.Moniker) == "" {
 		return ErrInvalidMoniker{Moniker: info.Moniker}
@@ -236,6 +266,15 @@ func (info DefaultNodeInfo) HasChannel(chID byte) bool {
 	return bytes.Contains(info.Channels, []byte{chID})
 }
 
+// SupportsTransport returns whether the node accepts connections over
+// transport. Nodes that advertise no transport only support TCP.
+func (info DefaultNodeInfo) SupportsTransport(transport string) bool {
+	if len(info.Transports) == 0 {
+		return transport == TransportTCP
+	}
+	return slices.Contains(info.Transports, transport)
+}
+
 func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 	dni := new(tmp2p.DefaultNodeInfo)
 	dni.ProtocolVersion = tmp2p.ProtocolVersion{
@@ -254,6 +293,7 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 		TxIndex:    info.Other.TxIndex,
 		RPCAddress: info.Other.RPCAddress,
 	}
+	dni.Transports = info.Transports
 
 	return dni
 }
@@ -279,6 +319,7 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 			TxIndex:    pb.Other.TxIndex,
 			RPCAddress: pb.Other.RPCAddress,
 		},
+		Transports: pb.Transports,
 	}
 
 	return dni, nil
@@ -39,6 +39,10 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Duplicate Channel", func(ni *DefaultNodeInfo) { ni.Channels = dupChannels }, true},
 		{"Good Channels", func(ni *DefaultNodeInfo) { ni.Channels = ni.Channels[:5] }, false},
 
+		{"Unknown Transport", func(ni *DefaultNodeInfo) { ni.Transports = []string{"udp"} }, true},
+		{"Duplicate Transport", func(ni *DefaultNodeInfo) { ni.Transports = []string{TransportQUIC, TransportQUIC} }, true},
+		{"Good Transports", func(ni *DefaultNodeInfo) { ni.Transports = []string{TransportTCP, TransportQUIC} }, false},
+
 		{"Invalid NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "not-an-address" }, true},
 		{"Good NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "0.0.0.0:26656" }, false},
 
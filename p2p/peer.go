@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/quic-go/quic-go"
 
 	"github.com/cometbft/cometbft/internal/cmap"
 	"github.com/cometbft/cometbft/libs/log"
@@ -101,6 +102,24 @@ func (pc peerConn) RemoteIP() net.IP {
 	return pc.ip
 }
 
+// mConnection multiplexes the channels of a peer over its connection: an
+// MConnection over TCP, or a QUICConnection.
+type mConnection interface {
+	service.Service
+	FlushStop()
+
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+
+	Status() cmtconn.ConnectionStatus
+}
+
+var (
+	_ mConnection = (*cmtconn.MConnection)(nil)
+	_ mConnection = (*cmtconn.QUICConnection)(nil)
+)
+
 // peer implements Peer.
 //
 // Before using a peer, you will need to perform a handshake on connection.
@@ -109,7 +128,7 @@ type peer struct {
 
 	// raw peerConn and the multiplex connection
 	peerConn
-	mconn *cmtconn.MConnection
+	mconn mConnection
 
 	// peer's node info and the channel it knows about
 	// channels = nodeInfo.Channels
@@ -166,6 +185,44 @@ func newPeer(
 	return p
 }
 
+// newQUICPeer returns a peer whose channels are multiplexed over the streams
+// of the QUIC connection qc. The connection of pc is the control stream of qc.
+func newQUICPeer(
+	pc peerConn,
+	qc quic.Connection,
+	mConfig cmtconn.MConnConfig,
+	nodeInfo NodeInfo,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, any),
+	mlc *metricsLabelCache,
+	options ...PeerOption,
+) *peer {
+	p := &peer{
+		peerConn: pc,
+		nodeInfo: nodeInfo,
+		channels: nodeInfo.(DefaultNodeInfo).Channels,
+		Data:     cmap.NewCMap(),
+		metrics:  NopMetrics(),
+		mlc:      mlc,
+	}
+
+	p.mconn = cmtconn.NewQUICConnection(
+		qc,
+		chDescs,
+		receiveFunc(p, reactorsByCh, msgTypeByChID),
+		func(r any) { onPeerError(p, r) },
+		mConfig,
+	)
+	p.BaseService = *service.NewBaseService(nil, "Peer", p)
+	for _, option := range options {
+		option(p)
+	}
+
+	return p
+}
+
 // String representation.
 func (p *peer) String() string {
 	if p.outbound {
@@ -398,7 +455,27 @@ func createMConnection(
 	onPeerError func(Peer, any),
 	config cmtconn.MConnConfig,
 ) *cmtconn.MConnection {
-	onReceive := func(chID byte, msgBytes []byte) {
+	onError := func(r any) {
+		onPeerError(p, r)
+	}
+
+	return cmtconn.NewMConnectionWithConfig(
+		conn,
+		chDescs,
+		receiveFunc(p, reactorsByCh, msgTypeByChID),
+		onError,
+		config,
+	)
+}
+
+// receiveFunc returns the callback passing the messages received from p to
+// the reactors of their channels.
+func receiveFunc(
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+) func(chID byte, msgBytes []byte) {
+	return func(chID byte, msgBytes []byte) {
 		reactor := reactorsByCh[chID]
 		if reactor == nil {
 			// Note that its ok to panic here as it's caught in the conn._recover,
@@ -426,16 +503,4 @@ func createMConnection(
 			Message:   msg,
 		})
 	}
-
-	onError := func(r any) {
-		onPeerError(p, r)
-	}
-
-	return cmtconn.NewMConnectionWithConfig(
-		conn,
-		chDescs,
-		onReceive,
-		onError,
-		config,
-	)
 }
@@ -0,0 +1,208 @@
+package p2p
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/cometbft/cometbft/internal/tempfile"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+const (
+	// scores decay towards zero, halving every scoreHalfLife.
+	scoreHalfLife = time.Hour
+
+	// scores are capped, so that a peer cannot bank unlimited credit, and
+	// floored, so that a banned peer recovers within a couple of half-lives.
+	maxPeerScore = 100.0
+	minPeerScore = 2 * banScoreThreshold
+
+	// peers whose score drops below banScoreThreshold are banned for
+	// peerBanDuration.
+	banScoreThreshold = -100.0
+	peerBanDuration   = time.Hour
+
+	// scores whose magnitude decayed below negligibleScore are forgotten.
+	negligibleScore = 0.01
+
+	// scores are saved to disk every savePeerScoresInterval.
+	savePeerScoresInterval = 2 * time.Minute
+)
+
+// PeerEvent is an event, reported by a reactor, that changes the score of a
+// peer.
+type PeerEvent int
+
+const (
+	// PeerEventBlockPart is a useful block part.
+	PeerEventBlockPart PeerEvent = iota + 1
+	// PeerEventVote is a valid vote.
+	PeerEventVote
+	// PeerEventTx is a new transaction, admitted into the mempool.
+	PeerEventTx
+	// PeerEventInvalidMessage is an invalid message.
+	PeerEventInvalidMessage
+	// PeerEventTimeout is a request the peer did not respond to in time.
+	PeerEventTimeout
+	// PeerEventDuplicate is a request sent too often.
+	PeerEventDuplicate
+)
+
+// peerEventWeights are the changes of score of the peer events.
+var peerEventWeights = map[PeerEvent]float64{
+	PeerEventBlockPart:      0.5,
+	PeerEventVote:           0.1,
+	PeerEventTx:             0.1,
+	PeerEventInvalidMessage: -50,
+	PeerEventTimeout:        -10,
+	PeerEventDuplicate:      -0.05,
+}
+
+func (e PeerEvent) String() string {
+	switch e {
+	case PeerEventBlockPart:
+		return "block_part"
+	case PeerEventVote:
+		return "vote"
+	case PeerEventTx:
+		return "tx"
+	case PeerEventInvalidMessage:
+		return "invalid_message"
+	case PeerEventTimeout:
+		return "timeout"
+	case PeerEventDuplicate:
+		return "duplicate"
+	default:
+		return fmt.Sprintf("PeerEvent(%d)", int(e))
+	}
+}
+
+// peerScore is the score of a peer, as of UpdatedAt.
+type peerScore struct {
+	Score       float64   `json:"score"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	BannedUntil time.Time `json:"banned_until,omitempty"`
+}
+
+// decay decays the score to now.
+func (s *peerScore) decay(now time.Time) {
+	if elapsed := now.Sub(s.UpdatedAt); elapsed > 0 {
+		s.Score *= math.Pow(0.5, float64(elapsed)/float64(scoreHalfLife))
+		s.UpdatedAt = now
+	}
+}
+
+// PeerScores keeps the scores of peers, from the events reported by the
+// reactors. Scores decay towards zero over time, and peers whose score drops
+// below banScoreThreshold are banned for peerBanDuration.
+//
+// Scores are persisted to a file, if any, so that they survive restarts.
+type PeerScores struct {
+	mtx      cmtsync.Mutex
+	scores   map[ID]*peerScore
+	filePath string
+}
+
+// NewPeerScores returns peer scores persisted to filePath. If filePath is
+// empty, the scores are kept in memory only.
+func NewPeerScores(filePath string) *PeerScores {
+	return &PeerScores{
+		scores:   make(map[ID]*peerScore),
+		filePath: filePath,
+	}
+}
+
+// Report changes the score of the peer id by the weight of event. It returns
+// true if the peer got banned as a result.
+func (ps *PeerScores) Report(id ID, event PeerEvent) (banned bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	now := time.Now()
+	s, ok := ps.scores[id]
+	if !ok {
+		s = &peerScore{UpdatedAt: now}
+		ps.scores[id] = s
+	}
+	s.decay(now)
+	s.Score = math.Max(math.Min(s.Score+peerEventWeights[event], maxPeerScore), minPeerScore)
+
+	if s.Score < banScoreThreshold && !now.Before(s.BannedUntil) {
+		s.BannedUntil = now.Add(peerBanDuration)
+		return true
+	}
+	return false
+}
+
+// Score returns the current score of the peer id, zero if unknown.
+func (ps *PeerScores) Score(id ID) float64 {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	s, ok := ps.scores[id]
+	if !ok {
+		return 0
+	}
+	s.decay(time.Now())
+	return s.Score
+}
+
+// IsBanned returns whether the peer id is banned.
+func (ps *PeerScores) IsBanned(id ID) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	s, ok := ps.scores[id]
+	return ok && time.Now().Before(s.BannedUntil)
+}
+
+// Save writes the scores to the file, forgetting the negligible ones.
+func (ps *PeerScores) Save() error {
+	if ps.filePath == "" {
+		return nil
+	}
+
+	ps.mtx.Lock()
+	now := time.Now()
+	for id, s := range ps.scores {
+		s.decay(now)
+		if math.Abs(s.Score) < negligibleScore && !now.Before(s.BannedUntil) {
+			delete(ps.scores, id)
+		}
+	}
+	jsonBytes, err := json.MarshalIndent(ps.scores, "", "\t")
+	ps.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return tempfile.WriteFileAtomic(ps.filePath, jsonBytes, 0o644)
+}
+
+// Load reads the scores from the file, if it exists.
+func (ps *PeerScores) Load() error {
+	if ps.filePath == "" {
+		return nil
+	}
+
+	jsonBytes, err := os.ReadFile(ps.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	scores := make(map[ID]*peerScore)
+	if err := json.Unmarshal(jsonBytes, &scores); err != nil {
+		return fmt.Errorf("error reading file %s: %w", ps.filePath, err)
+	}
+
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ps.scores = scores
+	return nil
+}
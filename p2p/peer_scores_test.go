@@ -0,0 +1,88 @@
+package p2p
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScoresReport(t *testing.T) {
+	ps := NewPeerScores("")
+	id := ID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+
+	assert.Zero(t, ps.Score(id))
+	assert.False(t, ps.Report(id, PeerEventBlockPart))
+	assert.InDelta(t, peerEventWeights[PeerEventBlockPart], ps.Score(id), 1e-3)
+
+	// Positive scores are capped.
+	for i := 0; i < 10000; i++ {
+		ps.Report(id, PeerEventVote)
+	}
+	assert.InDelta(t, maxPeerScore, ps.Score(id), 1e-3)
+
+	// The peer is banned once its score drops below the threshold, and the ban
+	// is reported only once.
+	var bans int
+	for ps.Score(id) >= banScoreThreshold {
+		assert.False(t, ps.IsBanned(id))
+		if ps.Report(id, PeerEventInvalidMessage) {
+			bans++
+		}
+	}
+	assert.False(t, ps.Report(id, PeerEventInvalidMessage))
+	assert.Equal(t, 1, bans)
+	assert.True(t, ps.IsBanned(id))
+
+	// Negative scores are floored.
+	for i := 0; i < 1000; i++ {
+		ps.Report(id, PeerEventInvalidMessage)
+	}
+	assert.InDelta(t, minPeerScore, ps.Score(id), 1e-3)
+}
+
+func TestPeerScoresDecay(t *testing.T) {
+	ps := NewPeerScores("")
+	id := ID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+
+	for i := 0; i < 3; i++ {
+		ps.Report(id, PeerEventInvalidMessage)
+	}
+	require.True(t, ps.IsBanned(id))
+	score := ps.Score(id)
+
+	// Simulate the passing of one half-life, and of the ban.
+	ps.scores[id].UpdatedAt = ps.scores[id].UpdatedAt.Add(-scoreHalfLife)
+	ps.scores[id].BannedUntil = time.Now().Add(-time.Second)
+
+	assert.InDelta(t, score/2, ps.Score(id), 1e-3)
+	assert.False(t, ps.IsBanned(id))
+}
+
+func TestPeerScoresSaveLoad(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "peer_scores.json")
+	var (
+		good       = ID("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+		bad        = ID("baadf00dbaadf00dbaadf00dbaadf00dbaadf00d")
+		negligible = ID("0123456789abcdef0123456789abcdef01234567")
+	)
+
+	ps := NewPeerScores(filePath)
+	require.NoError(t, ps.Load()) // no file yet
+	ps.Report(good, PeerEventBlockPart)
+	for i := 0; i < 3; i++ {
+		ps.Report(bad, PeerEventInvalidMessage)
+	}
+	ps.Report(negligible, PeerEventTx)
+	ps.scores[negligible].UpdatedAt = ps.scores[negligible].UpdatedAt.Add(-10 * scoreHalfLife)
+	require.NoError(t, ps.Save())
+
+	loaded := NewPeerScores(filePath)
+	require.NoError(t, loaded.Load())
+	assert.InDelta(t, ps.Score(good), loaded.Score(good), 1e-3)
+	assert.InDelta(t, ps.Score(bad), loaded.Score(bad), 1e-3)
+	assert.True(t, loaded.IsBanned(bad))
+	assert.NotContains(t, loaded.scores, negligible)
+}
@@ -44,6 +44,10 @@ type AddrBook interface {
 
 	AddPrivateIDs(ids []string)
 
+	// Set the bans of the operator, whose addresses are neither added,
+	// picked nor selected
+	SetBanList(banList *p2p.BanList)
+
 	// Add and remove an address
 	AddAddress(addr *p2p.NetAddress, src *p2p.NetAddress) error
 	RemoveAddress(addr *p2p.NetAddress)
@@ -95,6 +99,7 @@ type addrBook struct {
 	privateIDs map[p2p.ID]struct{}
 	addrLookup map[p2p.ID]*knownAddress // new & old
 	badPeers   map[p2p.ID]*knownAddress // blacklisted peers
+	banList    *p2p.BanList             // bans of the operator, if any
 	bucketsOld []map[string]*knownAddress
 	bucketsNew []map[string]*knownAddress
 	nOld       int
@@ -206,6 +211,14 @@ func (a *addrBook) AddPrivateIDs(ids []string) {
 	}
 }
 
+// SetBanList implements AddrBook.
+func (a *addrBook) SetBanList(banList *p2p.BanList) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.banList = banList
+}
+
 // AddAddress implements AddrBook
 // Add address to a "new" bucket. If it's already in one, only add it probabilistically.
 // Returns error if the addr is non-routable. Does not add self.
@@ -234,13 +247,13 @@ func (a *addrBook) IsGood(addr *p2p.NetAddress) bool {
 	return a.addrLookup[addr.ID].isOld()
 }
 
-// IsBanned returns true if the peer is currently banned.
+// IsBanned returns true if the peer is currently banned, for misbehaving or by
+// the operator.
 func (a *addrBook) IsBanned(addr *p2p.NetAddress) bool {
 	a.mtx.Lock()
-	_, ok := a.badPeers[addr.ID]
-	a.mtx.Unlock()
+	defer a.mtx.Unlock()
 
-	return ok
+	return a.isBanned(addr)
 }
 
 // HasAddress returns true if the address is in the book.
@@ -310,6 +323,9 @@ func (a *addrBook) PickAddress(biasTowardsNewAddrs int) *p2p.NetAddress {
 	randIndex := a.rand.Intn(len(bucket))
 	for _, ka := range bucket {
 		if randIndex == 0 {
+			if a.isBanned(ka.Addr) {
+				return nil
+			}
 			return ka.Addr
 		}
 		randIndex--
@@ -416,7 +432,7 @@ func (a *addrBook) GetSelection() []*p2p.NetAddress {
 	}
 
 	// slice off the limit we are willing to share.
-	return allAddr[:numAddresses]
+	return a.withoutBanned(allAddr[:numAddresses])
 }
 
 func percentageOfNum(p, n int) int {
@@ -460,7 +476,7 @@ func (a *addrBook) GetSelectionWithBias(biasTowardsNewAddrs int) []*p2p.NetAddre
 	numRequiredNewAdd := cmtmath.MaxInt(percentageOfNum(biasTowardsNewAddrs, numAddresses), numAddresses-a.nOld)
 	selection := a.randomPickAddresses(bucketTypeNew, numRequiredNewAdd)
 	selection = append(selection, a.randomPickAddresses(bucketTypeOld, numAddresses-len(selection))...)
-	return selection
+	return a.withoutBanned(selection)
 }
 
 // ------------------------------------------------
@@ -477,6 +493,25 @@ func (a *addrBook) size() int {
 	return a.nNew + a.nOld
 }
 
+// isBanned returns whether addr is banned, for misbehaving or by the operator.
+func (a *addrBook) isBanned(addr *p2p.NetAddress) bool {
+	if _, ok := a.badPeers[addr.ID]; ok {
+		return true
+	}
+	return a.banList != nil && a.banList.IsAddressBanned(addr)
+}
+
+// withoutBanned filters the banned addresses out of addrs, in place.
+func (a *addrBook) withoutBanned(addrs []*p2p.NetAddress) []*p2p.NetAddress {
+	selection := addrs[:0]
+	for _, addr := range addrs {
+		if !a.isBanned(addr) {
+			selection = append(selection, addr)
+		}
+	}
+	return selection
+}
+
 // ----------------------------------------------------------
 
 // Save persists the address book to disk.
@@ -639,7 +674,7 @@ func (a *addrBook) addAddress(addr, src *p2p.NetAddress) error {
 		return ErrAddrBookInvalidAddr{Addr: addr, AddrErr: err}
 	}
 
-	if _, ok := a.badPeers[addr.ID]; ok {
+	if a.isBanned(addr) {
 		return ErrAddressBanned{addr}
 	}
 
@@ -442,6 +442,53 @@ func TestBanBadPeers(t *testing.T) {
 	assert.False(t, book.IsGood(addr))
 }
 
+func TestBanListPeers(t *testing.T) {
+	fname := createTempFileName()
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	banList := p2p.NewBanList("")
+	book.SetBanList(banList)
+
+	var (
+		addr       = randIPv4Address(t)
+		bannedID   = randIPv4Address(t)
+		bannedIP   = randIPv4Address(t)
+		notYetSeen = randIPv4Address(t)
+	)
+	for _, a := range []*p2p.NetAddress{addr, bannedID, bannedIP} {
+		require.NoError(t, book.AddAddress(a, a))
+	}
+
+	_, err := banList.Ban(string(bannedID.ID), "testing", 0)
+	require.NoError(t, err)
+	_, err = banList.Ban(bannedIP.IP.String(), "testing", 0)
+	require.NoError(t, err)
+	_, err = banList.Ban(string(notYetSeen.ID), "testing", 0)
+	require.NoError(t, err)
+
+	// banned addresses are neither added, selected nor picked
+	err = book.AddAddress(notYetSeen, notYetSeen)
+	require.ErrorAs(t, err, &ErrAddressBanned{})
+	assert.True(t, book.IsBanned(bannedID))
+	assert.True(t, book.IsBanned(bannedIP))
+	assert.False(t, book.IsBanned(addr))
+
+	assert.Equal(t, []*p2p.NetAddress{addr}, book.GetSelection())
+	assert.Equal(t, []*p2p.NetAddress{addr}, book.GetSelectionWithBias(30))
+	for i := 0; i < 100; i++ {
+		if picked := book.PickAddress(50); picked != nil {
+			assert.Equal(t, addr, picked)
+		}
+	}
+
+	// lifting a ban makes the address usable again
+	require.NoError(t, banList.Unban(string(bannedID.ID)))
+	assert.False(t, book.IsBanned(bannedID))
+	assert.Len(t, book.GetSelection(), 2)
+}
+
 func TestAddrBookEmpty(t *testing.T) {
 	fname := createTempFileName()
 	defer deleteTempFile(fname)
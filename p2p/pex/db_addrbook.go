@@ -0,0 +1,873 @@
+package pex
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// max addresses in a database-backed address book: as many as the
+	// buckets of the file-backed one hold.
+	maxDBAddrBookSize = newBucketCount*newBucketSize + oldBucketCount*oldBucketSize
+
+	// peers that gossiped an address remembered by its entry.
+	maxSourcesPerAddress = 8
+
+	// weight of a new measurement in the moving average of the dial latency.
+	latencyAverageWeight = 0.125
+)
+
+// connectionRecorder is implemented by the address books recording the
+// history of the connections with their addresses.
+type connectionRecorder interface {
+	// MarkConnected records that a connection was made with the peer at addr.
+	MarkConnected(addr *p2p.NetAddress)
+	// MarkDisconnected records that the connection with the peer was closed.
+	MarkDisconnected(id p2p.ID)
+	// MarkLatency records the time it took to dial the peer at addr.
+	MarkLatency(addr *p2p.NetAddress, latency time.Duration)
+}
+
+// AddrBookEntry is the history of an address in a DBAddrBook.
+type AddrBookEntry struct {
+	Addr *p2p.NetAddress `json:"addr"`
+	// IDs of the last peers that gossiped the address, most recent last. An
+	// address received from the peer itself or from a DNS seed lists the ID of
+	// the peer.
+	Sources []p2p.ID `json:"sources"`
+	// When the address was first and last added to the book
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	// Dials that failed since the last connection with the peer
+	Attempts    int32     `json:"attempts"`
+	LastAttempt time.Time `json:"last_attempt"`
+	// Connections made with the peer, inbound or outbound
+	Connections int64     `json:"connections"`
+	LastSuccess time.Time `json:"last_success"`
+	// Total time spent connected to the peer
+	Uptime time.Duration `json:"uptime"`
+	// Moving average of the time it took to dial the peer
+	Latency time.Duration `json:"latency"`
+	// Whether the reactors marked the peer as good
+	Good bool `json:"good"`
+	// Until when the peer is banned for misbehaving, if it is
+	BannedUntil time.Time `json:"banned_until"`
+
+	connectedAt time.Time // start of the current connection, if any
+}
+
+// ID returns the ID of the peer at the address.
+func (e *AddrBookEntry) ID() p2p.ID {
+	return e.Addr.ID
+}
+
+func (e *AddrBookEntry) isBanned(now time.Time) bool {
+	return e.BannedUntil.After(now)
+}
+
+func (e *AddrBookEntry) isConnected() bool {
+	return !e.connectedAt.IsZero()
+}
+
+// isTried returns whether the address is known to lead to a peer, which is the
+// equivalent of the "old" buckets of the file-backed address book.
+func (e *AddrBookEntry) isTried() bool {
+	return e.Good || e.Connections > 0
+}
+
+// uptime returns the time spent connected to the peer, including the current
+// connection.
+func (e *AddrBookEntry) uptime(now time.Time) time.Duration {
+	if e.isConnected() {
+		return e.Uptime + now.Sub(e.connectedAt)
+	}
+	return e.Uptime
+}
+
+func (e *AddrBookEntry) addSource(id p2p.ID) {
+	for i, src := range e.Sources {
+		if src == id {
+			e.Sources = append(e.Sources[:i], e.Sources[i+1:]...)
+			break
+		}
+	}
+	e.Sources = append(e.Sources, id)
+	if len(e.Sources) > maxSourcesPerAddress {
+		e.Sources = e.Sources[len(e.Sources)-maxSourcesPerAddress:]
+	}
+}
+
+// score rates how worth dialing the address is, from its history. Addresses
+// which were connected to more often, stayed connected longer, were faster to
+// dial and were marked as good score higher, while every dial that failed
+// since the last connection halves the score. It is always positive.
+func (e *AddrBookEntry) score(now time.Time) float64 {
+	score := 1 + math.Log1p(float64(e.Connections))
+	score *= 1 + math.Log1p(e.uptime(now).Hours())
+	if e.Latency > 0 {
+		score /= 1 + e.Latency.Seconds()
+	}
+	if e.Good {
+		score *= 2
+	}
+	return score / math.Pow(2, float64(cmtmath.MinInt(int(e.Attempts), 64)))
+}
+
+// snapshot returns a copy of the entry, whose uptime includes the current
+// connection.
+func (e *AddrBookEntry) snapshot(now time.Time) *AddrBookEntry {
+	entry := *e
+	entry.Sources = append([]p2p.ID(nil), e.Sources...)
+	entry.Uptime = e.uptime(now)
+	entry.connectedAt = time.Time{}
+	return &entry
+}
+
+// DBAddrBook is an AddrBook stored in a database, which records the history
+// of every address: when it was first and last seen, which peers gossiped it,
+// the connections made with the peer, their uptime, and the latency of the
+// dials. The addresses to dial are picked according to this history.
+//
+// Each entry is stored as JSON under the ID of its peer. The entries changed
+// since the last save are written periodically and when the book is stopped.
+type DBAddrBook struct {
+	service.BaseService
+
+	db dbm.DB
+
+	// accessed concurrently
+	mtx        cmtsync.Mutex
+	rand       *cmtrand.Rand
+	ourAddrs   map[string]struct{}
+	privateIDs map[p2p.ID]struct{}
+	banList    *p2p.BanList // bans of the operator, if any
+	entries    map[p2p.ID]*AddrBookEntry
+	dirty      map[p2p.ID]struct{} // entries changed or removed since the last save
+
+	// immutable after creation
+	routabilityStrict bool
+
+	wg sync.WaitGroup
+}
+
+var (
+	_ AddrBook           = (*DBAddrBook)(nil)
+	_ connectionRecorder = (*DBAddrBook)(nil)
+)
+
+// NewDBAddrBook creates a new address book stored in db.
+// Use Start to load the book and begin saving it periodically.
+func NewDBAddrBook(db dbm.DB, routabilityStrict bool) *DBAddrBook {
+	b := &DBAddrBook{
+		db:                db,
+		rand:              cmtrand.NewRand(),
+		ourAddrs:          make(map[string]struct{}),
+		privateIDs:        make(map[p2p.ID]struct{}),
+		entries:           make(map[p2p.ID]*AddrBookEntry),
+		dirty:             make(map[p2p.ID]struct{}),
+		routabilityStrict: routabilityStrict,
+	}
+	b.BaseService = *service.NewBaseService(nil, "DBAddrBook", b)
+	return b
+}
+
+// OnStart implements Service.
+func (b *DBAddrBook) OnStart() error {
+	if err := b.load(); err != nil {
+		return err
+	}
+
+	b.wg.Add(1)
+	go b.saveRoutine()
+
+	return nil
+}
+
+// Stop overrides Service.Stop().
+func (b *DBAddrBook) Stop() error {
+	// Closes the Service.Quit() channel.
+	// This enables b.saveRoutine() to quit.
+	if err := b.BaseService.Stop(); err != nil {
+		return err
+	}
+	b.wg.Wait()
+	return nil
+}
+
+// -------------------------------------------------------
+
+// AddOurAddress implements AddrBook.
+func (b *DBAddrBook) AddOurAddress(addr *p2p.NetAddress) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.Logger.Info("Add our address to book", "addr", addr)
+	b.ourAddrs[addr.String()] = struct{}{}
+}
+
+// OurAddress implements AddrBook.
+func (b *DBAddrBook) OurAddress(addr *p2p.NetAddress) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	_, ok := b.ourAddrs[addr.String()]
+	return ok
+}
+
+// AddPrivateIDs implements AddrBook.
+func (b *DBAddrBook) AddPrivateIDs(ids []string) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, id := range ids {
+		b.privateIDs[p2p.ID(id)] = struct{}{}
+	}
+}
+
+// SetBanList implements AddrBook.
+func (b *DBAddrBook) SetBanList(banList *p2p.BanList) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.banList = banList
+}
+
+// AddAddress implements AddrBook. It adds the address to the book, or records
+// that it was seen again, gossiped by src, if it is known. The address of a
+// known peer is never changed, so that peers cannot redirect the dials of
+// others. If the book is full, the never connected address with the lowest
+// score is evicted.
+// NOTE: addr must not be nil.
+func (b *DBAddrBook) AddAddress(addr *p2p.NetAddress, src *p2p.NetAddress) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.addAddress(addr, src, time.Now().UTC())
+}
+
+// RemoveAddress implements AddrBook.
+func (b *DBAddrBook) RemoveAddress(addr *p2p.NetAddress) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if _, ok := b.entries[addr.ID]; !ok {
+		return
+	}
+	b.Logger.Info("Remove address from book", "addr", addr)
+	b.removeEntry(addr.ID)
+}
+
+// HasAddress implements AddrBook. Addresses banned for misbehaving are not in
+// the book until they are reinstated.
+func (b *DBAddrBook) HasAddress(addr *p2p.NetAddress) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[addr.ID]
+	return e != nil && !e.isBanned(time.Now().UTC())
+}
+
+// NeedMoreAddrs implements AddrBook.
+func (b *DBAddrBook) NeedMoreAddrs() bool {
+	return b.Size() < needAddressThreshold
+}
+
+// Empty implements AddrBook.
+func (b *DBAddrBook) Empty() bool {
+	return b.Size() == 0
+}
+
+// PickAddress implements AddrBook. It picks an address to connect to, among
+// the addresses which are not connected. Like in the file-backed address
+// book, biasTowardsNewAddrs, between [0, 100] (or else truncated to that
+// range), determines how biased we are to pick an address which was never
+// connected to, rather than one which was. Within either, the address is
+// picked randomly, in proportion to the score of its history.
+// PickAddress returns nil if there is no address to pick from.
+func (b *DBAddrBook) PickAddress(biasTowardsNewAddrs int) *p2p.NetAddress {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	var tried, fresh []*AddrBookEntry
+	for _, e := range b.entries {
+		if e.isConnected() || b.isBanned(e.Addr, now) {
+			continue
+		}
+		if e.isTried() {
+			tried = append(tried, e)
+		} else {
+			fresh = append(fresh, e)
+		}
+	}
+	if len(tried)+len(fresh) == 0 {
+		return nil
+	}
+	if biasTowardsNewAddrs > 100 {
+		biasTowardsNewAddrs = 100
+	}
+	if biasTowardsNewAddrs < 0 {
+		biasTowardsNewAddrs = 0
+	}
+
+	// Bias between new and tried addresses.
+	triedCorrelation := math.Sqrt(float64(len(tried))) * (100.0 - float64(biasTowardsNewAddrs))
+	newCorrelation := math.Sqrt(float64(len(fresh))) * float64(biasTowardsNewAddrs)
+
+	candidates := fresh
+	if (newCorrelation+triedCorrelation)*b.rand.Float64() < triedCorrelation {
+		candidates = tried
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return b.pickByScore(candidates, now).Addr
+}
+
+// MarkGood implements AddrBook.
+func (b *DBAddrBook) MarkGood(id p2p.ID) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[id]
+	if e == nil {
+		return
+	}
+	e.Good = true
+	e.Attempts = 0
+	b.dirty[id] = struct{}{}
+}
+
+// MarkAttempt implements AddrBook. It records a failed dial of the address.
+func (b *DBAddrBook) MarkAttempt(addr *p2p.NetAddress) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[addr.ID]
+	if e == nil {
+		return
+	}
+	e.Attempts++
+	e.LastAttempt = time.Now().UTC()
+	b.dirty[addr.ID] = struct{}{}
+}
+
+// MarkBad implements AddrBook. The address is banned for banTime, but keeps
+// its history.
+func (b *DBAddrBook) MarkBad(addr *p2p.NetAddress, banTime time.Duration) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[addr.ID]
+	if e == nil {
+		return
+	}
+	if bannedUntil := time.Now().UTC().Add(banTime); e.BannedUntil.Before(bannedUntil) {
+		e.BannedUntil = bannedUntil
+	}
+	e.Good = false
+	b.dirty[addr.ID] = struct{}{}
+	b.Logger.Info("Add address to blacklist", "addr", addr)
+}
+
+// ReinstateBadPeers implements AddrBook.
+func (b *DBAddrBook) ReinstateBadPeers() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	for id, e := range b.entries {
+		if e.BannedUntil.IsZero() || e.isBanned(now) {
+			continue
+		}
+		e.BannedUntil = time.Time{}
+		b.dirty[id] = struct{}{}
+
+		b.Logger.Info("Reinstated address", "addr", e.Addr)
+	}
+}
+
+// IsGood implements AddrBook.
+func (b *DBAddrBook) IsGood(addr *p2p.NetAddress) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[addr.ID]
+	return e != nil && e.Good && !e.isBanned(time.Now().UTC())
+}
+
+// IsBanned implements AddrBook.
+func (b *DBAddrBook) IsBanned(addr *p2p.NetAddress) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.isBanned(addr, time.Now().UTC())
+}
+
+// GetSelection implements AddrBook.
+// It randomly selects some addresses, connected or not. Suitable for
+// peer-exchange protocols.
+func (b *DBAddrBook) GetSelection() []*p2p.NetAddress {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	var entries []*AddrBookEntry
+	for _, e := range b.entries {
+		if !b.isBanned(e.Addr, now) {
+			entries = append(entries, e)
+		}
+	}
+	return b.randomAddrs(entries, selectionSize(len(entries)))
+}
+
+// GetSelectionWithBias implements AddrBook.
+// It randomly selects some addresses, connected or not. Suitable for
+// peer-exchange protocols.
+//
+// biasTowardsNewAddrs, between [0, 100] (or else truncated to that range),
+// is the share of the selection which, if possible, is made of addresses which
+// were never connected to.
+func (b *DBAddrBook) GetSelectionWithBias(biasTowardsNewAddrs int) []*p2p.NetAddress {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	var tried, fresh []*AddrBookEntry
+	for _, e := range b.entries {
+		switch {
+		case b.isBanned(e.Addr, now):
+		case e.isTried():
+			tried = append(tried, e)
+		default:
+			fresh = append(fresh, e)
+		}
+	}
+	if biasTowardsNewAddrs > 100 {
+		biasTowardsNewAddrs = 100
+	}
+	if biasTowardsNewAddrs < 0 {
+		biasTowardsNewAddrs = 0
+	}
+
+	numAddresses := selectionSize(len(tried) + len(fresh))
+	// if there are not enough tried addresses, new ones are selected instead.
+	numRequiredNewAdd := cmtmath.MaxInt(percentageOfNum(biasTowardsNewAddrs, numAddresses), numAddresses-len(tried))
+	selection := b.randomAddrs(fresh, numRequiredNewAdd)
+	return append(selection, b.randomAddrs(tried, numAddresses-len(selection))...)
+}
+
+// Size implements AddrBook. It does not count the addresses banned for
+// misbehaving.
+func (b *DBAddrBook) Size() int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	size := 0
+	for _, e := range b.entries {
+		if !e.isBanned(now) {
+			size++
+		}
+	}
+	return size
+}
+
+// Save implements AddrBook. It writes the entries changed since the last save
+// to the database.
+func (b *DBAddrBook) Save() {
+	if err := b.save(); err != nil {
+		b.Logger.Error("Failed to save AddrBook", "err", err)
+	}
+}
+
+// ----------------------------------------------------------
+
+// MarkConnected implements connectionRecorder.
+func (b *DBAddrBook) MarkConnected(addr *p2p.NetAddress) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[addr.ID]
+	if e == nil || e.isConnected() {
+		return
+	}
+	now := time.Now().UTC()
+	e.connectedAt = now
+	e.Connections++
+	e.LastSuccess = now
+	e.Attempts = 0
+	b.dirty[addr.ID] = struct{}{}
+}
+
+// MarkDisconnected implements connectionRecorder.
+func (b *DBAddrBook) MarkDisconnected(id p2p.ID) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[id]
+	if e == nil || !e.isConnected() {
+		return
+	}
+	e.Uptime = e.uptime(time.Now().UTC())
+	e.connectedAt = time.Time{}
+	b.dirty[id] = struct{}{}
+}
+
+// MarkLatency implements connectionRecorder.
+func (b *DBAddrBook) MarkLatency(addr *p2p.NetAddress, latency time.Duration) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[addr.ID]
+	if e == nil {
+		return
+	}
+	if e.Latency == 0 {
+		e.Latency = latency
+	} else {
+		e.Latency += time.Duration(latencyAverageWeight * float64(latency-e.Latency))
+	}
+	b.dirty[addr.ID] = struct{}{}
+}
+
+// ----------------------------------------------------------
+
+// Entries returns a copy of all the entries of the book, including the
+// addresses banned for misbehaving, sorted by ID.
+func (b *DBAddrBook) Entries() []*AddrBookEntry {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	entries := make([]*AddrBookEntry, 0, len(b.entries))
+	for _, e := range b.entries {
+		entries = append(entries, e.snapshot(now))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID() < entries[j].ID()
+	})
+	return entries
+}
+
+// Entry returns a copy of the entry of the peer with the given ID, if it is in
+// the book.
+func (b *DBAddrBook) Entry(id p2p.ID) (*AddrBookEntry, bool) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	e := b.entries[id]
+	if e == nil {
+		return nil, false
+	}
+	return e.snapshot(time.Now().UTC()), true
+}
+
+// Prune removes the entries for which remove returns true from the book and
+// the database. It returns the number of removed entries.
+func (b *DBAddrBook) Prune(remove func(e *AddrBookEntry) bool) (int, error) {
+	b.mtx.Lock()
+	now := time.Now().UTC()
+	pruned := 0
+	for id, e := range b.entries {
+		if remove(e.snapshot(now)) {
+			b.removeEntry(id)
+			pruned++
+		}
+	}
+	b.mtx.Unlock()
+
+	return pruned, b.save()
+}
+
+// Import adds the entries of the peers which are not in the book yet, with
+// their history, to the book and the database. Entries with an invalid
+// address are skipped. It returns the number of imported entries.
+func (b *DBAddrBook) Import(entries []*AddrBookEntry) (int, error) {
+	b.mtx.Lock()
+	now := time.Now().UTC()
+	imported := 0
+	for _, e := range entries {
+		if e == nil || e.Addr == nil || e.Addr.Valid() != nil {
+			continue
+		}
+		if _, ok := b.entries[e.ID()]; ok {
+			continue
+		}
+		if len(b.entries) >= maxDBAddrBookSize {
+			break
+		}
+		entry := e.snapshot(now)
+		b.entries[entry.ID()] = entry
+		b.dirty[entry.ID()] = struct{}{}
+		imported++
+	}
+	b.mtx.Unlock()
+
+	return imported, b.save()
+}
+
+// ReadAddrBookFile reads the address book file of a file-backed address book,
+// as entries to import into a DBAddrBook. As the file does not record when
+// the addresses were seen, they are seen now.
+func ReadAddrBookFile(filePath string) ([]*AddrBookEntry, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	aJSON := &addrBookJSON{}
+	if err := json.Unmarshal(bz, aJSON); err != nil {
+		return nil, fmt.Errorf("error reading file %s: %w", filePath, err)
+	}
+
+	now := time.Now().UTC()
+	entries := make([]*AddrBookEntry, 0, len(aJSON.Addrs))
+	for _, ka := range aJSON.Addrs {
+		if ka.Addr == nil {
+			continue
+		}
+		e := &AddrBookEntry{
+			Addr:        ka.Addr,
+			FirstSeen:   now,
+			LastSeen:    now,
+			Attempts:    ka.Attempts,
+			LastAttempt: ka.LastAttempt,
+			LastSuccess: ka.LastSuccess,
+			Good:        ka.isOld(),
+		}
+		if ka.Src != nil {
+			e.Sources = []p2p.ID{ka.Src.ID}
+		}
+		if ka.isBanned() {
+			e.BannedUntil = ka.LastBanTime
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// ----------------------------------------------------------
+
+// adds the address to the book, or records that it was seen again.
+func (b *DBAddrBook) addAddress(addr, src *p2p.NetAddress, now time.Time) error {
+	if addr == nil || src == nil {
+		return ErrAddrBookNilAddr{addr, src}
+	}
+
+	if err := addr.Valid(); err != nil {
+		return ErrAddrBookInvalidAddr{Addr: addr, AddrErr: err}
+	}
+
+	if b.isBanned(addr, now) {
+		return ErrAddressBanned{addr}
+	}
+
+	if _, ok := b.privateIDs[addr.ID]; ok {
+		return ErrAddrBookPrivate{addr}
+	}
+
+	if _, ok := b.privateIDs[src.ID]; ok {
+		return ErrAddrBookPrivateSrc{src}
+	}
+
+	if _, ok := b.ourAddrs[addr.String()]; ok {
+		return ErrAddrBookSelf{addr}
+	}
+
+	if b.routabilityStrict && !addr.Routable() {
+		return ErrAddrBookNonRoutable{addr}
+	}
+
+	e := b.entries[addr.ID]
+	if e == nil {
+		if len(b.entries) >= maxDBAddrBookSize && !b.evict(now) {
+			return ErrAddrBookFull{Addr: addr, Size: len(b.entries)}
+		}
+		e = &AddrBookEntry{Addr: addr, FirstSeen: now}
+		b.entries[addr.ID] = e
+	}
+	e.LastSeen = now
+	e.addSource(src.ID)
+	b.dirty[addr.ID] = struct{}{}
+	return nil
+}
+
+// evict removes the address with the lowest score among the addresses that
+// were never connected to. It returns false if there is no such address.
+func (b *DBAddrBook) evict(now time.Time) bool {
+	var (
+		worst      *AddrBookEntry
+		worstScore float64
+	)
+	for _, e := range b.entries {
+		if e.isTried() || e.isConnected() {
+			continue
+		}
+		if score := e.score(now); worst == nil || score < worstScore {
+			worst, worstScore = e, score
+		}
+	}
+	if worst == nil {
+		return false
+	}
+	b.Logger.Info("Evict address from full book", "addr", worst.Addr)
+	b.removeEntry(worst.ID())
+	return true
+}
+
+func (b *DBAddrBook) removeEntry(id p2p.ID) {
+	delete(b.entries, id)
+	b.dirty[id] = struct{}{}
+}
+
+// isBanned returns whether addr is banned, for misbehaving or by the operator.
+func (b *DBAddrBook) isBanned(addr *p2p.NetAddress, now time.Time) bool {
+	if e := b.entries[addr.ID]; e != nil && e.isBanned(now) {
+		return true
+	}
+	return b.banList != nil && b.banList.IsAddressBanned(addr)
+}
+
+// pickByScore picks one of entries randomly, in proportion to their scores.
+func (b *DBAddrBook) pickByScore(entries []*AddrBookEntry, now time.Time) *AddrBookEntry {
+	scores := make([]float64, len(entries))
+	total := 0.0
+	for i, e := range entries {
+		scores[i] = e.score(now)
+		total += scores[i]
+	}
+	r := total * b.rand.Float64()
+	for i, e := range entries {
+		r -= scores[i]
+		if r < 0 {
+			return e
+		}
+	}
+	return entries[len(entries)-1]
+}
+
+// randomAddrs returns the addresses of up to num random entries.
+func (b *DBAddrBook) randomAddrs(entries []*AddrBookEntry, num int) []*p2p.NetAddress {
+	num = cmtmath.MinInt(num, len(entries))
+	if num <= 0 {
+		return nil
+	}
+	addrs := make([]*p2p.NetAddress, 0, num)
+	for _, i := range b.rand.Perm(len(entries))[:num] {
+		addrs = append(addrs, entries[i].Addr)
+	}
+	return addrs
+}
+
+// selectionSize returns the number of addresses to select for peer exchange
+// out of bookSize addresses.
+func selectionSize(bookSize int) int {
+	numAddresses := cmtmath.MaxInt(
+		cmtmath.MinInt(minGetSelection, bookSize),
+		bookSize*getSelectionPercent/100)
+	return cmtmath.MinInt(maxGetSelection, numAddresses)
+}
+
+// ----------------------------------------------------------
+
+// load reads the entries of the book from the database. Entries that cannot
+// be decoded are skipped.
+func (b *DBAddrBook) load() error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	iter, err := b.db.Iterator(nil, nil)
+	if err != nil {
+		return fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+
+	corrupt := 0
+	for ; iter.Valid(); iter.Next() {
+		e := &AddrBookEntry{}
+		if err := json.Unmarshal(iter.Value(), e); err != nil ||
+			e.Addr == nil || string(e.ID()) != string(iter.Key()) {
+			corrupt++
+			continue
+		}
+		b.entries[e.ID()] = e
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if corrupt > 0 {
+		b.Logger.Error("Skipped corrupt AddrBook entries", "count", corrupt)
+	}
+	b.Logger.Info("Loaded AddrBook", "size", len(b.entries))
+	return nil
+}
+
+// save writes the entries changed since the last save, and those of the
+// connected peers whose uptime grows, to the database.
+func (b *DBAddrBook) save() error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	now := time.Now().UTC()
+	for id, e := range b.entries {
+		if e.isConnected() {
+			b.dirty[id] = struct{}{}
+		}
+	}
+	if len(b.dirty) == 0 {
+		return nil
+	}
+
+	batch := b.db.NewBatch()
+	defer batch.Close()
+	for id := range b.dirty {
+		e, ok := b.entries[id]
+		if !ok {
+			if err := batch.Delete([]byte(id)); err != nil {
+				return err
+			}
+			continue
+		}
+		bz, err := json.Marshal(e.snapshot(now))
+		if err != nil {
+			return err
+		}
+		if err := batch.Set([]byte(id), bz); err != nil {
+			return err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	b.dirty = make(map[p2p.ID]struct{})
+	return nil
+}
+
+func (b *DBAddrBook) saveRoutine() {
+	defer b.wg.Done()
+
+	saveTicker := time.NewTicker(dumpAddressInterval)
+	defer saveTicker.Stop()
+	for {
+		select {
+		case <-saveTicker.C:
+			b.Save()
+		case <-b.Quit():
+			b.Save()
+			return
+		}
+	}
+}
@@ -0,0 +1,379 @@
+package pex
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+func createDBAddrBook(t *testing.T, db dbm.DB) *DBAddrBook {
+	t.Helper()
+	book := NewDBAddrBook(db, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	t.Cleanup(func() {
+		if book.IsRunning() {
+			require.NoError(t, book.Stop())
+		}
+	})
+	return book
+}
+
+func TestDBAddrBookAddAddress(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+	assert.True(t, book.Empty())
+
+	addr := randIPv4Address(t)
+	src1, src2 := randIPv4Address(t), randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, src1))
+	require.NoError(t, book.AddAddress(addr, src2))
+	require.NoError(t, book.AddAddress(addr, src1))
+	assert.Equal(t, 1, book.Size())
+	assert.True(t, book.HasAddress(addr))
+
+	entry, ok := book.Entry(addr.ID)
+	require.True(t, ok)
+	assert.Equal(t, addr, entry.Addr)
+	assert.Equal(t, []p2p.ID{src2.ID, src1.ID}, entry.Sources)
+	assert.False(t, entry.FirstSeen.After(entry.LastSeen))
+
+	// The address of a known peer is not changed.
+	moved := randIPv4Address(t)
+	moved.ID = addr.ID
+	require.NoError(t, book.AddAddress(moved, src1))
+	entry, _ = book.Entry(addr.ID)
+	assert.Equal(t, addr, entry.Addr)
+
+	// Only the last sources are remembered.
+	for i := 0; i < 2*maxSourcesPerAddress; i++ {
+		require.NoError(t, book.AddAddress(addr, randIPv4Address(t)))
+	}
+	entry, _ = book.Entry(addr.ID)
+	assert.Len(t, entry.Sources, maxSourcesPerAddress)
+
+	self := randIPv4Address(t)
+	book.AddOurAddress(self)
+	require.ErrorAs(t, book.AddAddress(self, self), &ErrAddrBookSelf{})
+
+	private := randIPv4Address(t)
+	book.AddPrivateIDs([]string{string(private.ID)})
+	require.ErrorAs(t, book.AddAddress(private, src1), &ErrAddrBookPrivate{})
+	require.ErrorAs(t, book.AddAddress(randIPv4Address(t), private), &ErrAddrBookPrivateSrc{})
+
+	local, err := p2p.NewNetAddressString(string(randIPv4Address(t).ID) + "@127.0.0.1:26656")
+	require.NoError(t, err)
+	require.ErrorAs(t, book.AddAddress(local, src1), &ErrAddrBookNonRoutable{})
+
+	assert.Equal(t, 1, book.Size())
+}
+
+func TestDBAddrBookConnectionHistory(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+
+	addr := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, addr))
+
+	book.MarkAttempt(addr)
+	book.MarkAttempt(addr)
+	entry, _ := book.Entry(addr.ID)
+	assert.EqualValues(t, 2, entry.Attempts)
+	assert.False(t, book.IsGood(addr))
+
+	book.MarkLatency(addr, 800*time.Millisecond)
+	book.MarkConnected(addr)
+	entry, _ = book.Entry(addr.ID)
+	assert.EqualValues(t, 0, entry.Attempts)
+	assert.EqualValues(t, 1, entry.Connections)
+	assert.Equal(t, 800*time.Millisecond, entry.Latency)
+	assert.False(t, entry.LastSuccess.IsZero())
+
+	// A connected address is not picked.
+	assert.Nil(t, book.PickAddress(50))
+
+	time.Sleep(10 * time.Millisecond)
+	book.MarkDisconnected(addr.ID)
+	entry, _ = book.Entry(addr.ID)
+	uptime := entry.Uptime
+	assert.GreaterOrEqual(t, uptime, 10*time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	entry, _ = book.Entry(addr.ID)
+	assert.Equal(t, uptime, entry.Uptime, "uptime only grows while connected")
+
+	book.MarkLatency(addr, 0)
+	entry, _ = book.Entry(addr.ID)
+	assert.Equal(t, 700*time.Millisecond, entry.Latency, "latency is a moving average")
+
+	book.MarkGood(addr.ID)
+	assert.True(t, book.IsGood(addr))
+	assert.Equal(t, addr, book.PickAddress(0))
+}
+
+func TestDBAddrBookPickAddress(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+	assert.Nil(t, book.PickAddress(50))
+
+	// An address that was never connected to and one that was.
+	fresh := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(fresh, fresh))
+	tried := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(tried, tried))
+	book.MarkConnected(tried)
+	book.MarkDisconnected(tried.ID)
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, fresh, book.PickAddress(100))
+		assert.Equal(t, tried, book.PickAddress(0))
+	}
+
+	// Among the addresses that were never connected to, the ones whose dials
+	// failed are picked less often.
+	failing := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(failing, failing))
+	for i := 0; i < 4; i++ {
+		book.MarkAttempt(failing)
+	}
+	picks := make(map[p2p.ID]int)
+	for i := 0; i < 1000; i++ {
+		picks[book.PickAddress(100).ID]++
+	}
+	assert.Greater(t, picks[fresh.ID], 5*picks[failing.ID])
+	assert.Positive(t, picks[failing.ID])
+}
+
+func TestDBAddrBookMarkBad(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+
+	addr := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, addr))
+	book.MarkGood(addr.ID)
+
+	book.MarkBad(addr, time.Hour)
+	assert.True(t, book.IsBanned(addr))
+	assert.False(t, book.HasAddress(addr))
+	assert.False(t, book.IsGood(addr))
+	assert.True(t, book.Empty())
+	assert.Nil(t, book.PickAddress(50))
+	assert.Empty(t, book.GetSelection())
+	require.ErrorAs(t, book.AddAddress(addr, addr), &ErrAddressBanned{})
+
+	// A ban is not shortened, and is lifted once expired, keeping the
+	// history of the address.
+	book.MarkBad(addr, 0)
+	book.ReinstateBadPeers()
+	entry, _ := book.Entry(addr.ID)
+	require.False(t, entry.BannedUntil.Before(time.Now()))
+
+	book.mtx.Lock()
+	book.entries[addr.ID].BannedUntil = time.Now().Add(-time.Second)
+	book.mtx.Unlock()
+	book.ReinstateBadPeers()
+	entry, _ = book.Entry(addr.ID)
+	assert.True(t, entry.BannedUntil.IsZero())
+	assert.True(t, book.HasAddress(addr))
+	assert.Equal(t, addr, book.PickAddress(50))
+
+	banList := p2p.NewBanList("")
+	_, err := banList.Ban(string(addr.ID), "spam", 0)
+	require.NoError(t, err)
+	book.SetBanList(banList)
+	assert.True(t, book.IsBanned(addr))
+	assert.Nil(t, book.PickAddress(50))
+}
+
+func TestDBAddrBookGetSelection(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+	assert.Empty(t, book.GetSelection())
+	assert.Empty(t, book.GetSelectionWithBias(30))
+
+	addrs := make(map[p2p.ID]bool)
+	for i := 0; i < 100; i++ {
+		addr := randIPv4Address(t)
+		require.NoError(t, book.AddAddress(addr, addr))
+		addrs[addr.ID] = false
+		if i%2 == 0 {
+			book.MarkConnected(addr)
+			addrs[addr.ID] = true
+		}
+	}
+
+	selection := book.GetSelection()
+	assert.Len(t, selection, minGetSelection)
+	for _, addr := range selection {
+		assert.Contains(t, addrs, addr.ID)
+	}
+
+	selection = book.GetSelectionWithBias(30)
+	require.Len(t, selection, minGetSelection)
+	numFresh := 0
+	for _, addr := range selection {
+		if !addrs[addr.ID] {
+			numFresh++
+		}
+	}
+	assert.Equal(t, percentageOfNum(30, minGetSelection), numFresh)
+}
+
+func TestDBAddrBookFull(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+
+	first := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(first, first))
+	book.MarkConnected(first)
+	for i := 1; i < maxDBAddrBookSize; i++ {
+		addr := randIPv4Address(t)
+		require.NoError(t, book.AddAddress(addr, addr))
+		book.MarkAttempt(addr)
+	}
+
+	// The worst address that was never connected to is evicted.
+	worst := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(worst, worst))
+	for i := 0; i < 10; i++ {
+		book.MarkAttempt(worst)
+	}
+	addr := randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr, addr))
+	assert.Equal(t, maxDBAddrBookSize, book.Size())
+	assert.True(t, book.HasAddress(addr))
+	assert.False(t, book.HasAddress(worst))
+	assert.True(t, book.HasAddress(first))
+}
+
+func TestDBAddrBookSaveLoad(t *testing.T) {
+	db := dbm.NewMemDB()
+	book := createDBAddrBook(t, db)
+
+	addr1, addr2, addr3 := randIPv4Address(t), randIPv4Address(t), randIPv4Address(t)
+	require.NoError(t, book.AddAddress(addr1, addr2))
+	require.NoError(t, book.AddAddress(addr2, addr2))
+	require.NoError(t, book.AddAddress(addr3, addr3))
+	book.MarkConnected(addr1)
+	book.MarkLatency(addr1, time.Second)
+	book.MarkAttempt(addr2)
+	book.MarkBad(addr3, time.Hour)
+	book.Save()
+
+	loaded := createDBAddrBook(t, db)
+	assert.Len(t, loaded.Entries(), 3)
+	for _, addr := range []*p2p.NetAddress{addr2, addr3} {
+		saved, _ := book.Entry(addr.ID)
+		entry, ok := loaded.Entry(addr.ID)
+		require.True(t, ok)
+		assert.Equal(t, saved, entry)
+	}
+
+	// The removal of addresses and the uptime of the connected peers are saved
+	// too.
+	book.RemoveAddress(addr2)
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, book.Stop())
+
+	loaded = createDBAddrBook(t, db)
+	assert.Equal(t, 2, len(loaded.Entries()))
+	assert.False(t, loaded.HasAddress(addr2))
+	entry, ok := loaded.Entry(addr1.ID)
+	require.True(t, ok)
+	assert.GreaterOrEqual(t, entry.Uptime, 10*time.Millisecond)
+	assert.EqualValues(t, 1, entry.Connections)
+	assert.Equal(t, time.Second, entry.Latency)
+	assert.Equal(t, []p2p.ID{addr2.ID}, entry.Sources)
+	assert.True(t, loaded.IsBanned(addr3))
+
+	// Corrupt entries are skipped.
+	require.NoError(t, db.Set([]byte("garbage"), []byte("{")))
+	loaded = createDBAddrBook(t, db)
+	assert.Equal(t, 2, len(loaded.Entries()))
+}
+
+func TestDBAddrBookPruneImport(t *testing.T) {
+	book := createDBAddrBook(t, dbm.NewMemDB())
+
+	connected, failing := randIPv4Address(t), randIPv4Address(t)
+	require.NoError(t, book.AddAddress(connected, connected))
+	require.NoError(t, book.AddAddress(failing, failing))
+	book.MarkConnected(connected)
+	book.MarkAttempt(failing)
+	entries := book.Entries()
+
+	pruned, err := book.Prune(func(e *AddrBookEntry) bool { return e.Attempts > 0 })
+	require.NoError(t, err)
+	assert.Equal(t, 1, pruned)
+	assert.False(t, book.HasAddress(failing))
+	assert.True(t, book.HasAddress(connected))
+
+	other := createDBAddrBook(t, dbm.NewMemDB())
+	imported, err := other.Import(append(entries, nil, &AddrBookEntry{}))
+	require.NoError(t, err)
+	assert.Equal(t, 2, imported)
+	assert.Equal(t, entries, other.Entries())
+
+	imported, err = other.Import(entries)
+	require.NoError(t, err)
+	assert.Zero(t, imported, "known peers are skipped")
+}
+
+func TestReadAddrBookFile(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "addrbook.json")
+	fileBook := NewAddrBook(fname, true)
+	fileBook.SetLogger(log.TestingLogger())
+
+	good, fresh := randIPv4Address(t), randIPv4Address(t)
+	src := randIPv4Address(t)
+	require.NoError(t, fileBook.AddAddress(good, src))
+	require.NoError(t, fileBook.AddAddress(fresh, src))
+	fileBook.MarkGood(good.ID)
+	fileBook.Save()
+
+	entries, err := ReadAddrBookFile(fname)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	book := createDBAddrBook(t, dbm.NewMemDB())
+	imported, err := book.Import(entries)
+	require.NoError(t, err)
+	assert.Equal(t, 2, imported)
+	assert.True(t, book.IsGood(good))
+	assert.False(t, book.IsGood(fresh))
+	entry, ok := book.Entry(fresh.ID)
+	require.True(t, ok)
+	assert.Equal(t, []p2p.ID{src.ID}, entry.Sources)
+
+	_, err = ReadAddrBookFile(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestPEXReactorRecordsConnections(t *testing.T) {
+	book := NewDBAddrBook(dbm.NewMemDB(), true)
+	book.SetLogger(log.TestingLogger())
+	r := NewReactor(book, &ReactorConfig{})
+	r.SetLogger(log.TestingLogger())
+
+	inbound := p2p.CreateRandomPeer(false)
+	r.AddPeer(inbound)
+	entry, ok := book.Entry(inbound.ID())
+	require.True(t, ok)
+	assert.EqualValues(t, 1, entry.Connections)
+	assert.Equal(t, []p2p.ID{inbound.ID()}, entry.Sources)
+
+	outbound := p2p.CreateRandomPeer(true)
+	require.NoError(t, book.AddAddress(outbound.SocketAddr(), inbound.SocketAddr()))
+	r.AddPeer(outbound)
+	entry, _ = book.Entry(outbound.ID())
+	assert.EqualValues(t, 1, entry.Connections)
+
+	r.RemovePeer(inbound, "peer not available")
+	r.RemovePeer(outbound, "peer not available")
+	book.mtx.Lock()
+	for _, e := range book.entries {
+		assert.False(t, e.isConnected())
+	}
+	book.mtx.Unlock()
+}
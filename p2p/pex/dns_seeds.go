@@ -0,0 +1,176 @@
+package pex
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// DNSSeedsSigPrefix prefixes the TXT record carrying the signature of the
+	// addresses of a DNS seed.
+	DNSSeedsSigPrefix = "sig="
+
+	// DNSSeedsExpiryPrefix prefixes the TXT record carrying the expiry of the
+	// addresses of a DNS seed, as a Unix time in seconds.
+	DNSSeedsExpiryPrefix = "exp="
+
+	// resolve the DNS seeds every this.
+	dnsSeedsPeriod = 30 * time.Minute
+
+	// maximum time to resolve a DNS seed.
+	dnsSeedsLookupTimeout = 10 * time.Second
+)
+
+// DNSResolver looks up the TXT records of DNS names. *net.Resolver implements
+// it.
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+var _ DNSResolver = (*net.Resolver)(nil)
+
+// DNSSeedsSignBytes returns the bytes the TXT records of the DNS seed name,
+// listing addrs until expiry, are signed over: name, the expiry record and the
+// sorted addrs, one per line.
+func DNSSeedsSignBytes(name string, expiry time.Time, addrs []string) []byte {
+	sorted := make([]string, len(addrs))
+	copy(sorted, addrs)
+	sort.Strings(sorted)
+	lines := append([]string{name, DNSSeedsExpiryPrefix + strconv.FormatInt(expiry.Unix(), 10)}, sorted...)
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// lookupDNSSeed returns the addresses listed by the TXT records of the DNS
+// seed name, as id@host:port entries, and their expiry. The entries must be
+// signed with pubKey, by a TXT record holding DNSSeedsSigPrefix followed by
+// the base64-encoded signature of DNSSeedsSignBytes, and must not have
+// expired at now. The expiry is given by a single TXT record holding
+// DNSSeedsExpiryPrefix followed by a Unix time in seconds. TXT records of
+// other formats are ignored.
+//
+// Entries failing to resolve into an address are skipped, and their errors
+// returned along with the addresses.
+func lookupDNSSeed(
+	ctx context.Context,
+	resolver DNSResolver,
+	name string,
+	pubKey crypto.PubKey,
+	now time.Time,
+) ([]*p2p.NetAddress, time.Time, []error, error) {
+	records, err := resolver.LookupTXT(ctx, name)
+	if err != nil {
+		return nil, time.Time{}, nil, ErrDNSSeedLookup{Name: name, Err: err}
+	}
+
+	var entries, sigs, expiries []string
+	for _, record := range records {
+		record = strings.TrimSpace(record)
+		switch {
+		case strings.HasPrefix(record, DNSSeedsSigPrefix):
+			sigs = append(sigs, strings.TrimPrefix(record, DNSSeedsSigPrefix))
+		case strings.HasPrefix(record, DNSSeedsExpiryPrefix):
+			expiries = append(expiries, strings.TrimPrefix(record, DNSSeedsExpiryPrefix))
+		case strings.Contains(record, "@"):
+			entries = append(entries, record)
+		}
+	}
+	if len(entries) == 0 {
+		return nil, time.Time{}, nil, ErrDNSSeedLookup{Name: name, Err: ErrDNSSeedNoAddresses}
+	}
+	if len(expiries) != 1 {
+		return nil, time.Time{}, nil, ErrDNSSeedLookup{Name: name, Err: ErrDNSSeedNoExpiry}
+	}
+	unix, err := strconv.ParseInt(expiries[0], 10, 64)
+	if err != nil {
+		return nil, time.Time{}, nil, ErrDNSSeedLookup{Name: name, Err: ErrDNSSeedNoExpiry}
+	}
+	expiry := time.Unix(unix, 0)
+
+	signBytes := DNSSeedsSignBytes(name, expiry, entries)
+	verified := false
+	for _, sig := range sigs {
+		sigBytes, err := base64.StdEncoding.DecodeString(sig)
+		if err == nil && pubKey.VerifySignature(signBytes, sigBytes) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, time.Time{}, nil, ErrDNSSeedSignature{Name: name}
+	}
+	if !now.Before(expiry) {
+		return nil, time.Time{}, nil, ErrDNSSeedStale{Name: name, Expiry: expiry}
+	}
+
+	addrs, errs := p2p.NewNetAddressStrings(entries)
+	return addrs, expiry, errs, nil
+}
+
+// dnsSeedsRoutine adds the addresses of the DNS seeds to the book right away,
+// so that the node can dial them if it has no peers, and then periodically.
+// (continuous)
+func (r *Reactor) dnsSeedsRoutine() {
+	defer r.peersRoutineWg.Done()
+
+	r.addDNSSeeds()
+	if !r.nodeHasSomePeersOrDialingAny() {
+		r.ensurePeers()
+	}
+
+	ticker := time.NewTicker(dnsSeedsPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.addDNSSeeds()
+		case <-r.book.Quit():
+			return
+		case <-r.Quit():
+			return
+		}
+	}
+}
+
+// addDNSSeeds adds the addresses of the DNS seeds to the book. The addresses
+// of a DNS seed are ignored if they expire before the last ones added, so
+// that older signed records cannot be replayed. (once)
+//
+// Only called from dnsSeedsRoutine.
+func (r *Reactor) addDNSSeeds() {
+	resolver := r.config.DNSResolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	for _, name := range r.config.DNSSeeds {
+		ctx, cancel := context.WithTimeout(context.Background(), dnsSeedsLookupTimeout)
+		addrs, expiry, errs, err := lookupDNSSeed(ctx, resolver, name, r.config.DNSSeedsPubKey, time.Now())
+		cancel()
+		if err == nil && expiry.Before(r.dnsSeedsExpiries[name]) {
+			err = ErrDNSSeedStale{Name: name, Expiry: expiry}
+		}
+		if err != nil {
+			r.Logger.Error("Failed to resolve DNS seed", "name", name, "err", err)
+			continue
+		}
+		r.dnsSeedsExpiries[name] = expiry
+		for _, err := range errs {
+			r.Logger.Error("Invalid address from DNS seed", "name", name, "err", err)
+		}
+
+		for _, addr := range addrs {
+			// The DNS seed is trusted as much as the address itself.
+			err := r.book.AddAddress(addr, addr)
+			r.logErrAddrBook(err)
+		}
+		r.Logger.Info("Resolved DNS seed", "name", name, "addrs", len(addrs))
+	}
+}
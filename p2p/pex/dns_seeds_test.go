@@ -0,0 +1,191 @@
+package pex
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// stubResolver resolves the TXT records of DNS names from a map.
+type stubResolver map[string][]string
+
+func (r stubResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := r[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return records, nil
+}
+
+// signedRecords returns the TXT records of the DNS seed name listing addrs,
+// expiring in an hour, signed with privKey.
+func signedRecords(t *testing.T, privKey crypto.PrivKey, name string, addrs ...string) []string {
+	t.Helper()
+	return signedRecordsExpiring(t, privKey, name, time.Now().Add(time.Hour), addrs...)
+}
+
+// signedRecordsExpiring returns the TXT records of the DNS seed name listing
+// addrs until expiry, signed with privKey.
+func signedRecordsExpiring(t *testing.T, privKey crypto.PrivKey, name string, expiry time.Time, addrs ...string) []string {
+	t.Helper()
+	sig, err := privKey.Sign(DNSSeedsSignBytes(name, expiry, addrs))
+	require.NoError(t, err)
+	records := append([]string{DNSSeedsExpiryPrefix + strconv.FormatInt(expiry.Unix(), 10)}, addrs...)
+	return append(records, DNSSeedsSigPrefix+base64.StdEncoding.EncodeToString(sig))
+}
+
+func TestLookupDNSSeed(t *testing.T) {
+	var (
+		privKey   = ed25519.GenPrivKey()
+		otherKey  = ed25519.GenPrivKey()
+		addr1     = "ed3dfd27bfc4af18f67a49862f04cc100696e84d@1.2.3.4:26656"
+		addr2     = "d824b13cb5d40fa1d8a614e089357c7eff31b670@5.6.7.8:26656"
+		badAddr   = "d824b13cb5d40fa1d8a614e089357c7eff31b670@bad.network.addr:26656"
+		now       = time.Now()
+		expiry    = now.Add(time.Hour)
+		expRecord = DNSSeedsExpiryPrefix + strconv.FormatInt(expiry.Unix(), 10)
+	)
+
+	resolver := stubResolver{
+		"seeds.example.com":     append(signedRecords(t, privKey, "seeds.example.com", addr2, addr1), "v=spf1 -all"),
+		"wrongkey.example.com":  signedRecords(t, otherKey, "wrongkey.example.com", addr1),
+		"othername.example.com": signedRecords(t, privKey, "seeds.example.com", addr1),
+		"unsigned.example.com":  {expRecord, addr1, addr2},
+		"tampered.example.com":  append(signedRecords(t, privKey, "tampered.example.com", addr1), addr2),
+		"badsig.example.com":    {expRecord, addr1, DNSSeedsSigPrefix + "not base64"},
+		"empty.example.com":     {"v=spf1 -all"},
+		"badaddr.example.com":   signedRecords(t, privKey, "badaddr.example.com", addr1, badAddr),
+		"expired.example.com":   signedRecordsExpiring(t, privKey, "expired.example.com", now.Add(-time.Second), addr1),
+		"noexpiry.example.com":  signedRecords(t, privKey, "noexpiry.example.com", addr1)[1:],
+		"extended.example.com": append(signedRecords(t, privKey, "extended.example.com", addr1),
+			DNSSeedsExpiryPrefix+strconv.FormatInt(now.Add(100*time.Hour).Unix(), 10)),
+		"moved.example.com": func() []string {
+			// The signed expiry is replaced by a later one.
+			records := signedRecords(t, privKey, "moved.example.com", addr1)
+			records[0] = DNSSeedsExpiryPrefix + strconv.FormatInt(now.Add(100*time.Hour).Unix(), 10)
+			return records
+		}(),
+	}
+
+	addrs, exp, errs, err := lookupDNSSeed(context.Background(), resolver, "seeds.example.com", privKey.PubKey(), now)
+	require.NoError(t, err)
+	assert.Equal(t, expiry.Unix(), exp.Unix())
+	assert.Empty(t, errs)
+	require.Len(t, addrs, 2)
+	assert.Equal(t, addr2, addrs[0].String())
+	assert.Equal(t, addr1, addrs[1].String())
+
+	for _, name := range []string{
+		"wrongkey.example.com",
+		"othername.example.com",
+		"unsigned.example.com",
+		"tampered.example.com",
+		"badsig.example.com",
+		"moved.example.com",
+	} {
+		_, _, _, err := lookupDNSSeed(context.Background(), resolver, name, privKey.PubKey(), now)
+		require.ErrorAs(t, err, &ErrDNSSeedSignature{}, name)
+	}
+
+	_, _, _, err = lookupDNSSeed(context.Background(), resolver, "empty.example.com", privKey.PubKey(), now)
+	require.ErrorIs(t, err, ErrDNSSeedNoAddresses)
+
+	for _, name := range []string{"noexpiry.example.com", "extended.example.com"} {
+		_, _, _, err = lookupDNSSeed(context.Background(), resolver, name, privKey.PubKey(), now)
+		require.ErrorIs(t, err, ErrDNSSeedNoExpiry, name)
+	}
+
+	_, _, _, err = lookupDNSSeed(context.Background(), resolver, "expired.example.com", privKey.PubKey(), now)
+	require.ErrorAs(t, err, &ErrDNSSeedStale{})
+
+	_, _, _, err = lookupDNSSeed(context.Background(), resolver, "unknown.example.com", privKey.PubKey(), now)
+	require.ErrorAs(t, err, &ErrDNSSeedLookup{})
+
+	addrs, _, errs, err = lookupDNSSeed(context.Background(), resolver, "badaddr.example.com", privKey.PubKey(), now)
+	require.NoError(t, err)
+	assert.Len(t, errs, 1)
+	require.Len(t, addrs, 1)
+	assert.Equal(t, addr1, addrs[0].String())
+}
+
+func TestPEXReactorUsesDNSSeeds(t *testing.T) {
+	// directory to store address books
+	dir, err := os.MkdirTemp("", "pex_reactor")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// 1. create seed
+	seed := testCreateSeed(dir, 0, []*p2p.NetAddress{}, []*p2p.NetAddress{})
+	require.NoError(t, seed.Start())
+	defer seed.Stop() //nolint:errcheck // ignore for tests
+
+	// 2. create usual peer with only the seed listed by a DNS seed.
+	privKey := ed25519.GenPrivKey()
+	peer := testCreatePeerWithConfig(dir, 1, &ReactorConfig{
+		DNSSeeds:       []string{"seeds.example.com"},
+		DNSSeedsPubKey: privKey.PubKey(),
+		DNSResolver: stubResolver{
+			"seeds.example.com": signedRecords(t, privKey, "seeds.example.com", seed.NetAddress().String()),
+		},
+	})
+	require.NoError(t, peer.Start())
+	defer peer.Stop() //nolint:errcheck // ignore for tests
+
+	// 3. check that the peer connects to seed
+	assertPeersWithTimeout(t, []*p2p.Switch{peer}, 3*time.Second, 1)
+
+	// 4. DNS seeds are not used without a key to verify them
+	peer = testCreatePeerWithConfig(dir, 2, &ReactorConfig{
+		DNSSeeds:    []string{"seeds.example.com"},
+		DNSResolver: stubResolver{},
+	})
+	require.Error(t, peer.Start())
+	peer.Stop() //nolint:errcheck // ignore for tests
+}
+
+func TestPEXReactorRejectsOlderDNSSeeds(t *testing.T) {
+	var (
+		privKey = ed25519.GenPrivKey()
+		addr1   = "ed3dfd27bfc4af18f67a49862f04cc100696e84d@1.2.3.4:26656"
+		addr2   = "d824b13cb5d40fa1d8a614e089357c7eff31b670@5.6.7.8:26656"
+		now     = time.Now()
+	)
+
+	book := NewAddrBook(filepath.Join(t.TempDir(), "addrbook.json"), false)
+	book.SetLogger(log.TestingLogger())
+	resolver := stubResolver{
+		"seeds.example.com": signedRecordsExpiring(t, privKey, "seeds.example.com", now.Add(2*time.Hour), addr1),
+	}
+	r := NewReactor(book, &ReactorConfig{
+		DNSSeeds:       []string{"seeds.example.com"},
+		DNSSeedsPubKey: privKey.PubKey(),
+		DNSResolver:    resolver,
+	})
+	r.SetLogger(log.TestingLogger())
+
+	r.addDNSSeeds()
+	assert.Equal(t, 1, book.Size())
+
+	// A replayed set of records, signed earlier, is ignored.
+	resolver["seeds.example.com"] = signedRecordsExpiring(t, privKey, "seeds.example.com", now.Add(time.Hour), addr2)
+	r.addDNSSeeds()
+	assert.Equal(t, 1, book.Size())
+
+	// A newer set is accepted.
+	resolver["seeds.example.com"] = signedRecordsExpiring(t, privKey, "seeds.example.com", now.Add(3*time.Hour), addr2)
+	r.addDNSSeeds()
+	assert.Equal(t, 2, book.Size())
+}
@@ -12,6 +12,11 @@ var (
 	ErrEmptyAddressBook = errors.New("address book is empty and couldn't resolve any seed nodes")
 	// ErrUnsolicitedList is thrown when a peer provides a list of addresses that have not been asked for.
 	ErrUnsolicitedList = errors.New("unsolicited pexAddrsMessage")
+	// ErrDNSSeedNoAddresses is thrown when the TXT records of a DNS seed list no address.
+	ErrDNSSeedNoAddresses = errors.New("no addresses in TXT records")
+	// ErrDNSSeedNoExpiry is thrown when the TXT records of a DNS seed do not
+	// hold exactly one valid expiry.
+	ErrDNSSeedNoExpiry = errors.New("no valid expiry in TXT records")
 )
 
 type ErrAddrBookNonRoutable struct {
@@ -92,6 +97,17 @@ func (err ErrAddressBanned) Error() string {
 	return fmt.Sprintf("Address: %v is currently banned", err.Addr)
 }
 
+// ErrAddrBookFull is thrown when the address book is full of addresses that
+// are not worth evicting.
+type ErrAddrBookFull struct {
+	Addr *p2p.NetAddress
+	Size int
+}
+
+func (err ErrAddrBookFull) Error() string {
+	return fmt.Sprintf("Cannot add address %v: address book is full (%d addresses)", err.Addr, err.Size)
+}
+
 // ErrReceivedPEXRequestTooSoon is thrown when a peer sends a PEX request too soon after the last one.
 type ErrReceivedPEXRequestTooSoon struct {
 	Peer         p2p.ID
@@ -144,3 +160,36 @@ func (e ErrSeedNodeConfig) Error() string {
 }
 
 func (e ErrSeedNodeConfig) Unwrap() error { return e.Err }
+
+// ErrDNSSeedLookup is thrown when the addresses of a DNS seed cannot be looked up.
+type ErrDNSSeedLookup struct {
+	Name string
+	Err  error
+}
+
+func (e ErrDNSSeedLookup) Error() string {
+	return fmt.Sprintf("failed to look up DNS seed %s: %v", e.Name, e.Err)
+}
+
+func (e ErrDNSSeedLookup) Unwrap() error { return e.Err }
+
+// ErrDNSSeedSignature is thrown when the TXT records of a DNS seed are not
+// signed with the configured key.
+type ErrDNSSeedSignature struct {
+	Name string
+}
+
+func (e ErrDNSSeedSignature) Error() string {
+	return fmt.Sprintf("TXT records of DNS seed %s are not signed with the configured key", e.Name)
+}
+
+// ErrDNSSeedStale is thrown when the signed TXT records of a DNS seed have
+// expired, or expire before the ones previously accepted.
+type ErrDNSSeedStale struct {
+	Name   string
+	Expiry time.Time
+}
+
+func (e ErrDNSSeedStale) Error() string {
+	return fmt.Sprintf("TXT records of DNS seed %s are stale (expiry %v)", e.Name, e.Expiry)
+}
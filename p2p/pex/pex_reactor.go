@@ -3,10 +3,12 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	tmp2p "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/internal/cmap"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -79,6 +81,9 @@ type Reactor struct {
 
 	// seed/crawled mode fields
 	crawlPeerInfos map[p2p.ID]crawlPeerInfo
+
+	// expiry of the last addresses added from each DNS seed
+	dnsSeedsExpiries map[string]time.Time
 }
 
 func (r *Reactor) minReceiveRequestInterval() time.Duration {
@@ -103,6 +108,16 @@ type ReactorConfig struct {
 	// Seeds is a list of addresses reactor may use
 	// if it can't connect to peers in the addrbook.
 	Seeds []string
+
+	// DNSSeeds is a list of DNS names whose TXT records list addresses the
+	// reactor adds to the addrbook, at startup and periodically. The records
+	// must be signed with DNSSeedsPubKey, and carry an expiry.
+	DNSSeeds       []string
+	DNSSeedsPubKey crypto.PubKey
+
+	// DNSResolver looks up the TXT records of DNSSeeds. If nil, the default
+	// resolver is used.
+	DNSResolver DNSResolver
 }
 
 type _attemptsToDial struct {
@@ -119,6 +134,7 @@ func NewReactor(b AddrBook, config *ReactorConfig) *Reactor {
 		requestsSent:         cmap.NewCMap(),
 		lastReceivedRequests: cmap.NewCMap(),
 		crawlPeerInfos:       make(map[p2p.ID]crawlPeerInfo),
+		dnsSeedsExpiries:     make(map[string]time.Time),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("PEX", r)
 	return r
@@ -134,12 +150,22 @@ func (r *Reactor) OnStart() error {
 	numOnline, seedAddrs, err := r.checkSeeds()
 	if err != nil {
 		return err
-	} else if numOnline == 0 && r.book.Empty() {
+	}
+	if len(r.config.DNSSeeds) > 0 && r.config.DNSSeedsPubKey == nil {
+		return ErrSeedNodeConfig{Err: errors.New("no public key to verify the DNS seeds")}
+	}
+	// The DNS seeds are resolved in the background, by dnsSeedsRoutine.
+	if numOnline == 0 && r.book.Empty() && len(r.config.DNSSeeds) == 0 {
 		return ErrEmptyAddressBook
 	}
 
 	r.seedAddrs = seedAddrs
 
+	if len(r.config.DNSSeeds) > 0 {
+		r.peersRoutineWg.Add(1)
+		go r.dnsSeedsRoutine()
+	}
+
 	r.peersRoutineWg.Add(1)
 	// Check if this node should run
 	// in seed/crawler mode
@@ -182,6 +208,9 @@ func (r *Reactor) AddPeer(p Peer) {
 	if p.IsOutbound() {
 		// For outbound peers, the address is already in the books -
 		// either via DialPeersAsync or r.Receive.
+		if recorder, ok := r.book.(connectionRecorder); ok {
+			recorder.MarkConnected(p.SocketAddr())
+		}
 		// Ask it for more peers if we need.
 		if r.book.NeedMoreAddrs() {
 			r.RequestAddrs(p)
@@ -201,6 +230,9 @@ func (r *Reactor) AddPeer(p Peer) {
 		// we don't trust inbound as much - let ensurePeersRoutine handle it.
 		err = r.book.AddAddress(addr, src)
 		r.logErrAddrBook(err)
+		if recorder, ok := r.book.(connectionRecorder); ok {
+			recorder.MarkConnected(addr)
+		}
 	}
 }
 
@@ -209,6 +241,9 @@ func (r *Reactor) RemovePeer(p Peer, _ any) {
 	id := string(p.ID())
 	r.requestsSent.Delete(id)
 	r.lastReceivedRequests.Delete(id)
+	if recorder, ok := r.book.(connectionRecorder); ok {
+		recorder.MarkDisconnected(p.ID())
+	}
 }
 
 func (r *Reactor) logErrAddrBook(err error) {
@@ -257,6 +292,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 		} else {
 			// Check we're not receiving requests too frequently.
 			if err := r.receiveRequest(e.Src); err != nil {
+				r.Switch.ReportPeerEvent(e.Src, p2p.PeerEventDuplicate)
 				r.Switch.StopPeerForError(e.Src, err)
 				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 				return
@@ -268,12 +304,14 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 		// If we asked for addresses, add them to the book
 		addrs, err := p2p.NetAddressesFromProto(msg.Addrs)
 		if err != nil {
+			r.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 			r.Switch.StopPeerForError(e.Src, err)
 			r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 			return
 		}
 		err = r.ReceiveAddrs(addrs, e.Src)
 		if err != nil {
+			r.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 			r.Switch.StopPeerForError(e.Src, err)
 			if errors.Is(err, ErrUnsolicitedList) {
 				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
@@ -463,11 +501,12 @@ func (r *Reactor) ensurePeers() {
 	// NOTE: range here is [10, 90]. Too high ?
 	newBias := cmtmath.MinInt(out, 8)*10 + 10
 
-	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	candidates := make(map[p2p.ID]*p2p.NetAddress)
+	// Try maxAttempts times to pick up to twice numToDial candidates, of which
+	// the numToDial best scored ones are dialed.
 	maxAttempts := numToDial * 3
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts && len(candidates) < 2*numToDial; i++ {
 		if !r.IsRunning() || !r.book.IsRunning() {
 			return
 		}
@@ -476,17 +515,21 @@ func (r *Reactor) ensurePeers() {
 		if try == nil {
 			continue
 		}
-		if _, selected := toDial[try.ID]; selected {
+		if _, selected := candidates[try.ID]; selected {
 			continue
 		}
 		if r.Switch.IsDialingOrExistingAddress(try) {
 			continue
 		}
+		if r.Switch.IsPeerBanned(try.ID) {
+			continue
+		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialing again, or have dialed too many times already
-		toDial[try.ID] = try
+		candidates[try.ID] = try
 	}
+	toDial := r.bestScored(candidates, numToDial)
 
 	// Dial picked addresses
 	for _, addr := range toDial {
@@ -526,6 +569,29 @@ func (r *Reactor) ensurePeers() {
 	}
 }
 
+// bestScored returns the n addresses of the best scored peers.
+func (r *Reactor) bestScored(addrs map[p2p.ID]*p2p.NetAddress, n int) map[p2p.ID]*p2p.NetAddress {
+	if len(addrs) <= n {
+		return addrs
+	}
+
+	sorted := make([]*p2p.NetAddress, 0, len(addrs))
+	scores := make(map[p2p.ID]float64, len(addrs))
+	for id, addr := range addrs {
+		sorted = append(sorted, addr)
+		scores[id] = r.Switch.PeerScore(id)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return scores[sorted[i].ID] > scores[sorted[j].ID]
+	})
+
+	best := make(map[p2p.ID]*p2p.NetAddress, n)
+	for _, addr := range sorted[:n] {
+		best[addr.ID] = addr
+	}
+	return best
+}
+
 func (r *Reactor) dialAttemptsInfo(addr *p2p.NetAddress) (attempts int, lastDialed time.Time) {
 	_attempts, ok := r.attemptsToDial.Load(addr.DialString())
 	if !ok {
@@ -553,6 +619,7 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 		}
 	}
 
+	dialStart := time.Now()
 	err := r.Switch.DialPeerWithAddress(addr)
 	if err != nil {
 		if _, ok := err.(p2p.ErrCurrentlyDialingOrExistingAddress); ok {
@@ -570,6 +637,10 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 		return ErrFailedToDial{attempts + 1, err}
 	}
 
+	if recorder, ok := r.book.(connectionRecorder); ok {
+		recorder.MarkLatency(addr, time.Since(dialStart))
+	}
+
 	// cleanup any history
 	r.attemptsToDial.Delete(addr.DialString())
 	return nil
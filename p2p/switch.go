@@ -83,6 +83,8 @@ type Switch struct {
 	nodeInfo      NodeInfo // our node info
 	nodeKey       *NodeKey // our node privkey
 	addrBook      AddrBook
+	scores        *PeerScores
+	banList       *BanList
 	// peers addresses with whom we'll maintain constant connection
 	persistentPeersAddrs []*NetAddress
 	unconditionalPeerIDs map[ID]struct{}
@@ -122,6 +124,8 @@ func NewSwitch(
 		peers:                NewPeerSet(),
 		dialing:              cmap.NewCMap(),
 		reconnecting:         cmap.NewCMap(),
+		scores:               NewPeerScores(""),
+		banList:              NewBanList(""),
 		metrics:              NopMetrics(),
 		transport:            transport,
 		filterTimeout:        defaultFilterTimeout,
@@ -152,6 +156,18 @@ func SwitchPeerFilters(filters ...PeerFilterFunc) SwitchOption {
 	return func(sw *Switch) { sw.peerFilters = filters }
 }
 
+// SwitchPeerScores sets the scores of the peers, which are kept in memory
+// only by default.
+func SwitchPeerScores(scores *PeerScores) SwitchOption {
+	return func(sw *Switch) { sw.scores = scores }
+}
+
+// SwitchBanList sets the bans of peers, which are kept in memory only by
+// default.
+func SwitchBanList(banList *BanList) SwitchOption {
+	return func(sw *Switch) { sw.banList = banList }
+}
+
 // WithMetrics sets the metrics.
 func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
@@ -242,6 +258,8 @@ func (sw *Switch) OnStart() error {
 	// Start accepting Peers.
 	go sw.acceptRoutine()
 
+	go sw.savePeerScoresRoutine()
+
 	return nil
 }
 
@@ -259,6 +277,10 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "err", err)
 		}
 	}
+
+	if err := sw.scores.Save(); err != nil {
+		sw.Logger.Error("Failed to save peer scores", "err", err)
+	}
 }
 
 // ---------------------------------------------------------------------
@@ -442,6 +464,93 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	}
 }
 
+// ReportPeerEvent changes the score of the peer by the weight of event. Peers
+// whose score drops too low are disconnected and banned for a while, unless
+// they are persistent or unconditional.
+func (sw *Switch) ReportPeerEvent(peer Peer, event PeerEvent) {
+	if !sw.scores.Report(peer.ID(), event) {
+		return
+	}
+	if peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+		sw.Logger.Info("Not banning persistent or unconditional peer despite its score",
+			"peer", peer, "score", sw.scores.Score(peer.ID()))
+		return
+	}
+	sw.StopPeerForError(peer, ErrPeerBanned{ID: peer.ID(), Reason: "low score after " + event.String()})
+}
+
+// PeerScore returns the score of the peer id.
+func (sw *Switch) PeerScore(id ID) float64 {
+	return sw.scores.Score(id)
+}
+
+// IsPeerBanned returns whether the peer id is banned, for its score or by the
+// operator.
+func (sw *Switch) IsPeerBanned(id ID) bool {
+	if _, ok := sw.banList.IDBan(id); ok {
+		return true
+	}
+	return sw.scores.IsBanned(id)
+}
+
+// BanPeer bans target, a node ID, an IP or a CIDR range, for duration, or
+// forever if duration is zero, and disconnects from the peers it matches.
+// Unlike bans for a low score, it applies to persistent and unconditional
+// peers too.
+func (sw *Switch) BanPeer(target, reason string, duration time.Duration) (Ban, error) {
+	ban, err := sw.banList.Ban(target, reason, duration)
+	if err != nil {
+		return Ban{}, err
+	}
+	sw.Logger.Info("Banned peer", "target", ban.Target, "reason", reason, "expires_at", ban.ExpiresAt)
+
+	for _, p := range sw.peers.Copy() {
+		if b, ok := sw.peerBan(p); ok {
+			sw.StopPeerForError(p, ErrPeerBanned{ID: p.ID(), Reason: b.Reason})
+		}
+	}
+
+	return ban, nil
+}
+
+// UnbanPeer lifts the ban of target, set by BanPeer.
+func (sw *Switch) UnbanPeer(target string) error {
+	if err := sw.banList.Unban(target); err != nil {
+		return err
+	}
+	sw.Logger.Info("Unbanned peer", "target", target)
+	return nil
+}
+
+// Bans returns the bans set by BanPeer in effect.
+func (sw *Switch) Bans() []Ban {
+	return sw.banList.Bans()
+}
+
+// peerBan returns the ban of the node ID or the IP of p, if any.
+func (sw *Switch) peerBan(p Peer) (Ban, bool) {
+	if addr := p.SocketAddr(); addr != nil {
+		return sw.banList.AddressBan(addr)
+	}
+	return sw.banList.IDBan(p.ID())
+}
+
+func (sw *Switch) savePeerScoresRoutine() {
+	ticker := time.NewTicker(savePeerScoresInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := sw.scores.Save(); err != nil {
+				sw.Logger.Error("Failed to save peer scores", "err", err)
+			}
+		case <-sw.Quit():
+			return
+		}
+	}
+}
+
 // ---------------------------------------------------------------------
 // Dialing
 
@@ -537,6 +646,9 @@ func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
+	if ban, ok := sw.banList.AddressBan(addr); ok {
+		return ErrPeerBanned{ID: addr.ID, Reason: ban.Reason}
+	}
 
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
@@ -676,9 +788,10 @@ func (sw *Switch) acceptRoutine() {
 		}
 
 		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) {
-			// Ignore connection if we already have enough peers.
+			// Ignore connection if we already have enough peers, unless it
+			// can replace a worse scored one.
 			_, in, _ := sw.NumPeers()
-			if in >= sw.config.MaxNumInboundPeers {
+			if in >= sw.config.MaxNumInboundPeers && !sw.evictInboundPeerFor(p) {
 				sw.Logger.Info(
 					"Ignoring inbound connection: already have enough inbound peers",
 					"address", p.SocketAddr(),
@@ -706,6 +819,32 @@ func (sw *Switch) acceptRoutine() {
 	}
 }
 
+// evictInboundPeerFor disconnects the inbound peer with the lowest score, if
+// it is lower than the score of p, to make room for p. Persistent and
+// unconditional peers are never evicted. It returns whether a peer was evicted.
+func (sw *Switch) evictInboundPeerFor(p Peer) bool {
+	var (
+		worst      Peer
+		worstScore float64
+	)
+	sw.peers.ForEach(func(peer Peer) {
+		if peer.IsOutbound() || peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+			return
+		}
+		if score := sw.scores.Score(peer.ID()); worst == nil || score < worstScore {
+			worst, worstScore = peer, score
+		}
+	})
+	if worst == nil || worstScore >= sw.scores.Score(p.ID()) {
+		return false
+	}
+
+	sw.Logger.Info("Evicting inbound peer with a lower score",
+		"peer", worst, "score", worstScore, "for", p.ID())
+	sw.StopPeerGracefully(worst)
+	return true
+}
+
 // dial the peer; make secret connection; authenticate against the dialed ID;
 // add the peer.
 // if dialing fails, start the reconnect loop. If handshake fails, it's over.
@@ -770,6 +909,16 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	// Reject peers banned by the operator.
+	if ban, ok := sw.peerBan(p); ok {
+		return ErrRejected{id: p.ID(), err: ErrPeerBanned{ID: p.ID(), Reason: ban.Reason}, isFiltered: true}
+	}
+
+	// Reject peers banned for their score, unless we want them anyway.
+	if sw.scores.IsBanned(p.ID()) && !p.IsPersistent() && !sw.IsPeerUnconditional(p.ID()) {
+		return ErrRejected{id: p.ID(), err: ErrPeerBanned{ID: p.ID(), Reason: "low score"}, isFiltered: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
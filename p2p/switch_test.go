@@ -410,6 +410,90 @@ func TestSwitchStopsNonPersistentPeerOnError(t *testing.T) {
 	assert.False(p.IsRunning())
 }
 
+func TestSwitchBansPeerWithLowScore(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, initSwitchFunc)
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	t.Cleanup(rp.Stop)
+
+	dial := func() Peer {
+		p, err := sw.transport.Dial(*rp.Addr(), peerConfig{
+			chDescs:      sw.chDescs,
+			onPeerError:  sw.StopPeerForError,
+			isPersistent: sw.IsPeerPersistent,
+			reactorsByCh: sw.reactorsByCh,
+		})
+		require.NoError(t, err)
+		return p
+	}
+
+	p := dial()
+	require.NoError(t, sw.addPeer(p))
+
+	for i := 0; i < 2; i++ {
+		sw.ReportPeerEvent(p, PeerEventInvalidMessage)
+		require.True(t, p.IsRunning(), "peer stopped before its score is low enough")
+	}
+	sw.ReportPeerEvent(p, PeerEventInvalidMessage)
+	assertNoPeersAfterTimeout(t, sw, 100*time.Millisecond)
+	assert.True(t, sw.IsPeerBanned(rp.ID()))
+	assert.Less(t, sw.PeerScore(rp.ID()), banScoreThreshold)
+
+	// The banned peer cannot be added again.
+	p = dial()
+	err := sw.addPeer(p)
+	var e ErrRejected
+	require.ErrorAs(t, err, &e)
+	assert.True(t, e.IsFiltered())
+	sw.transport.Cleanup(p)
+}
+
+func TestSwitchBanPeer(t *testing.T) {
+	sw := MakeSwitch(cfg, 1, initSwitchFunc)
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	t.Cleanup(rp.Stop)
+
+	p, err := sw.transport.Dial(*rp.Addr(), peerConfig{
+		chDescs:      sw.chDescs,
+		onPeerError:  sw.StopPeerForError,
+		isPersistent: func(*NetAddress) bool { return true },
+		reactorsByCh: sw.reactorsByCh,
+	})
+	require.NoError(t, err)
+	require.NoError(t, sw.addPeer(p))
+
+	// Bans apply to persistent peers too.
+	_, err = sw.BanPeer("127.0.0.0/8", "testing", time.Hour)
+	require.NoError(t, err)
+	assertNoPeersAfterTimeout(t, sw, 100*time.Millisecond)
+	assert.Len(t, sw.Bans(), 1)
+
+	err = sw.DialPeerWithAddress(rp.Addr())
+	require.ErrorAs(t, err, &ErrPeerBanned{})
+
+	require.NoError(t, sw.UnbanPeer("127.0.0.0/8"))
+	assert.Empty(t, sw.Bans())
+	require.NoError(t, sw.DialPeerWithAddress(rp.Addr()))
+	assert.Equal(t, 1, sw.Peers().Size())
+}
+
 func TestSwitchStopPeerForError(t *testing.T) {
 	s := httptest.NewServer(promhttp.Handler())
 	defer s.Close()
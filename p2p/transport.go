@@ -427,11 +427,77 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	nodeInfo, err = authenticatePeer(
+		c,
+		secretConn,
+		PubKeyToID(secretConn.RemotePubKey()),
+		dialedAddr,
+		mt.nodeInfo,
+		mt.handshakeTimeout,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secretConn, nodeInfo, nil
+}
+
+func (mt *MultiplexTransport) wrapPeer(
+	c net.Conn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) Peer {
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	peerConn := newPeerConn(
+		cfg.outbound,
+		persistent,
+		c,
+		socketAddr,
+	)
+
+	p := newPeer(
+		peerConn,
+		mt.mConfig,
+		ni,
+		cfg.reactorsByCh,
+		cfg.msgTypeByChID,
+		cfg.chDescs,
+		cfg.onPeerError,
+		cfg.mlc,
+		PeerMetrics(cfg.metrics),
+	)
+
+	return p
+}
+
+// authenticatePeer checks that connID, the ID authenticated on the connection
+// c, is the dialed ID for outgoing connections. It then exchanges node infos
+// over hc, the authenticated connection, and checks that the peer's is valid,
+// matches connID and is compatible with ourNodeInfo.
+func authenticatePeer(
+	c net.Conn,
+	hc net.Conn,
+	connID ID,
+	dialedAddr *NetAddress,
+	ourNodeInfo NodeInfo,
+	timeout time.Duration,
+) (NodeInfo, error) {
 	// For outgoing conns, ensure connection key matches dialed key.
-	connID := PubKeyToID(secretConn.RemotePubKey())
 	if dialedAddr != nil {
 		if dialedID := dialedAddr.ID; connID != dialedID {
-			return nil, nil, ErrRejected{
+			return nil, ErrRejected{
 				conn: c,
 				id:   connID,
 				err: fmt.Errorf(
@@ -444,9 +510,9 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	nodeInfo, err := handshake(hc, timeout, ourNodeInfo)
 	if err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:          c,
 			err:           fmt.Errorf("handshake failed: %w", err),
 			isAuthFailure: true,
@@ -454,7 +520,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -463,7 +529,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Ensure connection key matches self reported key.
 	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -476,8 +542,8 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	// Reject self.
-	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+	if ourNodeInfo.ID() == nodeInfo.ID() {
+		return nil, ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -485,8 +551,8 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+	if err := ourNodeInfo.CompatibleWith(nodeInfo); err != nil {
+		return nil, ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -494,47 +560,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
-}
-
-func (mt *MultiplexTransport) wrapPeer(
-	c net.Conn,
-	ni NodeInfo,
-	cfg peerConfig,
-	socketAddr *NetAddress,
-) Peer {
-	persistent := false
-	if cfg.isPersistent != nil {
-		if cfg.outbound {
-			persistent = cfg.isPersistent(socketAddr)
-		} else {
-			selfReportedAddr, err := ni.NetAddress()
-			if err == nil {
-				persistent = cfg.isPersistent(selfReportedAddr)
-			}
-		}
-	}
-
-	peerConn := newPeerConn(
-		cfg.outbound,
-		persistent,
-		c,
-		socketAddr,
-	)
-
-	p := newPeer(
-		peerConn,
-		mt.mConfig,
-		ni,
-		cfg.reactorsByCh,
-		cfg.msgTypeByChID,
-		cfg.chDescs,
-		cfg.onPeerError,
-		cfg.mlc,
-		PeerMetrics(cfg.metrics),
-	)
-
-	return p
+	return nodeInfo, nil
 }
 
 func handshake(
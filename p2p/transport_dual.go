@@ -0,0 +1,145 @@
+package p2p
+
+import (
+	"errors"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// DualTransport accepts both TCP and QUIC connections, and dials peers with
+// QUIC unless they are known not to support it, falling back to TCP. Thus,
+// nodes with the QUIC transport can be part of networks where some nodes only
+// support TCP.
+//
+// A peer is known not to support QUIC when it does not advertise it in its
+// NodeInfo, or when it could not be dialed with QUIC.
+type DualTransport struct {
+	tcp  *MultiplexTransport
+	quic *QUICTransport
+
+	mtx     cmtsync.Mutex
+	tcpOnly map[ID]struct{}
+}
+
+// Test DualTransport for interface completeness.
+var (
+	_ Transport          = (*DualTransport)(nil)
+	_ transportLifecycle = (*DualTransport)(nil)
+)
+
+// NewDualTransport returns a transport combining tcp and quic. Both must have
+// the same NodeInfo, with both transports, and share their connection set, so
+// that a peer is connected by at most one of them.
+func NewDualTransport(tcp *MultiplexTransport, quic *QUICTransport) *DualTransport {
+	quic.conns = tcp.conns
+	return &DualTransport{
+		tcp:     tcp,
+		quic:    quic,
+		tcpOnly: make(map[ID]struct{}),
+	}
+}
+
+// NetAddress implements Transport.
+func (dt *DualTransport) NetAddress() NetAddress {
+	return dt.tcp.NetAddress()
+}
+
+// Accept implements Transport.
+func (dt *DualTransport) Accept(cfg peerConfig) (Peer, error) {
+	cfg.outbound = false
+
+	select {
+	case a := <-dt.tcp.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+		dt.learnTransports(a.nodeInfo)
+
+		return dt.tcp.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+	case a := <-dt.quic.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		return dt.quic.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+	case <-dt.tcp.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (dt *DualTransport) Dial(addr NetAddress, cfg peerConfig) (Peer, error) {
+	if !dt.isTCPOnly(addr.ID) {
+		p, err := dt.quic.Dial(addr, cfg)
+		if err == nil {
+			return p, nil
+		}
+		// The peer was reached, but rejected: TCP would not do better.
+		if errors.As(err, &ErrRejected{}) {
+			return nil, err
+		}
+		dt.setTCPOnly(addr.ID, true)
+	}
+
+	p, err := dt.tcp.Dial(addr, cfg)
+	if err != nil {
+		return nil, err
+	}
+	dt.learnTransports(p.NodeInfo())
+
+	return p, nil
+}
+
+// Cleanup implements Transport.
+func (dt *DualTransport) Cleanup(p Peer) {
+	// Both transports share the connection set, and close the peer connection
+	// the same way.
+	dt.tcp.Cleanup(p)
+}
+
+// Close implements transportLifecycle.
+func (dt *DualTransport) Close() error {
+	return errors.Join(dt.tcp.Close(), dt.quic.Close())
+}
+
+// Listen implements transportLifecycle. It listens for TCP connections on the
+// TCP port of addr, and for QUIC connections on its UDP port.
+func (dt *DualTransport) Listen(addr NetAddress) error {
+	if err := dt.tcp.Listen(addr); err != nil {
+		return err
+	}
+	return dt.quic.Listen(addr)
+}
+
+// AddChannel registers a channel to nodeInfo.
+func (dt *DualTransport) AddChannel(chID byte) {
+	dt.tcp.AddChannel(chID)
+	dt.quic.AddChannel(chID)
+}
+
+// learnTransports records whether the peer of nodeInfo, connected with TCP,
+// supports QUIC.
+func (dt *DualTransport) learnTransports(nodeInfo NodeInfo) {
+	ni, ok := nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return
+	}
+	dt.setTCPOnly(ni.ID(), !ni.SupportsTransport(TransportQUIC))
+}
+
+func (dt *DualTransport) isTCPOnly(id ID) bool {
+	dt.mtx.Lock()
+	defer dt.mtx.Unlock()
+	_, ok := dt.tcpOnly[id]
+	return ok
+}
+
+func (dt *DualTransport) setTCPOnly(id ID, tcpOnly bool) {
+	dt.mtx.Lock()
+	defer dt.mtx.Unlock()
+	if tcpOnly {
+		dt.tcpOnly[id] = struct{}{}
+	} else {
+		delete(dt.tcpOnly, id)
+	}
+}
@@ -0,0 +1,491 @@
+package p2p
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+// quicALPN is the application protocol negotiated by QUIC peers.
+const quicALPN = "cometbft"
+
+// quicMaxIncomingStreams is the maximum number of unidirectional streams a
+// peer can open, one per channel.
+const quicMaxIncomingStreams = 256
+
+// QUICTransportOption sets an optional parameter on the QUICTransport.
+type QUICTransportOption func(*QUICTransport)
+
+// QUICTransportConnFilters sets the filters for rejection new connections.
+func QUICTransportConnFilters(filters ...ConnFilterFunc) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.connFilters = filters }
+}
+
+// QUICTransportFilterTimeout sets the timeout waited for filter calls to
+// return.
+func QUICTransportFilterTimeout(timeout time.Duration) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.filterTimeout = timeout }
+}
+
+// QUICTransportResolver sets the Resolver used for ip lookups, defaults to
+// net.DefaultResolver.
+func QUICTransportResolver(resolver IPResolver) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.resolver = resolver }
+}
+
+// QUICTransportMaxIncomingConnections sets the maximum number of
+// simultaneous connections (incoming). Default: 0 (unlimited).
+func QUICTransportMaxIncomingConnections(n int) QUICTransportOption {
+	return func(qt *QUICTransport) { qt.maxIncomingConnections = int32(n) }
+}
+
+// QUICTransport accepts and dials QUIC connections and upgrades them to peers,
+// whose channels are sent on distinct streams of the connection.
+//
+// Peers are authenticated by TLS 1.3, with self-signed certificates of their
+// node keys. Node infos are then exchanged on a bidirectional control stream,
+// opened by the dialer.
+type QUICTransport struct {
+	netAddr                NetAddress
+	listener               *quic.Listener
+	maxIncomingConnections int32 // see MaxIncomingConnections
+	numIncomingConnections int32 // atomic
+
+	acceptc chan accept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       ConnSet
+	connFilters []ConnFilterFunc
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeInfo         NodeInfo
+	nodeKey          NodeKey
+	resolver         IPResolver
+
+	mConfig conn.MConnConfig
+}
+
+// Test QUICTransport for interface completeness.
+var (
+	_ Transport          = (*QUICTransport)(nil)
+	_ transportLifecycle = (*QUICTransport)(nil)
+)
+
+// NewQUICTransport returns a QUIC connected multiplexed peer.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) *QUICTransport {
+	return &QUICTransport{
+		acceptc:          make(chan accept),
+		closec:           make(chan struct{}),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		mConfig:          mConfig,
+		nodeInfo:         nodeInfo,
+		nodeKey:          nodeKey,
+		conns:            NewConnSet(),
+		resolver:         net.DefaultResolver,
+	}
+}
+
+// NetAddress implements Transport.
+func (qt *QUICTransport) NetAddress() NetAddress {
+	return qt.netAddr
+}
+
+// Accept implements Transport.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	select {
+	case a := <-qt.acceptc:
+		if a.err != nil {
+			return nil, a.err
+		}
+
+		cfg.outbound = false
+
+		return qt.wrapPeer(a.conn, a.nodeInfo, cfg, a.netAddr), nil
+	case <-qt.closec:
+		return nil, ErrTransportClosed{}
+	}
+}
+
+// Dial implements Transport.
+func (qt *QUICTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	tlsConf, err := quicTLSConfig(qt.nodeKey.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout)
+	defer cancel()
+	qc, err := quic.DialAddr(ctx, addr.DialString(), tlsConf, qt.quicConfig())
+	if err != nil {
+		return nil, err
+	}
+	stream, err := qc.OpenStream()
+	if err != nil {
+		_ = qc.CloseWithError(conn.QUICErrorCodeNoError, "")
+		return nil, err
+	}
+	c := &quicConn{Stream: stream, conn: qc}
+
+	if err := qt.filterConn(c); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgrade(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr), nil
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	close(qt.closec)
+
+	if qt.listener != nil {
+		return qt.listener.Close()
+	}
+
+	return nil
+}
+
+// Listen implements transportLifecycle. It listens for QUIC connections on the
+// UDP port of addr.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	tlsConf, err := quicTLSConfig(qt.nodeKey.PrivKey)
+	if err != nil {
+		return err
+	}
+
+	ln, err := quic.ListenAddr(addr.DialString(), tlsConf, qt.quicConfig())
+	if err != nil {
+		return err
+	}
+
+	qt.netAddr = addr
+	qt.listener = ln
+
+	go qt.acceptPeers()
+
+	return nil
+}
+
+// AddChannel registers a channel to nodeInfo.
+// NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated.
+func (qt *QUICTransport) AddChannel(chID byte) {
+	if ni, ok := qt.nodeInfo.(DefaultNodeInfo); ok {
+		if !ni.HasChannel(chID) {
+			ni.Channels = append(ni.Channels, chID)
+		}
+		qt.nodeInfo = ni
+	}
+}
+
+func (qt *QUICTransport) acceptPeers() {
+	for {
+		qc, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- accept{err: err}
+			return
+		}
+
+		if qt.maxIncomingConnections > 0 &&
+			atomic.AddInt32(&qt.numIncomingConnections, 1) > qt.maxIncomingConnections {
+			atomic.AddInt32(&qt.numIncomingConnections, -1)
+			_ = qc.CloseWithError(conn.QUICErrorCodeNoError, "too many connections")
+			continue
+		}
+
+		go func(qc quic.Connection) {
+			if qt.maxIncomingConnections > 0 {
+				defer atomic.AddInt32(&qt.numIncomingConnections, -1)
+			}
+
+			var (
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+				c        *quicConn
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+			stream, err := qc.AcceptStream(ctx)
+			cancel()
+			if err != nil {
+				_ = qc.CloseWithError(conn.QUICErrorCodeNoError, "")
+				err = ErrRejected{
+					err:           fmt.Errorf("control stream not opened: %w", err),
+					isAuthFailure: true,
+				}
+			} else {
+				c = &quicConn{Stream: stream, conn: qc}
+				err = qt.filterConn(c)
+				if err == nil {
+					nodeInfo, err = qt.upgrade(c, nil)
+					if err == nil {
+						netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+					}
+				}
+			}
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = qc.CloseWithError(conn.QUICErrorCodeNoError, "")
+				return
+			}
+
+			// Hold the incoming connection slot until the connection is closed.
+			<-qc.Context().Done()
+		}(qc)
+	}
+}
+
+// Cleanup removes the given address from the connections set and
+// closes the connection.
+func (qt *QUICTransport) Cleanup(p Peer) {
+	qt.conns.RemoveAddr(p.RemoteAddr())
+	_ = p.CloseConn()
+}
+
+func (qt *QUICTransport) cleanup(c net.Conn) error {
+	qt.conns.Remove(c)
+
+	return c.Close()
+}
+
+func (qt *QUICTransport) filterConn(c net.Conn) (err error) {
+	defer func() {
+		if err != nil {
+			_ = c.Close()
+		}
+	}()
+
+	// Reject if connection is already present.
+	if qt.conns.Has(c) {
+		return ErrRejected{conn: c, isDuplicate: true}
+	}
+
+	// Resolve ips for incoming conn.
+	ips, err := resolveIPs(qt.resolver, c)
+	if err != nil {
+		return err
+	}
+
+	errc := make(chan error, len(qt.connFilters))
+
+	for _, f := range qt.connFilters {
+		go func(f ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
+			errc <- f(qt.conns, c, ips)
+		}(f, c, ips, errc)
+	}
+
+	for i := 0; i < cap(errc); i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return ErrRejected{conn: c, err: err, isFiltered: true}
+			}
+		case <-time.After(qt.filterTimeout):
+			return ErrFilterTimeout{}
+		}
+	}
+
+	qt.conns.Set(c, ips)
+
+	return nil
+}
+
+// upgrade authenticates the peer of c by its TLS certificate, and exchanges
+// node infos on the control stream of c.
+func (qt *QUICTransport) upgrade(
+	c *quicConn,
+	dialedAddr *NetAddress,
+) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	connID, err := quicPeerID(c.conn)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           err,
+			isAuthFailure: true,
+		}
+	}
+
+	return authenticatePeer(c, c, connID, dialedAddr, qt.nodeInfo, qt.handshakeTimeout)
+}
+
+func (qt *QUICTransport) wrapPeer(
+	c net.Conn,
+	ni NodeInfo,
+	cfg peerConfig,
+	socketAddr *NetAddress,
+) Peer {
+	persistent := false
+	if cfg.isPersistent != nil {
+		if cfg.outbound {
+			persistent = cfg.isPersistent(socketAddr)
+		} else {
+			selfReportedAddr, err := ni.NetAddress()
+			if err == nil {
+				persistent = cfg.isPersistent(selfReportedAddr)
+			}
+		}
+	}
+
+	peerConn := newPeerConn(
+		cfg.outbound,
+		persistent,
+		c,
+		socketAddr,
+	)
+
+	return newQUICPeer(
+		peerConn,
+		c.(*quicConn).conn,
+		qt.mConfig,
+		ni,
+		cfg.reactorsByCh,
+		cfg.msgTypeByChID,
+		cfg.chDescs,
+		cfg.onPeerError,
+		cfg.mlc,
+		PeerMetrics(cfg.metrics),
+	)
+}
+
+// quicConfig returns the configuration of the QUIC connections. The keep-alive
+// and idle timeout replace the pings and pongs of MConnection.
+func (qt *QUICTransport) quicConfig() *quic.Config {
+	return &quic.Config{
+		HandshakeIdleTimeout:  qt.handshakeTimeout,
+		MaxIdleTimeout:        qt.mConfig.PingInterval + qt.mConfig.PongTimeout,
+		KeepAlivePeriod:       qt.mConfig.PingInterval,
+		MaxIncomingStreams:    1, // the control stream
+		MaxIncomingUniStreams: quicMaxIncomingStreams,
+	}
+}
+
+// quicTLSConfig returns the TLS configuration of a node, whose certificate is
+// self-signed with its ed25519 node key. The certificates of the peers are not
+// verified against any authority; instead, the peer is identified by the key
+// of its certificate (see quicPeerID).
+func quicTLSConfig(privKey crypto.PrivKey) (*tls.Config, error) {
+	edKey, ok := privKey.(cmted25519.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("QUIC transport requires an ed25519 node key, got %s", privKey.Type())
+	}
+	key := ed25519.PrivateKey(edKey)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(100 * 365 * 24 * time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{cert},
+			PrivateKey:  key,
+		}},
+		ClientAuth:         tls.RequireAnyClientCert,
+		InsecureSkipVerify: true, //nolint:gosec // peers are authenticated by ID
+		MinVersion:         tls.VersionTLS13,
+		NextProtos:         []string{quicALPN},
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) != 1 {
+				return fmt.Errorf("expected 1 certificate, got %d", len(rawCerts))
+			}
+			cert, err := x509.ParseCertificate(rawCerts[0])
+			if err != nil {
+				return err
+			}
+			if _, ok := cert.PublicKey.(ed25519.PublicKey); !ok {
+				return fmt.Errorf("expected an ed25519 certificate key, got %T", cert.PublicKey)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// quicPeerID returns the ID of the peer of qc, derived from the key of its
+// certificate. TLS guarantees the peer owns the key.
+func quicPeerID(qc quic.Connection) (ID, error) {
+	certs := qc.ConnectionState().TLS.PeerCertificates
+	if len(certs) == 0 {
+		return "", errors.New("no peer certificate")
+	}
+	key, ok := certs[0].PublicKey.(ed25519.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("expected an ed25519 certificate key, got %T", certs[0].PublicKey)
+	}
+	return PubKeyToID(cmted25519.PubKey(key)), nil
+}
+
+// quicConn is the control stream of a QUIC connection, over which node infos
+// are exchanged, as a net.Conn. Closing it closes the QUIC connection.
+type quicConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+// LocalAddr implements net.Conn.
+func (c *quicConn) LocalAddr() net.Addr { return c.conn.LocalAddr() }
+
+// RemoteAddr implements net.Conn.
+func (c *quicConn) RemoteAddr() net.Addr { return c.conn.RemoteAddr() }
+
+// Close implements net.Conn.
+func (c *quicConn) Close() error {
+	return c.conn.CloseWithError(conn.QUICErrorCodeNoError, "")
+}
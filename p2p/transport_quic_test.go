@@ -0,0 +1,160 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p2p "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+func newQUICTransport(nodeInfo NodeInfo, nodeKey NodeKey) *QUICTransport {
+	return NewQUICTransport(nodeInfo, nodeKey, conn.DefaultMConnConfig())
+}
+
+func testSetupQUICTransport(t *testing.T) *QUICTransport {
+	t.Helper()
+	var (
+		pv = ed25519.GenPrivKey()
+		id = PubKeyToID(pv.PubKey())
+		qt = newQUICTransport(
+			testNodeInfo(id, "transport"),
+			NodeKey{PrivKey: pv},
+		)
+	)
+
+	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+	t.Cleanup(func() { _ = qt.Close() })
+
+	return qt
+}
+
+// testQUICPeerConfig returns a peerConfig whose test channel is received by
+// reactor.
+func testQUICPeerConfig(reactor *TestReactor) peerConfig {
+	return peerConfig{
+		chDescs:       reactor.GetChannels(),
+		onPeerError:   func(Peer, any) {},
+		reactorsByCh:  map[byte]Reactor{testCh: reactor},
+		msgTypeByChID: map[byte]proto.Message{testCh: &p2p.Message{}},
+		metrics:       NopMetrics(),
+		mlc:           newMetricsLabelCache(),
+	}
+}
+
+func TestTransportQUICDialAccept(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+
+	var (
+		pv     = ed25519.GenPrivKey()
+		dialer = newQUICTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), defaultNodeName),
+			NodeKey{PrivKey: pv},
+		)
+		chDescs         = []*conn.ChannelDescriptor{{ID: testCh, Priority: 1}}
+		acceptorReactor = NewTestReactor(chDescs, true)
+		dialerReactor   = NewTestReactor(chDescs, true)
+		addr            = NewNetAddress(qt.nodeInfo.ID(), qt.listener.Addr())
+	)
+
+	acceptedc := make(chan Peer)
+	errc := make(chan error)
+	go func() {
+		p, err := qt.Accept(testQUICPeerConfig(acceptorReactor))
+		if err != nil {
+			errc <- err
+			return
+		}
+		acceptedc <- p
+	}()
+
+	dialed, err := dialer.Dial(*addr, testQUICPeerConfig(dialerReactor))
+	require.NoError(t, err)
+
+	var accepted Peer
+	select {
+	case accepted = <-acceptedc:
+	case err := <-errc:
+		t.Fatal(err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the dialed peer to be accepted")
+	}
+
+	assert.True(t, dialed.IsOutbound())
+	assert.False(t, accepted.IsOutbound())
+	assert.Equal(t, qt.nodeInfo.ID(), dialed.ID())
+	assert.Equal(t, dialer.nodeInfo.ID(), accepted.ID())
+
+	for _, p := range []Peer{dialed, accepted} {
+		p.SetLogger(log.TestingLogger())
+		require.NoError(t, p.Start())
+		t.Cleanup(func() { _ = p.Stop() })
+	}
+
+	for i := 0; i < 3; i++ {
+		require.True(t, dialed.Send(Envelope{ChannelID: testCh, Message: &p2p.PexRequest{}}))
+	}
+	require.True(t, accepted.Send(Envelope{ChannelID: testCh, Message: &p2p.PexRequest{}}))
+
+	assert.Eventually(t, func() bool {
+		return len(acceptorReactor.getMsgs(testCh)) == 3 && len(dialerReactor.getMsgs(testCh)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTransportQUICDialRejectWrongID(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+
+	var (
+		pv     = ed25519.GenPrivKey()
+		dialer = newQUICTransport(
+			testNodeInfo(PubKeyToID(pv.PubKey()), defaultNodeName),
+			NodeKey{PrivKey: pv},
+		)
+	)
+
+	wrongID := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	addr := NewNetAddress(wrongID, qt.listener.Addr())
+
+	_, err := dialer.Dial(*addr, peerConfig{})
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsAuthFailure())
+}
+
+func TestDualTransportDialFallsBackToTCP(t *testing.T) {
+	mt := testSetupMultiplexTransport(t)
+	t.Cleanup(func() { _ = mt.Close() })
+
+	var (
+		pv       = ed25519.GenPrivKey()
+		nodeInfo = testNodeInfo(PubKeyToID(pv.PubKey()), defaultNodeName).(DefaultNodeInfo)
+	)
+	nodeInfo.Transports = []string{TransportTCP, TransportQUIC}
+	dt := NewDualTransport(
+		newMultiplexTransport(nodeInfo, NodeKey{PrivKey: pv}),
+		newQUICTransport(nodeInfo, NodeKey{PrivKey: pv}),
+	)
+
+	go func() {
+		_, _ = mt.Accept(peerConfig{})
+	}()
+
+	addr := NewNetAddress(mt.nodeInfo.ID(), mt.listener.Addr())
+	p, err := dt.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p.CloseConn() })
+
+	_, ok := p.RemoteAddr().(*net.TCPAddr)
+	assert.True(t, ok, "expected a TCP peer, got %v", p.RemoteAddr())
+	assert.True(t, dt.isTCPOnly(addr.ID))
+}
@@ -299,6 +299,19 @@ func (c *baseRPCClient) UnconfirmedTx(
 	return result, nil
 }
 
+func (c *baseRPCClient) TxStatus(
+	ctx context.Context,
+	hash []byte,
+) (*ctypes.ResultTxStatus, error) {
+	result := new(ctypes.ResultTxStatus)
+	params := map[string]any{"hash": hash}
+	_, err := c.caller.Call(ctx, "tx_status", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) UnconfirmedTxs(
 	ctx context.Context,
 	limit *int,
@@ -351,6 +364,15 @@ func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*ctypes.ResultD
 	return result, nil
 }
 
+func (c *baseRPCClient) ValidatorUptime(ctx context.Context) (*ctypes.ResultValidatorUptime, error) {
+	result := new(ctypes.ResultValidatorUptime)
+	_, err := c.caller.Call(ctx, "validator_uptime", map[string]any{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error) {
 	result := new(ctypes.ResultConsensusState)
 	_, err := c.caller.Call(ctx, "consensus_state", map[string]any{}, result)
@@ -376,6 +398,22 @@ func (c *baseRPCClient) ConsensusParams(
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTimeline(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultConsensusTimeline, error) {
+	result := new(ctypes.ResultConsensusTimeline)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_timeline", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call(ctx, "health", map[string]any{}, result)
@@ -110,8 +110,10 @@ type StatusClient interface {
 type NetworkClient interface {
 	NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error)
+	ValidatorUptime(ctx context.Context) (*ctypes.ResultValidatorUptime, error)
 	ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
+	ConsensusTimeline(ctx context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error)
 	Health(ctx context.Context) (*ctypes.ResultHealth, error)
 }
 
@@ -138,6 +140,7 @@ type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error)
 	CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
+	TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
@@ -114,6 +114,10 @@ func (c *Local) UnconfirmedTx(_ context.Context, hash []byte) (*ctypes.ResultUnc
 	return c.env.UnconfirmedTx(c.ctx, hash)
 }
 
+func (c *Local) TxStatus(_ context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	return c.env.TxStatus(c.ctx, hash)
+}
+
 func (c *Local) UnconfirmedTxs(_ context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.env.UnconfirmedTxs(c.ctx, limit)
 }
@@ -134,6 +138,10 @@ func (c *Local) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensus
 	return c.env.DumpConsensusState(c.ctx)
 }
 
+func (c *Local) ValidatorUptime(context.Context) (*ctypes.ResultValidatorUptime, error) {
+	return c.env.ValidatorUptime(c.ctx)
+}
+
 func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
 	return c.env.GetConsensusState(c.ctx)
 }
@@ -142,6 +150,10 @@ func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.Resul
 	return c.env.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) ConsensusTimeline(_ context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+	return c.env.ConsensusTimeline(c.ctx, height)
+}
+
 func (c *Local) Health(context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(c.ctx)
 }
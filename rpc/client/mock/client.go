@@ -128,6 +128,10 @@ func (c Client) ConsensusState(_ context.Context) (*ctypes.ResultConsensusState,
 	return c.env.GetConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ValidatorUptime(_ context.Context) (*ctypes.ResultValidatorUptime, error) {
+	return c.env.ValidatorUptime(&rpctypes.Context{})
+}
+
 func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsensusState, error) {
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
@@ -136,6 +140,10 @@ func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.Resul
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
 
+func (c Client) ConsensusTimeline(_ context.Context, height *int64) (*ctypes.ResultConsensusTimeline, error) {
+	return c.env.ConsensusTimeline(&rpctypes.Context{}, height)
+}
+
 func (c Client) Health(_ context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(&rpctypes.Context{})
 }
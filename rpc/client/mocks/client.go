@@ -390,6 +390,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTimeline provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTimeline
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTimeline); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTimeline)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -797,6 +820,29 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	return r0, r1
 }
 
+// TxStatus provides a mock function with given fields: ctx, hash
+func (_m *Client) TxStatus(ctx context.Context, hash []byte) (*coretypes.ResultTxStatus, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultTxStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultTxStatus); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnconfirmedTx provides a mock function with given fields: ctx, hash
 func (_m *Client) UnconfirmedTx(ctx context.Context, hash []byte) (*coretypes.ResultUnconfirmedTx, error) {
 	ret := _m.Called(ctx, hash)
@@ -871,6 +917,29 @@ func (_m *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return r0
 }
 
+// ValidatorUptime provides a mock function with given fields: _a0
+func (_m *Client) ValidatorUptime(_a0 context.Context) (*coretypes.ResultValidatorUptime, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultValidatorUptime
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultValidatorUptime); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultValidatorUptime)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validators provides a mock function with given fields: ctx, height, page, perPage
 func (_m *Client) Validators(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultValidators, error) {
 	ret := _m.Called(ctx, height, page, perPage)
@@ -151,6 +151,23 @@ func TestConsensusState(t *testing.T) {
 	}
 }
 
+func TestValidatorUptime(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		// the LastCommit of the blocks after the first one is recorded
+		err := client.WaitForHeight(c, 3, nil)
+		require.NoError(t, err, "%d: %+v", i, err)
+		res, err := nc.ValidatorUptime(context.Background())
+		require.NoError(t, err, "%d: %+v", i, err)
+		require.Len(t, res.Validators, 1)
+		assert.Positive(t, res.Validators[0].Signed)
+		assert.Zero(t, res.Validators[0].Missed)
+		require.NotEmpty(t, res.Heights)
+		assert.Equal(t, "x", res.Heights[0].Signed)
+	}
+}
+
 func TestHealth(t *testing.T) {
 	for i, c := range GetClients() {
 		nc, ok := c.(client.NetworkClient)
@@ -470,6 +487,30 @@ func TestCheckTx(t *testing.T) {
 	}
 }
 
+func TestTxStatus(t *testing.T) {
+	c := getHTTPClient()
+	_, _, committedTx := MakeTxKV()
+	bres, err := c.BroadcastTxCommit(context.Background(), committedTx)
+	require.NoError(t, err)
+
+	for _, c := range GetClients() {
+		mc := c.(client.MempoolClient)
+
+		res, err := mc.TxStatus(context.Background(), bres.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, ctypes.TxStatusCommitted, res.Status)
+		assert.Equal(t, bres.Height, res.Height)
+		assert.Zero(t, res.Index)
+
+		res, err = mc.TxStatus(context.Background(), types.Tx("a different tx").Hash())
+		require.NoError(t, err)
+		assert.Equal(t, ctypes.TxStatusUnknown, res.Status)
+
+		_, err = mc.TxStatus(context.Background(), nil)
+		require.Error(t, err)
+	}
+}
+
 func TestTx(t *testing.T) {
 	// first we broadcast a tx
 	c := getHTTPClient()
@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 
+	"github.com/cometbft/cometbft/internal/bits"
 	cm "github.com/cometbft/cometbft/internal/consensus"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	"github.com/cometbft/cometbft/p2p"
@@ -53,6 +54,56 @@ func (env *Environment) Validators(
 	}, nil
 }
 
+// ValidatorUptime returns, for each validator in the validator set at any of
+// the last heights tracked, the number of heights at which it signed the
+// commit for the block, signed nil or missed it, along with, for each height,
+// bitmaps of the validators that signed for the block and for nil, indexed as
+// in the validator set of the height.
+// More: https://docs.cometbft.com/main/rpc/#/Info/validator_uptime
+func (env *Environment) ValidatorUptime(*rpctypes.Context) (*ctypes.ResultValidatorUptime, error) {
+	if env.ValidatorUptimeTracker == nil {
+		return nil, ErrValidatorUptimeDisabled
+	}
+
+	uptimes, heights := env.ValidatorUptimeTracker.Uptimes()
+	res := &ctypes.ResultValidatorUptime{
+		Window:     env.ValidatorUptimeTracker.Window(),
+		Validators: make([]ctypes.ValidatorUptime, len(uptimes)),
+		Heights:    make([]ctypes.HeightSignatures, len(heights)),
+	}
+	for i, vu := range uptimes {
+		res.Validators[i] = ctypes.ValidatorUptime{
+			Address:   vu.Address,
+			Signed:    vu.Signed,
+			SignedNil: vu.SignedNil,
+			Missed:    vu.Missed,
+			Uptime:    vu.Uptime(),
+		}
+	}
+	for i, h := range heights {
+		res.Heights[i] = ctypes.HeightSignatures{
+			Height:    h.Height,
+			Signed:    bitmap(h.Signed),
+			SignedNil: bitmap(h.SignedNil),
+		}
+	}
+	return res, nil
+}
+
+// bitmap returns bA as a string with, for each bit, x if it is set or _ if
+// not.
+func bitmap(bA *bits.BitArray) string {
+	bz := make([]byte, bA.Size())
+	for i := range bz {
+		if bA.GetIndex(i) {
+			bz[i] = 'x'
+		} else {
+			bz[i] = '_'
+		}
+	}
+	return string(bz)
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.cometbft.com/main/rpc/#/Info/dump_consensus_state
@@ -101,6 +152,32 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTimeline returns the consensus timeline recorded by the node at the
+// given height, i.e. its step transitions and when it received the proposal,
+// the votes and the block parts, and executed the block. If no height is
+// provided, it returns the timeline of the last complete height. Only the
+// timelines of the last heights are kept, and only if the timeline trace is
+// enabled.
+// UNSTABLE
+// More: https://docs.cometbft.com/main/rpc/#/Info/consensus_timeline
+func (env *Environment) ConsensusTimeline(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+) (*ctypes.ResultConsensusTimeline, error) {
+	var height int64
+	if heightPtr != nil {
+		height = *heightPtr
+		if height <= 0 {
+			return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+		}
+	}
+	bz, err := env.ConsensusState.GetTimelineJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusTimeline{Timeline: bz}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/main/rpc/#/Info/consensus_params
@@ -40,6 +40,7 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetTimelineJSON(height int64) ([]byte, error)
 }
 
 type transport interface {
@@ -54,6 +55,10 @@ type peers interface {
 	AddPrivatePeerIDs(peerIDs []string) error
 	DialPeersAsync(peers []string) error
 	Peers() p2p.IPeerSet
+	PeerScore(id p2p.ID) float64
+	BanPeer(target, reason string, duration time.Duration) (p2p.Ban, error)
+	UnbanPeer(target string) error
+	Bans() []p2p.Ban
 }
 
 // A reactor that transitions from block sync or state sync to consensus mode.
@@ -86,6 +91,9 @@ type Environment struct {
 	EventBus     *types.EventBus // thread safe
 	Mempool      mempl.Mempool
 
+	// nil if validator uptime tracking is disabled
+	ValidatorUptimeTracker *sm.ValidatorUptimeTracker // thread safe
+
 	Logger log.Logger
 
 	Config cfg.RPCConfig
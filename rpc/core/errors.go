@@ -18,6 +18,7 @@ var (
 	ErrGenesisRespSize         = errors.New("genesis response is too large, please use the genesis_chunked API instead")
 	ErrChunkNotInitialized     = errors.New("genesis chunks are not initialized")
 	ErrNoChunks                = errors.New("no chunks")
+	ErrValidatorUptimeDisabled = errors.New("validator uptime tracking is disabled")
 )
 
 type ErrMaxSubscription struct {
@@ -142,6 +143,15 @@ func (e ErrTxNotFound) Error() string {
 	return fmt.Sprintf("tx not found: %X", e.Hash)
 }
 
+type ErrInvalidTxHashLength struct {
+	Got      int
+	Expected int
+}
+
+func (e ErrInvalidTxHashLength) Error() string {
+	return fmt.Sprintf("invalid tx hash length: got %d, expected %d", e.Got, e.Expected)
+}
+
 type ErrInvalidOrderBy struct {
 	OrderBy string
 }
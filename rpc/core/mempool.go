@@ -6,8 +6,10 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	mempl "github.com/cometbft/cometbft/mempool"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -179,6 +181,55 @@ func (env *Environment) UnconfirmedTx(_ *rpctypes.Context, hash []byte) (*ctypes
 	}, nil
 }
 
+// TxStatus reports the status of a transaction in its lifecycle: unknown,
+// pending in the mempool, evicted from the mempool, rejected by CheckTx, or
+// committed. Committed transactions are only reported if transaction indexing
+// is enabled.
+// More: https://docs.cometbft.com/main/rpc/#/Info/tx_status
+func (env *Environment) TxStatus(_ *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	if len(hash) == 0 {
+		return nil, ErrorEmptyTxHash
+	}
+	if len(hash) != types.TxKeySize {
+		return nil, ErrInvalidTxHashLength{Got: len(hash), Expected: types.TxKeySize}
+	}
+
+	status := env.Mempool.GetTxStatus(types.TxKey(hash))
+	if status.Status == mempl.TxStatusPending {
+		return resultTxStatus(hash, status), nil
+	}
+
+	if _, ok := env.TxIndexer.(*null.TxIndex); !ok {
+		r, err := env.TxIndexer.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			return &ctypes.ResultTxStatus{
+				Hash:   hash,
+				Status: ctypes.TxStatusCommitted,
+				Height: r.Height,
+				Index:  r.Index,
+			}, nil
+		}
+	}
+
+	return resultTxStatus(hash, status), nil
+}
+
+func resultTxStatus(hash []byte, status mempl.TxStatus) *ctypes.ResultTxStatus {
+	return &ctypes.ResultTxStatus{
+		Hash:   hash,
+		Status: status.Status,
+		Height: status.Height,
+		Time:   status.Time,
+		Sender: status.Sender,
+		Reason: status.Reason,
+		Code:   status.Code,
+		Log:    status.Log,
+	}
+}
+
 // UnconfirmedTxs gets unconfirmed transactions (maximum ?limit entries)
 // including their number.
 // More: https://docs.cometbft.com/main/rpc/#/Info/unconfirmed_txs
@@ -205,6 +256,30 @@ func (env *Environment) NumUnconfirmedTxs(*rpctypes.Context) (*ctypes.ResultUnco
 	}, nil
 }
 
+// DumpMempool returns all the transactions in the mempool, in the order in
+// which they were added, along with their metadata. It is an unsafe route, as
+// the response is not paginated and reveals the peers that sent each tx.
+// More: https://docs.cometbft.com/main/rpc/#/Unsafe/dump_mempool
+func (env *Environment) DumpMempool(*rpctypes.Context) (*ctypes.ResultDumpMempool, error) {
+	infos := env.Mempool.DumpTxs()
+	txs := make([]ctypes.MempoolTxInfo, 0, len(infos))
+	for _, info := range infos {
+		txs = append(txs, ctypes.MempoolTxInfo{
+			Hash:      info.Tx.Hash(),
+			Tx:        info.Tx,
+			Height:    info.Height,
+			Time:      info.Time,
+			GasWanted: info.GasWanted,
+			Senders:   info.Senders,
+		})
+	}
+	return &ctypes.ResultDumpMempool{
+		Total:      env.Mempool.Size(),
+		TotalBytes: env.Mempool.SizeBytes(),
+		Txs:        txs,
+	}, nil
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.cometbft.com/main/rpc/#/Tx/check_tx
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -30,6 +31,7 @@ func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
+			Score:            env.P2PPeers.PeerScore(peer.ID()),
 		})
 	})
 	if err != nil {
@@ -102,6 +104,50 @@ func (env *Environment) UnsafeDialPeers(
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeBanPeer bans a node ID, an IP or a CIDR range, for the given duration
+// (e.g. "24h"), or forever if the duration is empty, and disconnects from the
+// peers it matches. The ban is persisted, so it survives restarts.
+func (env *Environment) UnsafeBanPeer(
+	_ *rpctypes.Context,
+	target, reason, duration string,
+) (*ctypes.ResultBanPeer, error) {
+	if target == "" {
+		return &ctypes.ResultBanPeer{}, errors.New("no target provided")
+	}
+
+	var d time.Duration
+	if duration != "" {
+		var err error
+		if d, err = time.ParseDuration(duration); err != nil {
+			return &ctypes.ResultBanPeer{}, fmt.Errorf("invalid duration %q: %w", duration, err)
+		}
+		if d <= 0 {
+			return &ctypes.ResultBanPeer{}, fmt.Errorf("invalid duration %q: must be positive", duration)
+		}
+	}
+
+	env.Logger.Info("BanPeer", "target", target, "reason", reason, "duration", duration)
+	ban, err := env.P2PPeers.BanPeer(target, reason, d)
+	if err != nil {
+		return &ctypes.ResultBanPeer{}, err
+	}
+	return &ctypes.ResultBanPeer{Ban: ban}, nil
+}
+
+// UnsafeUnbanPeer lifts the ban of a node ID, an IP or a CIDR range.
+func (env *Environment) UnsafeUnbanPeer(_ *rpctypes.Context, target string) (*ctypes.ResultUnbanPeer, error) {
+	env.Logger.Info("UnbanPeer", "target", target)
+	if err := env.P2PPeers.UnbanPeer(target); err != nil {
+		return &ctypes.ResultUnbanPeer{}, err
+	}
+	return &ctypes.ResultUnbanPeer{}, nil
+}
+
+// ListBans returns the bans in effect, set with UnsafeBanPeer.
+func (env *Environment) ListBans(*rpctypes.Context) (*ctypes.ResultListBans, error) {
+	return &ctypes.ResultListBans{Bans: env.P2PPeers.Bans()}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.cometbft.com/main/rpc/#/Info/genesis
 func (env *Environment) Genesis(*rpctypes.Context) (*ctypes.ResultGenesis, error) {
@@ -87,3 +87,55 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+func TestUnsafeBanPeer(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1,
+		func(_ int, sw *p2p.Switch) *p2p.Switch { return sw })
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	env := &Environment{}
+	env.Logger = log.TestingLogger()
+	env.P2PPeers = sw
+
+	testCases := []struct {
+		target, duration string
+		isErr            bool
+	}{
+		{"", "", true},
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", "", false},
+		{"10.0.0.0/8", "24h", false},
+		{"10.0.0.0/8", "tomorrow", true},
+		{"10.0.0.0/8", "-1h", true},
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4@127.0.0.1:41198", "", true},
+	}
+
+	for _, tc := range testCases {
+		res, err := env.UnsafeBanPeer(&rpctypes.Context{}, tc.target, "testing", tc.duration)
+		if tc.isErr {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+			assert.Equal(t, tc.target, res.Ban.Target)
+			assert.Equal(t, tc.duration == "", res.Ban.ExpiresAt.IsZero())
+		}
+	}
+
+	res, err := env.ListBans(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Len(t, res.Bans, 2)
+
+	_, err = env.UnsafeUnbanPeer(&rpctypes.Context{}, "10.0.0.0/8")
+	require.NoError(t, err)
+	_, err = env.UnsafeUnbanPeer(&rpctypes.Context{}, "10.0.0.0/8")
+	require.Error(t, err)
+
+	res, err = env.ListBans(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Len(t, res.Bans, 1)
+}
@@ -20,6 +20,7 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"health":               rpc.NewRPCFunc(env.Health, ""),
 		"status":               rpc.NewRPCFunc(env.Status, ""),
 		"net_info":             rpc.NewRPCFunc(env.NetInfo, ""),
+		"list_bans":            rpc.NewRPCFunc(env.ListBans, ""),
 		"blockchain":           rpc.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
 		"genesis":              rpc.NewRPCFunc(env.Genesis, "", rpc.Cacheable()),
 		"genesis_chunked":      rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable()),
@@ -34,12 +35,15 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
 		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
 		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
+		"validator_uptime":     rpc.NewRPCFunc(env.ValidatorUptime, ""),
 		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
+		"consensus_timeline":   rpc.NewRPCFunc(env.ConsensusTimeline, "height"),
 		"unconfirmed_tx":       rpc.NewRPCFunc(env.UnconfirmedTx, "hash"),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
+		"tx_status":            rpc.NewRPCFunc(env.TxStatus, "hash"),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpc.NewRPCFunc(env.BroadcastTxCommit, "tx"),
@@ -61,4 +65,9 @@ func (env *Environment) AddUnsafeRoutes(routes RoutesMap) {
 	routes["dial_seeds"] = rpc.NewRPCFunc(env.UnsafeDialSeeds, "seeds")
 	routes["dial_peers"] = rpc.NewRPCFunc(env.UnsafeDialPeers, "peers,persistent,unconditional,private")
 	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(env.UnsafeFlushMempool, "")
+	routes["unsafe_ban_peer"] = rpc.NewRPCFunc(env.UnsafeBanPeer, "target,reason,duration")
+	routes["unsafe_unban_peer"] = rpc.NewRPCFunc(env.UnsafeUnbanPeer, "target")
+
+	// mempool API
+	routes["dump_mempool"] = rpc.NewRPCFunc(env.DumpMempool, "")
 }
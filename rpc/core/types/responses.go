@@ -129,12 +129,23 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// The ban set by banning a peer.
+type ResultBanPeer struct {
+	Ban p2p.Ban `json:"ban"`
+}
+
+// The bans in effect.
+type ResultListBans struct {
+	Bans []p2p.Ban `json:"bans"`
+}
+
 // A peer.
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	Score            float64              `json:"score"`
 }
 
 // Validators for a height.
@@ -153,6 +164,33 @@ type ResultConsensusParams struct {
 	ConsensusParams types.ConsensusParams `json:"consensus_params"`
 }
 
+// Validator uptime over the last heights tracked.
+type ResultValidatorUptime struct {
+	// Number of heights tracked
+	Window     int64              `json:"window"`
+	Validators []ValidatorUptime  `json:"validators"`
+	Heights    []HeightSignatures `json:"heights"`
+}
+
+// Number of heights at which a validator signed the commit for the block,
+// signed nil or missed it, and the fraction of those it signed for the block.
+type ValidatorUptime struct {
+	Address   types.Address `json:"address"`
+	Signed    int64         `json:"signed"`
+	SignedNil int64         `json:"signed_nil"`
+	Missed    int64         `json:"missed"`
+	Uptime    float64       `json:"uptime"`
+}
+
+// Validators that signed the commit of a height, for the block or for nil, as
+// bitmaps with x for the validators that did and _ for the others, indexed as
+// in the validator set of the height.
+type HeightSignatures struct {
+	Height    int64  `json:"height"`
+	Signed    string `json:"signed"`
+	SignedNil string `json:"signed_nil"`
+}
+
 // Info about the consensus state.
 // UNSTABLE.
 type ResultDumpConsensusState struct {
@@ -171,6 +209,12 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// Consensus timeline of a height.
+// UNSTABLE.
+type ResultConsensusTimeline struct {
+	Timeline json.RawMessage `json:"timeline"`
+}
+
 // CheckTx result.
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -216,6 +260,39 @@ type ResultBlockSearch struct {
 	TotalCount int            `json:"total_count"`
 }
 
+// Statuses of a tx, as reported by ResultTxStatus.
+const (
+	TxStatusUnknown   = "unknown"
+	TxStatusPending   = "pending"
+	TxStatusCommitted = "committed"
+	TxStatusEvicted   = "evicted"
+	TxStatusRejected  = "rejected"
+)
+
+// Status of a tx in its lifecycle.
+//
+// Depending on Status, the following fields are set:
+//   - "pending": Height and Time at which the tx was added to the mempool, and
+//     Sender, the ID of the peer that first sent it (empty if submitted via
+//     RPC).
+//   - "committed": Height of the block including the tx, and Index of the tx
+//     in the block.
+//   - "evicted": Height and Time at which the tx was evicted from the mempool,
+//     and Reason ("recheck", "full" or "ttl").
+//   - "rejected": Height and Time at which the tx was rejected, and the Code
+//     and Log of the CheckTx response.
+type ResultTxStatus struct {
+	Hash   bytes.HexBytes `json:"hash"`
+	Status string         `json:"status"`
+	Height int64          `json:"height"`
+	Index  uint32         `json:"index"`
+	Time   time.Time      `json:"time"`
+	Sender p2p.ID         `json:"sender"`
+	Reason string         `json:"reason"`
+	Code   uint32         `json:"code"`
+	Log    string         `json:"log"`
+}
+
 // Single mempool tx.
 type ResultUnconfirmedTx struct {
 	Tx types.Tx `json:"tx"`
@@ -229,6 +306,23 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Single mempool tx, along with its metadata.
+type MempoolTxInfo struct {
+	Hash      bytes.HexBytes `json:"hash"`
+	Tx        types.Tx       `json:"tx"`
+	Height    int64          `json:"height"`
+	Time      time.Time      `json:"time"`
+	GasWanted int64          `json:"gas_wanted"`
+	Senders   []p2p.ID       `json:"senders"`
+}
+
+// All mempool txs, along with their metadata.
+type ResultDumpMempool struct {
+	Total      int             `json:"total"`
+	TotalBytes int64           `json:"total_bytes"`
+	Txs        []MempoolTxInfo `json:"txs"`
+}
+
 // Info abci msg.
 type ResultABCIInfo struct {
 	Response abcitypes.InfoResponse `json:"response"`
@@ -247,6 +341,7 @@ type ResultBroadcastEvidence struct {
 // empty results.
 type (
 	ResultUnsafeFlushMempool struct{}
+	ResultUnbanPeer          struct{}
 	ResultUnsafeProfile      struct{}
 	ResultSubscribe          struct{}
 	ResultUnsubscribe        struct{}
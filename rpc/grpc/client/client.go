@@ -22,6 +22,7 @@ type Client interface {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	MempoolServiceClient
 
 	// Close the connection to the server. Any subsequent requests will fail.
 	Close() error
@@ -34,6 +35,7 @@ type clientBuilder struct {
 	versionServiceEnabled      bool
 	blockServiceEnabled        bool
 	blockResultsServiceEnabled bool
+	mempoolServiceEnabled      bool
 }
 
 func newClientBuilder() *clientBuilder {
@@ -43,6 +45,7 @@ func newClientBuilder() *clientBuilder {
 		versionServiceEnabled:      true,
 		blockServiceEnabled:        true,
 		blockResultsServiceEnabled: true,
+		mempoolServiceEnabled:      true,
 	}
 }
 
@@ -56,6 +59,7 @@ type client struct {
 	VersionServiceClient
 	BlockServiceClient
 	BlockResultsServiceClient
+	MempoolServiceClient
 }
 
 // Close implements Client.
@@ -94,6 +98,17 @@ func WithBlockServiceEnabled(enabled bool) Option {
 	}
 }
 
+// WithMempoolServiceEnabled allows control of whether or not to create a
+// client for interacting with the mempool service of a CometBFT node.
+//
+// If disabled and the client attempts to access the mempool service API, the
+// client will panic.
+func WithMempoolServiceEnabled(enabled bool) Option {
+	return func(b *clientBuilder) {
+		b.mempoolServiceEnabled = enabled
+	}
+}
+
 // WithGRPCDialOption allows passing lower-level gRPC dial options through to
 // the gRPC dialer when creating the client.
 func WithGRPCDialOption(opt ggrpc.DialOption) Option {
@@ -133,10 +148,15 @@ func New(_ context.Context, addr string, opts ...Option) (Client, error) {
 	if builder.blockResultsServiceEnabled {
 		blockResultServiceClient = newBlockResultsServiceClient(conn)
 	}
+	mempoolServiceClient := newDisabledMempoolServiceClient()
+	if builder.mempoolServiceEnabled {
+		mempoolServiceClient = newMempoolServiceClient(conn)
+	}
 	return &client{
 		conn:                      conn,
 		VersionServiceClient:      versionServiceClient,
 		BlockServiceClient:        blockServiceClient,
 		BlockResultsServiceClient: blockResultServiceClient,
+		MempoolServiceClient:      mempoolServiceClient,
 	}, nil
 }
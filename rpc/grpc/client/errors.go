@@ -16,7 +16,7 @@ type ErrStreamSetup struct {
 }
 
 func (e ErrStreamSetup) Error() string {
-	return "error getting a stream for the latest height: " + e.Source.Error()
+	return "error getting a stream: " + e.Source.Error()
 }
 
 func (e ErrStreamSetup) Unwrap() error {
@@ -28,7 +28,7 @@ type ErrStreamReceive struct {
 }
 
 func (e ErrStreamReceive) Error() string {
-	return "error receiving the latest height from a stream: " + e.Source.Error()
+	return "error receiving from a stream: " + e.Source.Error()
 }
 
 func (e ErrStreamReceive) Unwrap() error {
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxEvent describes a tx added to the mempool, evicted from it, or removed
+// from it because it was included in a committed block. It is sent to the
+// client via a channel, along with any error that ended the stream.
+type TxEvent struct {
+	Hash      []byte
+	Tx        types.Tx
+	Action    string
+	Height    int64
+	GasWanted int64
+	Sender    string
+	Reason    string
+
+	Error error
+}
+
+type getTxEventsConfig struct {
+	chSize uint
+	query  string
+}
+
+type GetTxEventsOption func(*getTxEventsConfig)
+
+// GetTxEventsChannelSize allows control over the channel size. If not used or
+// the channel size is set to 0, an unbuffered channel will be created.
+func GetTxEventsChannelSize(sz uint) GetTxEventsOption {
+	return func(opts *getTxEventsConfig) {
+		opts.chSize = sz
+	}
+}
+
+// GetTxEventsQuery restricts the events to those matching the given query,
+// e.g. "mempool_tx.action='evicted'".
+func GetTxEventsQuery(query string) GetTxEventsOption {
+	return func(opts *getTxEventsConfig) {
+		opts.query = query
+	}
+}
+
+// MempoolServiceClient provides information about the mempool.
+type MempoolServiceClient interface {
+	// GetTxEvents sends the events of the mempool to the resulting output
+	// channel as they happen. Unlike GetLatestHeight, events are never
+	// skipped: if the channel is full, the stream blocks, and the server
+	// terminates it if the client falls too far behind.
+	GetTxEvents(ctx context.Context, opts ...GetTxEventsOption) (<-chan TxEvent, error)
+}
+
+type mempoolServiceClient struct {
+	client mempoolsvc.MempoolServiceClient
+}
+
+func newMempoolServiceClient(conn grpc.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{
+		client: mempoolsvc.NewMempoolServiceClient(conn),
+	}
+}
+
+// GetTxEvents implements MempoolServiceClient GetTxEvents.
+func (c *mempoolServiceClient) GetTxEvents(ctx context.Context, opts ...GetTxEventsOption) (<-chan TxEvent, error) {
+	cfg := &getTxEventsConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	txEventsClient, err := c.client.GetTxEvents(ctx, &mempoolsvc.GetTxEventsRequest{Query: cfg.query})
+	if err != nil {
+		return nil, ErrStreamSetup{Source: err}
+	}
+
+	resultCh := make(chan TxEvent, cfg.chSize)
+	go func(client mempoolsvc.MempoolService_GetTxEventsClient) {
+		defer close(resultCh)
+		for {
+			response, err := client.Recv()
+			if err != nil {
+				res := TxEvent{Error: ErrStreamReceive{Source: err}}
+				select {
+				case <-ctx.Done():
+				case resultCh <- res:
+				}
+				return
+			}
+			res := TxEvent{
+				Hash:      response.Hash,
+				Tx:        response.Tx,
+				Action:    response.Action,
+				Height:    response.Height,
+				GasWanted: response.GasWanted,
+				Sender:    response.Sender,
+				Reason:    response.Reason,
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+		}
+	}(txEventsClient)
+
+	return resultCh, nil
+}
+
+type disabledMempoolServiceClient struct{}
+
+func newDisabledMempoolServiceClient() MempoolServiceClient {
+	return &disabledMempoolServiceClient{}
+}
+
+// GetTxEvents implements MempoolServiceClient GetTxEvents - disabled client.
+func (*disabledMempoolServiceClient) GetTxEvents(context.Context, ...GetTxEventsOption) (<-chan TxEvent, error) {
+	panic("mempool service client is disabled")
+}
@@ -0,0 +1,105 @@
+package mempoolservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/types"
+)
+
+// subscriptionCapacity is the number of events buffered for each stream. If a
+// client does not keep up, its stream is terminated.
+const subscriptionCapacity = 100
+
+type mempoolServiceServer struct {
+	eventBus *types.EventBus
+	logger   log.Logger
+}
+
+// New creates a new CometBFT mempool service server.
+func New(eventBus *types.EventBus, logger log.Logger) mempoolsvc.MempoolServiceServer {
+	return &mempoolServiceServer{
+		eventBus: eventBus,
+		logger:   logger.With("service", "MempoolService"),
+	}
+}
+
+// GetTxEvents implements v1.MempoolServiceServer GetTxEvents method.
+func (s *mempoolServiceServer) GetTxEvents(req *mempoolsvc.GetTxEventsRequest, stream mempoolsvc.MempoolService_GetTxEventsServer) error {
+	logger := s.logger.With("endpoint", "GetTxEvents")
+
+	q := fmt.Sprintf("%s='%s'", types.EventTypeKey, types.EventMempoolTx)
+	if req.Query != "" {
+		q += " AND " + req.Query
+	}
+	query, err := cmtquery.New(q)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid query: %v", err)
+	}
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	// The trace ID is reused as a unique subscriber ID
+	sub, err := s.eventBus.Subscribe(context.Background(), traceID, query, subscriptionCapacity)
+	if err != nil {
+		logger.Error("Cannot subscribe to mempool tx events", "err", err, "traceID", traceID)
+		return status.Errorf(codes.Internal, "Cannot subscribe to mempool tx events (see logs for trace ID: %s)", traceID)
+	}
+	defer func() {
+		if err := s.eventBus.UnsubscribeAll(context.Background(), traceID); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			logger.Error("Cannot unsubscribe from mempool tx events", "err", err, "traceID", traceID)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			data, ok := msg.Data().(types.EventDataMempoolTx)
+			if !ok {
+				logger.Error("Unexpected event type", "type", fmt.Sprintf("%T", msg.Data()), "traceID", traceID)
+				return status.Errorf(codes.Internal, "Internal server error (see logs for trace ID: %s)", traceID)
+			}
+			if err := stream.Send(responseFromEvent(data)); err != nil {
+				logger.Error("Failed to stream mempool tx event", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream canceled by the client")
+		case <-sub.Canceled():
+			switch sub.Err() {
+			case cmtpubsub.ErrUnsubscribed:
+				return status.Error(codes.Canceled, "Subscription terminated")
+			case nil:
+				return status.Error(codes.Canceled, "Subscription canceled without errors")
+			default:
+				logger.Info("Subscription canceled with errors", "err", sub.Err(), "traceID", traceID)
+				return status.Errorf(codes.Canceled, "Subscription canceled with errors (see logs for trace ID: %s)", traceID)
+			}
+		}
+	}
+}
+
+func responseFromEvent(data types.EventDataMempoolTx) *mempoolsvc.GetTxEventsResponse {
+	return &mempoolsvc.GetTxEventsResponse{
+		Hash:      data.Tx.Hash(),
+		Tx:        data.Tx,
+		Action:    data.Action,
+		Height:    data.Height,
+		GasWanted: data.GasWanted,
+		Sender:    data.Sender,
+		Reason:    data.Reason,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/internal/fail"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
@@ -49,6 +50,26 @@ type BlockExecutor struct {
 	logger log.Logger
 
 	metrics *Metrics
+
+	// tracks which validators signed the LastCommit of the blocks applied, if
+	// not nil
+	validatorUptime *ValidatorUptimeTracker
+
+	// durations of the calls to the application for the last applied block
+	lastExecutionTimes ExecutionTimes
+
+	// the block being executed before it is decided, if any
+	optimisticMtx cmtsync.Mutex
+	optimistic    *optimisticExecution
+}
+
+// ExecutionTimes holds when the FinalizeBlock and Commit calls to the
+// application, made to apply a block, started and how long they took.
+type ExecutionTimes struct {
+	FinalizeBlockStart    time.Time
+	FinalizeBlockDuration time.Duration
+	CommitStart           time.Time
+	CommitDuration        time.Duration
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -65,6 +86,14 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithValidatorUptime records which validators signed the
+// LastCommit of each block applied in tracker.
+func BlockExecutorWithValidatorUptime(tracker *ValidatorUptimeTracker) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.validatorUptime = tracker
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -98,6 +127,11 @@ func (blockExec *BlockExecutor) Store() Store {
 	return blockExec.store
 }
 
+// LastExecutionTimes returns the execution times of the last block applied.
+func (blockExec *BlockExecutor) LastExecutionTimes() ExecutionTimes {
+	return blockExec.lastExecutionTimes
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
@@ -136,7 +170,15 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxReapBytes, maxGas)
 	commit := lastExtCommit.ToCommit()
+	// If all the validators use BLS12-381 keys, aggregate their signatures.
+	if height > state.InitialHeight && state.LastValidators.AllKeysAreBLS12381() {
+		var err error
+		if commit, err = commit.Aggregate(); err != nil {
+			return nil, err
+		}
+	}
 	block := state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	blockExec.waitOptimisticExecution()
 	rpp, err := blockExec.proxyApp.PrepareProposal(
 		ctx,
 		&abci.PrepareProposalRequest{
@@ -174,6 +216,7 @@ func (blockExec *BlockExecutor) ProcessProposal(
 	block *types.Block,
 	state State,
 ) (bool, error) {
+	blockExec.waitOptimisticExecution()
 	resp, err := blockExec.proxyApp.ProcessProposal(context.TODO(), &abci.ProcessProposalRequest{
 		Hash:               block.Header.Hash(),
 		Height:             block.Header.Height,
@@ -234,20 +277,12 @@ func (blockExec *BlockExecutor) ApplyBlock(
 }
 
 func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, block *types.Block, syncingToHeight int64) (State, error) {
-	startTime := cmttime.Now().UnixNano()
-	abciResponse, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), &abci.FinalizeBlockRequest{
-		Hash:               block.Hash(),
-		NextValidatorsHash: block.NextValidatorsHash,
-		ProposerAddress:    block.ProposerAddress,
-		Height:             block.Height,
-		Time:               block.Time,
-		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
-		Misbehavior:        block.Evidence.Evidence.ToABCI(),
-		Txs:                block.Txs.ToSliceOfBytes(),
-		SyncingToHeight:    syncingToHeight,
-	})
-	endTime := cmttime.Now().UnixNano()
-	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
+	abciResponse, start, duration, err := blockExec.finalizeBlock(state, block, syncingToHeight)
+	blockExec.metrics.BlockProcessingTime.Observe(float64(duration.Nanoseconds()) / 1000000)
+	blockExec.lastExecutionTimes = ExecutionTimes{
+		FinalizeBlockStart:    start,
+		FinalizeBlockDuration: duration,
+	}
 	if err != nil {
 		blockExec.logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
 		return state, err
@@ -299,6 +334,11 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 		return state, fmt.Errorf("error in next block delay: %w", err)
 	}
 
+	// The first LastCommit is intentionally empty.
+	if blockExec.validatorUptime != nil && block.Height > state.InitialHeight {
+		blockExec.validatorUptime.Record(state.LastValidators, block.LastCommit)
+	}
+
 	// Update the state with the block and responses.
 	state, err = updateState(state, blockID, &block.Header, abciResponse, validatorUpdates)
 	if err != nil {
@@ -362,6 +402,7 @@ func (blockExec *BlockExecutor) ExtendVote(
 		ProposerAddress:    block.ProposerAddress,
 	}
 
+	blockExec.waitOptimisticExecution()
 	resp, err := blockExec.proxyApp.ExtendVote(ctx, &req)
 	if err != nil {
 		panic(fmt.Errorf("ExtendVote call failed: %w", err))
@@ -377,6 +418,7 @@ func (blockExec *BlockExecutor) VerifyVoteExtension(ctx context.Context, vote *t
 		VoteExtension:    vote.Extension,
 	}
 
+	blockExec.waitOptimisticExecution()
 	resp, err := blockExec.proxyApp.VerifyVoteExtension(ctx, &req)
 	if err != nil {
 		panic(fmt.Errorf("VerifyVoteExtension call failed: %w", err))
@@ -420,7 +462,10 @@ func (blockExec *BlockExecutor) Commit(
 	}
 
 	// Commit block, get hash back
+	start := cmttime.Now()
 	res, err := blockExec.proxyApp.Commit(context.TODO())
+	blockExec.lastExecutionTimes.CommitStart = start
+	blockExec.lastExecutionTimes.CommitDuration = cmttime.Since(start)
 	if err != nil {
 		unlockMempool()
 		blockExec.logger.Error("client error during proxyAppConn.CommitSync", "err", err)
@@ -452,7 +497,7 @@ func (blockExec *BlockExecutor) asyncUpdateMempool(
 	err := blockExec.mempool.Update(
 		block.Height,
 		block.Txs,
-		abciResponse.TxResults,
+		abciResponse,
 		TxPreCheck(state),
 		TxPostCheck(state),
 	)
@@ -679,6 +724,11 @@ func updateState(
 		lastHeightParamsChanged = header.Height + 1
 	}
 
+	// Select the proposer of the next height, which may depend on the hash of
+	// this block.
+	nextValSet := state.NextValidators.Copy()
+	nextValSet.SelectProposer(nextParams.Validator.ProposerSelection, blockID.Hash, header.Height+1, 0)
+
 	nextVersion := state.Version
 
 	// NOTE: the AppHash and the VoteExtension has not been populated.
@@ -691,7 +741,7 @@ func updateState(
 		LastBlockID:                      blockID,
 		LastBlockTime:                    header.Time,
 		NextValidators:                   nValSet,
-		Validators:                       state.NextValidators.Copy(),
+		Validators:                       nextValSet,
 		LastValidators:                   state.Validators.Copy(),
 		LastHeightValidatorsChanged:      lastHeightValsChanged,
 		ConsensusParams:                  nextParams,
@@ -21,6 +21,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
 	"github.com/cometbft/cometbft/proxy"
 	pmocks "github.com/cometbft/cometbft/proxy/mocks"
@@ -77,6 +78,75 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// TestApplyBlockOptimisticExecution ensures that the result of the optimistic
+// execution of a block is used if the block is applied, and that the block
+// applied is executed otherwise.
+func TestApplyBlockOptimisticExecution(t *testing.T) {
+	for _, decided := range []bool{true, false} {
+		app := &finalizeRecordingApp{}
+		cc := proxy.NewLocalClientCreator(app)
+		proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		state, stateDB, _ := makeState(1, 1, chainID)
+		stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: false,
+		})
+		blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+		mp := &mpmocks.Mempool{}
+		mp.On("Lock").Return()
+		mp.On("Unlock").Return()
+		mp.On("FlushAppConn", mock.Anything).Return(nil)
+		mp.On("Update",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything).Return(nil)
+		blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+			mp, sm.EmptyEvidencePool{}, blockStore)
+
+		block := makeBlock(state, 1, new(types.Commit))
+		bps, err := block.MakePartSet(testPartSize)
+		require.NoError(t, err)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+
+		optimisticBlock := block
+		if !decided {
+			optimisticBlock = state.MakeBlock(1, test.MakeNTxs(1, 5), new(types.Commit), nil, state.Validators.GetProposer().Address)
+		}
+		require.True(t, blockExec.ExecuteBlockOptimistically(state, optimisticBlock))
+		// Only one block is executed optimistically per height.
+		require.False(t, blockExec.ExecuteBlockOptimistically(state, block))
+
+		_, err = blockExec.ApplyBlock(state, blockID, block, block.Height)
+		require.NoError(t, err)
+
+		if decided {
+			assert.Equal(t, [][]byte{block.Hash()}, app.finalized)
+		} else {
+			assert.Equal(t, [][]byte{optimisticBlock.Hash(), block.Hash()}, app.finalized)
+		}
+	}
+}
+
+type finalizeRecordingApp struct {
+	testApp
+
+	mtx       cmtsync.Mutex
+	finalized [][]byte // hashes of the blocks executed
+}
+
+func (app *finalizeRecordingApp) FinalizeBlock(ctx context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
+	app.mtx.Lock()
+	app.finalized = append(app.finalized, req.Hash)
+	app.mtx.Unlock()
+	return app.testApp.FinalizeBlock(ctx, req)
+}
+
 // TestFinalizeBlockDecidedLastCommit ensures we correctly send the
 // DecidedLastCommit to the application. The test ensures that the
 // DecidedLastCommit properly reflects which validators signed the preceding
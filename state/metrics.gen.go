@@ -96,6 +96,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: stdprometheus.ExponentialBuckets(0.0002, 10, 5),
 		}, append(labels, "method")).With(labelsAndValues...),
+		ValidatorUptimeBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_uptime_blocks",
+			Help:      "Number of tracked heights at which a validator signed, signed nil or missed the commit.",
+		}, append(labels, "validator_address", "status")).With(labelsAndValues...),
+		ValidatorUptime: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "validator_uptime",
+			Help:      "Fraction of the tracked heights at which a validator signed the commit for the block.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		OptimisticExecutions: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "optimistic_executions",
+			Help:      "Number of optimistic block executions, labeled by whether the result was used (hit) or discarded (miss).",
+		}, append(labels, "result")).With(labelsAndValues...),
 	}
 }
 
@@ -114,5 +132,8 @@ func NopMetrics() *Metrics {
 		TxIndexerBaseHeight:                    discard.NewGauge(),
 		BlockIndexerBaseHeight:                 discard.NewGauge(),
 		StoreAccessDurationSeconds:             discard.NewHistogram(),
+		ValidatorUptimeBlocks:                  discard.NewGauge(),
+		ValidatorUptime:                        discard.NewGauge(),
+		OptimisticExecutions:                   discard.NewCounter(),
 	}
 }
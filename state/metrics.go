@@ -66,4 +66,21 @@ type Metrics struct {
 	// The duration of accesses to the state store labeled by which method
 	// was called on the store.
 	StoreAccessDurationSeconds metrics.Histogram `metrics_bucketsizes:"0.0002, 10, 5" metrics_buckettype:"exp" metrics_labels:"method"`
+
+	// ValidatorUptimeBlocks is the number of heights, among the last ones
+	// tracked, at which a validator signed the commit, signed nil or missed
+	// it, labeled by the validator address and the status.
+	// metrics:Number of tracked heights at which a validator signed, signed nil or missed the commit.
+	ValidatorUptimeBlocks metrics.Gauge `metrics_labels:"validator_address, status"`
+
+	// ValidatorUptime is the fraction of the heights, among the last ones
+	// tracked, at which a validator signed the commit for the block.
+	// metrics:Fraction of the tracked heights at which a validator signed the commit for the block.
+	ValidatorUptime metrics.Gauge `metrics_labels:"validator_address"`
+
+	// OptimisticExecutions is the number of blocks executed before being
+	// decided, labeled by whether the result was used, as the block was
+	// decided (hit), or discarded (miss).
+	// metrics:Number of optimistic block executions, labeled by whether the result was used (hit) or discarded (miss).
+	OptimisticExecutions metrics.Counter `metrics_labels:"result"`
 }
@@ -0,0 +1,144 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// Results of an optimistic execution, used as label values of the
+// OptimisticExecutions metric.
+const (
+	OptimisticExecutionHit  = "hit"
+	OptimisticExecutionMiss = "miss"
+)
+
+// optimisticExecution is the execution of a block started before the block
+// was decided.
+type optimisticExecution struct {
+	height    int64
+	blockHash []byte
+
+	done     chan struct{} // closed once the execution completes
+	start    time.Time
+	duration time.Duration
+	resp     *abci.FinalizeBlockResponse
+	err      error
+}
+
+// ExecuteBlockOptimistically starts executing block, which is not decided
+// yet, in the background, and returns whether it did. If the block is then
+// decided, applying it uses the result of this execution. Otherwise, the
+// result is discarded and the decided block is executed.
+//
+// At most one block is executed optimistically per height, thus it does
+// nothing if another block of the same height was already.
+//
+// The application must support FinalizeBlock being called more than once at
+// a height, discarding the state of the previous call, which it advertises
+// with InfoResponse.OptimisticExecution (checked by the node on startup).
+func (blockExec *BlockExecutor) ExecuteBlockOptimistically(state State, block *types.Block) bool {
+	blockExec.optimisticMtx.Lock()
+	defer blockExec.optimisticMtx.Unlock()
+
+	if oe := blockExec.optimistic; oe != nil {
+		if oe.height >= block.Height {
+			return false
+		}
+		// A stale execution, which should not happen as applying a block
+		// takes the execution of its height.
+		<-oe.done
+	}
+
+	oe := &optimisticExecution{
+		height:    block.Height,
+		blockHash: block.Hash(),
+		done:      make(chan struct{}),
+	}
+	blockExec.optimistic = oe
+	req := blockExec.finalizeBlockRequest(state, block, block.Height)
+
+	blockExec.logger.Debug("executing block optimistically", "height", block.Height, "hash", oe.blockHash)
+	go func() {
+		defer close(oe.done)
+		oe.start = cmttime.Now()
+		oe.resp, oe.err = blockExec.proxyApp.FinalizeBlock(context.TODO(), req)
+		oe.duration = cmttime.Since(oe.start)
+	}()
+	return true
+}
+
+// takeOptimisticExecution returns the optimistic execution of the block at
+// height, if any, once it completes, and clears it.
+func (blockExec *BlockExecutor) takeOptimisticExecution(height int64) *optimisticExecution {
+	blockExec.optimisticMtx.Lock()
+	oe := blockExec.optimistic
+	if oe == nil || oe.height > height {
+		blockExec.optimisticMtx.Unlock()
+		return nil
+	}
+	blockExec.optimistic = nil
+	blockExec.optimisticMtx.Unlock()
+
+	<-oe.done
+	if oe.height < height {
+		return nil
+	}
+	return oe
+}
+
+// waitOptimisticExecution waits for the optimistic execution running, if any,
+// to complete, as the calls to the application on the consensus connection
+// must not be concurrent.
+func (blockExec *BlockExecutor) waitOptimisticExecution() {
+	blockExec.optimisticMtx.Lock()
+	oe := blockExec.optimistic
+	blockExec.optimisticMtx.Unlock()
+	if oe != nil {
+		<-oe.done
+	}
+}
+
+// finalizeBlock executes block, or returns the result of its optimistic
+// execution if any, along with when the execution started and how long it
+// took.
+func (blockExec *BlockExecutor) finalizeBlock(
+	state State,
+	block *types.Block,
+	syncingToHeight int64,
+) (*abci.FinalizeBlockResponse, time.Time, time.Duration, error) {
+	if oe := blockExec.takeOptimisticExecution(block.Height); oe != nil {
+		if oe.err == nil && block.HashesTo(oe.blockHash) {
+			blockExec.metrics.OptimisticExecutions.With("result", OptimisticExecutionHit).Add(1)
+			return oe.resp, oe.start, oe.duration, nil
+		}
+		blockExec.metrics.OptimisticExecutions.With("result", OptimisticExecutionMiss).Add(1)
+		blockExec.logger.Info("discarding optimistic execution",
+			"height", block.Height, "hash", oe.blockHash, "decided_hash", block.Hash(), "err", oe.err)
+	}
+
+	start := cmttime.Now()
+	resp, err := blockExec.proxyApp.FinalizeBlock(context.TODO(), blockExec.finalizeBlockRequest(state, block, syncingToHeight))
+	return resp, start, cmttime.Since(start), err
+}
+
+func (blockExec *BlockExecutor) finalizeBlockRequest(
+	state State,
+	block *types.Block,
+	syncingToHeight int64,
+) *abci.FinalizeBlockRequest {
+	return &abci.FinalizeBlockRequest{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  buildLastCommitInfoFromStore(block, blockExec.store, state.InitialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+		SyncingToHeight:    syncingToHeight,
+	}
+}
@@ -317,6 +317,7 @@ func MakeGenesisState(genDoc *types.GenesisDoc) (State, error) {
 		}
 		validatorSet = types.NewValidatorSet(validators)
 		nextValidatorSet = types.NewValidatorSet(validators).CopyIncrementProposerPriority(1)
+		validatorSet.SelectProposer(genDoc.ConsensusParams.Validator.ProposerSelection, nil, genDoc.InitialHeight, 0)
 	}
 
 	return State{
@@ -715,6 +715,46 @@ func TestProposerPriorityProposerAlternates(t *testing.T) {
 	}
 }
 
+// TestProposerSelectionStakeWeightedRandom tests that the proposer of the
+// validator set of the state is the one selected by the consensus params,
+// while the proposer priorities keep being updated as with the weighted
+// round-robin.
+func TestProposerSelectionStakeWeightedRandom(t *testing.T) {
+	params := test.ConsensusParams()
+	params.Validator.ProposerSelection = types.ProposerSelectionStakeWeightedRandom
+	state, _, _ := makeStateWithParams(4, 1, params, "test-chain")
+	rrState, _, _ := makeStateWithParams(4, 1, test.ConsensusParams(), "test-chain")
+	rrState.Validators = state.Validators.Copy()
+	rrState.NextValidators = state.NextValidators.Copy()
+
+	expected := state.Validators.ProposerForRound(types.ProposerSelectionStakeWeightedRandom, nil, state.InitialHeight, 0)
+	assert.Equal(t, expected.Address, state.Validators.GetProposer().Address)
+
+	proposers := make(map[string]bool)
+	for i := 0; i < 20; i++ {
+		block := makeBlock(state, state.LastBlockHeight+1, new(types.Commit))
+		blockID := makeBlockIDRandom()
+		abciResponses := &abci.FinalizeBlockResponse{}
+
+		var err error
+		state, err = sm.UpdateState(state, blockID, &block.Header, abciResponses, nil)
+		require.NoError(t, err)
+		rrState, err = sm.UpdateState(rrState, blockID, &block.Header, abciResponses, nil)
+		require.NoError(t, err)
+
+		expected := state.Validators.ProposerForRound(types.ProposerSelectionStakeWeightedRandom,
+			blockID.Hash, block.Height+1, 0)
+		assert.Equal(t, expected.Address, state.Validators.GetProposer().Address)
+		proposers[expected.Address.String()] = true
+
+		assert.Equal(t, rrState.NextValidators, state.NextValidators)
+		for j, val := range rrState.Validators.Validators {
+			assert.Equal(t, val.ProposerPriority, state.Validators.Validators[j].ProposerPriority)
+		}
+	}
+	assert.Greater(t, len(proposers), 1)
+}
+
 func TestLargeGenesisValidator(t *testing.T) {
 	tearDown, _, state := setupTestCase(t)
 	defer tearDown(t)
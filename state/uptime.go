@@ -0,0 +1,220 @@
+package state
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+
+	"github.com/cometbft/cometbft/internal/bits"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Statuses of a validator in the commit of a height, used as label values of
+// the validator uptime metrics.
+const (
+	UptimeStatusSigned    = "signed"
+	UptimeStatusSignedNil = "signed_nil"
+	UptimeStatusMissed    = "missed"
+)
+
+// ValidatorUptime is the number of heights, among the tracked ones, at which
+// a validator signed the commit for the block, signed it for nil, or did not
+// sign it.
+type ValidatorUptime struct {
+	Address   types.Address
+	Signed    int64
+	SignedNil int64
+	Missed    int64
+}
+
+// Total returns the number of heights at which the validator was in the
+// validator set.
+func (vu ValidatorUptime) Total() int64 {
+	return vu.Signed + vu.SignedNil + vu.Missed
+}
+
+// Uptime returns the fraction of the heights at which the validator was in
+// the validator set and signed the commit for the block.
+func (vu ValidatorUptime) Uptime() float64 {
+	if vu.Total() == 0 {
+		return 0
+	}
+	return float64(vu.Signed) / float64(vu.Total())
+}
+
+// CommitSignatures records which validators signed the commit of a height.
+// The validators are indexed as in the validator set of the height. Those in
+// neither bit array did not sign the commit.
+type CommitSignatures struct {
+	Height    int64
+	Signed    *bits.BitArray
+	SignedNil *bits.BitArray
+}
+
+type commitRecord struct {
+	CommitSignatures
+	addresses []types.Address
+	valsHash  []byte
+}
+
+// ValidatorUptimeTracker keeps a rolling record, over a window of heights, of
+// which validators signed, signed nil or missed the commit of each height,
+// derived from the LastCommit of the blocks applied. It is safe for
+// concurrent use.
+type ValidatorUptimeTracker struct {
+	mtx     sync.RWMutex
+	window  int64
+	metrics *Metrics
+
+	records []commitRecord // in increasing height order
+	uptimes map[string]*ValidatorUptime
+}
+
+// NewValidatorUptimeTracker returns a tracker of the validator uptime over the
+// last window heights.
+func NewValidatorUptimeTracker(window int64, metrics *Metrics) *ValidatorUptimeTracker {
+	if metrics == nil {
+		metrics = NopMetrics()
+	}
+	return &ValidatorUptimeTracker{
+		window:  window,
+		metrics: metrics,
+		uptimes: make(map[string]*ValidatorUptime),
+	}
+}
+
+// Window returns the number of heights tracked.
+func (t *ValidatorUptimeTracker) Window() int64 {
+	return t.window
+}
+
+// Load records the commits of the last heights up to height from the block
+// store, along with the validator sets from the state store. Heights whose
+// commit or validator set was pruned are skipped.
+func (t *ValidatorUptimeTracker) Load(stateStore Store, blockStore BlockStore, height int64) {
+	from := max(height-t.window+1, blockStore.Base(), 1)
+	for h := from; h <= height; h++ {
+		commit := blockStore.LoadBlockCommit(h)
+		if commit == nil {
+			continue
+		}
+		vals, err := stateStore.LoadValidators(h)
+		if err != nil {
+			continue
+		}
+		t.Record(vals, commit)
+	}
+}
+
+// Record records which validators of vals signed commit. Commits of heights
+// already recorded, or older, are ignored.
+func (t *ValidatorUptimeTracker) Record(vals *types.ValidatorSet, commit *types.Commit) {
+	if commit == nil || commit.Size() != vals.Size() {
+		return
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if n := len(t.records); n > 0 && commit.Height <= t.records[n-1].Height {
+		return
+	}
+
+	rec := commitRecord{
+		CommitSignatures: CommitSignatures{
+			Height:    commit.Height,
+			Signed:    bits.NewBitArray(vals.Size()),
+			SignedNil: bits.NewBitArray(vals.Size()),
+		},
+		valsHash: vals.Hash(),
+	}
+	// Share the addresses with the previous record if the validator set did
+	// not change, which is the common case.
+	if n := len(t.records); n > 0 && bytes.Equal(t.records[n-1].valsHash, rec.valsHash) {
+		rec.addresses = t.records[n-1].addresses
+	} else {
+		rec.addresses = make([]types.Address, vals.Size())
+		for i, val := range vals.Validators {
+			rec.addresses[i] = val.Address
+		}
+	}
+	for i, sig := range commit.Signatures {
+		switch sig.BlockIDFlag {
+		case types.BlockIDFlagCommit:
+			rec.Signed.SetIndex(i, true)
+		case types.BlockIDFlagNil:
+			rec.SignedNil.SetIndex(i, true)
+		}
+	}
+
+	changed := make(map[string]*ValidatorUptime)
+	t.apply(rec, 1, changed)
+	t.records = append(t.records, rec)
+
+	// Drop the heights out of the window.
+	evict := 0
+	for evict < len(t.records) && t.records[evict].Height <= commit.Height-t.window {
+		t.apply(t.records[evict], -1, changed)
+		evict++
+	}
+	t.records = t.records[evict:]
+
+	for key, vu := range changed {
+		if vu.Total() == 0 {
+			delete(t.uptimes, key)
+		}
+		label := []string{"validator_address", vu.Address.String()}
+		t.metrics.ValidatorUptimeBlocks.With(append(label, "status", UptimeStatusSigned)...).Set(float64(vu.Signed))
+		t.metrics.ValidatorUptimeBlocks.With(append(label, "status", UptimeStatusSignedNil)...).Set(float64(vu.SignedNil))
+		t.metrics.ValidatorUptimeBlocks.With(append(label, "status", UptimeStatusMissed)...).Set(float64(vu.Missed))
+		t.metrics.ValidatorUptime.With(label...).Set(vu.Uptime())
+	}
+}
+
+// apply adds (delta=1) or removes (delta=-1) the signatures of rec to the
+// uptimes, and collects the uptimes changed.
+func (t *ValidatorUptimeTracker) apply(rec commitRecord, delta int64, changed map[string]*ValidatorUptime) {
+	for i, addr := range rec.addresses {
+		key := string(addr)
+		vu, ok := t.uptimes[key]
+		if !ok {
+			vu = &ValidatorUptime{Address: addr}
+			t.uptimes[key] = vu
+		}
+		switch {
+		case rec.Signed.GetIndex(i):
+			vu.Signed += delta
+		case rec.SignedNil.GetIndex(i):
+			vu.SignedNil += delta
+		default:
+			vu.Missed += delta
+		}
+		changed[key] = vu
+	}
+}
+
+// Uptimes returns the uptime of each validator that was in the validator set
+// at any of the heights tracked, ordered by address, along with the
+// signatures of each height tracked, in increasing height order.
+func (t *ValidatorUptimeTracker) Uptimes() ([]ValidatorUptime, []CommitSignatures) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	uptimes := make([]ValidatorUptime, 0, len(t.uptimes))
+	for _, vu := range t.uptimes {
+		uptimes = append(uptimes, *vu)
+	}
+	slices.SortFunc(uptimes, func(a, b ValidatorUptime) int {
+		return bytes.Compare(a.Address, b.Address)
+	})
+
+	heights := make([]CommitSignatures, len(t.records))
+	for i, rec := range t.records {
+		heights[i] = CommitSignatures{
+			Height:    rec.Height,
+			Signed:    rec.Signed.Copy(),
+			SignedNil: rec.SignedNil.Copy(),
+		}
+	}
+	return uptimes, heights
+}
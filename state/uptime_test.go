@@ -0,0 +1,71 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestValidatorUptimeTracker(t *testing.T) {
+	vals, _ := types.RandValidatorSet(3, 10)
+	commit := func(height int64, vals *types.ValidatorSet, flags ...types.BlockIDFlag) *types.Commit {
+		require.Len(t, flags, vals.Size())
+		sigs := make([]types.CommitSig, len(flags))
+		for i, flag := range flags {
+			sigs[i] = types.CommitSig{BlockIDFlag: flag}
+		}
+		return &types.Commit{Height: height, Signatures: sigs}
+	}
+	uptime := func(tracker *sm.ValidatorUptimeTracker, addr types.Address) sm.ValidatorUptime {
+		uptimes, _ := tracker.Uptimes()
+		for _, vu := range uptimes {
+			if addr.String() == vu.Address.String() {
+				return vu
+			}
+		}
+		return sm.ValidatorUptime{}
+	}
+	const (
+		c = types.BlockIDFlagCommit
+		n = types.BlockIDFlagNil
+		a = types.BlockIDFlagAbsent
+	)
+
+	tracker := sm.NewValidatorUptimeTracker(3, nil)
+	tracker.Record(vals, commit(1, vals, c, c, a))
+	tracker.Record(vals, commit(2, vals, c, n, a))
+	tracker.Record(vals, commit(3, vals, c, c, c))
+	// Older heights and commits that do not match the validator set are ignored.
+	tracker.Record(vals, commit(2, vals, a, a, a))
+	tracker.Record(vals, &types.Commit{Height: 4})
+
+	assert.Equal(t, sm.ValidatorUptime{Address: vals.Validators[0].Address, Signed: 3}, uptime(tracker, vals.Validators[0].Address))
+	assert.Equal(t, sm.ValidatorUptime{Address: vals.Validators[1].Address, Signed: 2, SignedNil: 1}, uptime(tracker, vals.Validators[1].Address))
+	assert.Equal(t, sm.ValidatorUptime{Address: vals.Validators[2].Address, Signed: 1, Missed: 2}, uptime(tracker, vals.Validators[2].Address))
+	assert.InDelta(t, 1.0/3, uptime(tracker, vals.Validators[2].Address).Uptime(), 1e-9)
+
+	_, heights := tracker.Uptimes()
+	require.Len(t, heights, 3)
+	assert.EqualValues(t, 2, heights[1].Height)
+	assert.True(t, heights[1].Signed.GetIndex(0))
+	assert.True(t, heights[1].SignedNil.GetIndex(1))
+	assert.False(t, heights[1].Signed.GetIndex(2) || heights[1].SignedNil.GetIndex(2))
+
+	// The oldest heights slide out of the window, along with the validators
+	// that are no longer in the validator set.
+	newVals := types.NewValidatorSet(vals.Validators[:2])
+	tracker.Record(newVals, commit(5, newVals, a, c))
+	tracker.Record(newVals, commit(6, newVals, a, c))
+
+	_, heights = tracker.Uptimes()
+	require.Len(t, heights, 2)
+	assert.EqualValues(t, 5, heights[0].Height)
+	assert.Equal(t, sm.ValidatorUptime{Address: vals.Validators[0].Address, Missed: 2}, uptime(tracker, vals.Validators[0].Address))
+	assert.Equal(t, sm.ValidatorUptime{Address: vals.Validators[1].Address, Signed: 2}, uptime(tracker, vals.Validators[1].Address))
+	uptimes, _ := tracker.Uptimes()
+	assert.Len(t, uptimes, 2)
+}
@@ -114,6 +114,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 	err := validateMsg(e.Message)
 	if err != nil {
 		r.Logger.Error("Invalid message", "peer", e.Src, "msg", e.Message, "err", err)
+		r.Switch.ReportPeerEvent(e.Src, p2p.PeerEventInvalidMessage)
 		r.Switch.StopPeerForError(e.Src, err)
 		return
 	}
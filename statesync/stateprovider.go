@@ -201,6 +201,8 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	}
 	state.ConsensusParams = result.ConsensusParams
 	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
+	state.Validators.SelectProposer(state.ConsensusParams.Validator.ProposerSelection,
+		state.LastBlockID.Hash, currentLightBlock.Height, 0)
 
 	return state, nil
 }
@@ -222,7 +222,9 @@ func (bs *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
 	}
 	pbb := new(cmtproto.Block)
 	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+	// The data parts of an erasure-coded block are enough to decode it.
+	psh := blockMeta.BlockID.PartSetHeader
+	for i := 0; i < int(psh.DataTotal()); i++ {
 		part := bs.LoadBlockPart(height, i)
 		// If the part is missing (e.g. since it has been deleted after we
 		// loaded the block meta) we consider the whole block to be missing.
@@ -233,7 +235,13 @@ func (bs *BlockStore) LoadBlock(height int64) (*types.Block, *types.BlockMeta) {
 	}
 	addTimeSample(bs.metrics.BlockStoreAccessDurationSeconds.With("method", "load_block"), start)()
 
-	err := proto.Unmarshal(buf, pbb)
+	var err error
+	if psh.Parity > 0 {
+		if buf, err = types.TrimCodedData(buf); err != nil {
+			panic(fmt.Sprintf("Error reading block: %v", err))
+		}
+	}
+	err = proto.Unmarshal(buf, pbb)
 	if err != nil {
 		// NOTE: The existence of meta should imply the existence of the
 		// block. So, make sure meta is only saved after blocks are saved.
@@ -385,6 +385,30 @@ func stripExtensions(ec *types.ExtendedCommit) bool {
 
 // TestSaveBlockWithExtendedCommitPanicOnAbsentExtension tests that saving a
 // block with an extended commit panics when the extension data is absent.
+func TestBlockStoreSaveLoadCodedBlock(t *testing.T) {
+	state, bs, _, _, cleanup, _ := makeStateAndBlockStoreAndIndexers()
+	defer cleanup()
+
+	// save an erasure-coded block big enough to have two data block parts
+	txs := []types.Tx{make([]byte, types.BlockPartSizeBytes)}
+	block := state.MakeBlock(bs.Height()+1, txs, new(types.Commit), nil, state.Validators.GetProposer().Address)
+	partSet, err := block.MakeCodedPartSet(types.BlockPartSizeBytes, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, partSet.Header().Parity)
+	require.GreaterOrEqual(t, partSet.Header().DataTotal(), uint32(2))
+
+	seenCommit := makeTestExtCommit(block.Header.Height, cmttime.Now())
+	bs.SaveBlock(block, partSet, seenCommit.ToCommit())
+
+	gotBlock, gotMeta := bs.LoadBlock(block.Height)
+	require.NotNil(t, gotBlock)
+	assert.Equal(t, block.Hash(), gotBlock.Hash())
+	assert.Equal(t, partSet.Header(), gotMeta.BlockID.PartSetHeader)
+	for i := 0; i < int(partSet.Total()); i++ {
+		assert.Equal(t, partSet.GetPart(i), bs.LoadBlockPart(block.Height, i))
+	}
+}
+
 func TestSaveBlockWithExtendedCommitPanicOnAbsentExtension(t *testing.T) {
 	for _, testCase := range []struct {
 		name           string
@@ -50,6 +50,8 @@ type Config struct {
 
 	PbtsEnableHeight int64 `toml:"pbts_enable_height"`
 	PbtsUpdateHeight int64 `toml:"pbts_update_height"`
+
+	Misbehaviors map[string]string `toml:"misbehaviors"`
 }
 
 // App extracts out the application specific configuration parameters.
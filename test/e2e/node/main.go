@@ -16,6 +16,7 @@ import (
 	"github.com/cometbft/cometbft/abci/server"
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	cs "github.com/cometbft/cometbft/internal/consensus"
 	cmtnet "github.com/cometbft/cometbft/internal/net"
 	cmtflags "github.com/cometbft/cometbft/libs/cli/flags"
 	"github.com/cometbft/cometbft/libs/log"
@@ -137,8 +138,9 @@ func startNode(cfg *Config) error {
 		cmtcfg.Storage.ExperimentalKeyLayout = cfg.ExperimentalKeyLayout
 	}
 
+	pv := privval.LoadOrGenFilePV(cmtcfg.PrivValidatorKeyFile(), cmtcfg.PrivValidatorStateFile())
 	n, err := node.NewNode(context.Background(), cmtcfg,
-		privval.LoadOrGenFilePV(cmtcfg.PrivValidatorKeyFile(), cmtcfg.PrivValidatorStateFile()),
+		pv,
 		nodeKey,
 		clientCreator,
 		node.DefaultGenesisDocProviderFunc(cmtcfg),
@@ -149,6 +151,24 @@ func startNode(cfg *Config) error {
 	if err != nil {
 		return err
 	}
+
+	if len(cfg.Misbehaviors) > 0 {
+		misbehaviors := make(map[int64]cs.Misbehavior, len(cfg.Misbehaviors))
+		for heightStr, m := range cfg.Misbehaviors {
+			height, err := strconv.ParseInt(heightStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid misbehavior height %q: %w", heightStr, err)
+			}
+			if misbehaviors[height], err = cs.ParseMisbehavior(m); err != nil {
+				return err
+			}
+		}
+		if err := n.ConsensusReactor().SetMisbehaviors(misbehaviors, pv.Key.PrivKey); err != nil {
+			return err
+		}
+		nodeLogger.Info("Misbehaviors set", "misbehaviors", cfg.Misbehaviors)
+	}
+
 	return n.Start()
 }
 
@@ -211,6 +211,22 @@ type ManifestNode struct {
 	// restart:    restarts the node, shutting it down with SIGTERM
 	Perturb []string `toml:"perturb"`
 
+	// Misbehaviors makes a validator misbehave at the given heights, which
+	// requires the builtin ABCI protocol and the file privval protocol:
+	//
+	// double-prevote:       sends conflicting prevotes
+	// double-precommit:     sends conflicting precommits
+	// conflicting-proposal: proposes different blocks to different peers
+	// invalid-block-parts:  proposes a block with corrupted block parts
+	// withhold-votes:       does not send any vote
+	//
+	// For example:
+	//
+	// [node.validator01.misbehaviors]
+	// 10 = "double-prevote"
+	// 15 = "withhold-votes"
+	Misbehaviors map[string]string `toml:"misbehaviors"`
+
 	// SendNoLoad determines if the e2e test should send load to this node.
 	// It defaults to false so unless the configured, the node will
 	// receive load.
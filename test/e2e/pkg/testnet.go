@@ -45,6 +45,7 @@ type (
 	Mode         string
 	Protocol     string
 	Perturbation string
+	Misbehavior  string
 	ZoneID       string
 )
 
@@ -67,6 +68,12 @@ const (
 	PerturbationRestart    Perturbation = "restart"
 	PerturbationUpgrade    Perturbation = "upgrade"
 
+	MisbehaviorDoublePrevote       Misbehavior = "double-prevote"
+	MisbehaviorDoublePrecommit     Misbehavior = "double-precommit"
+	MisbehaviorConflictingProposal Misbehavior = "conflicting-proposal"
+	MisbehaviorInvalidBlockParts   Misbehavior = "invalid-block-parts"
+	MisbehaviorWithholdVotes       Misbehavior = "withhold-votes"
+
 	EvidenceAgeHeight int64         = 14
 	EvidenceAgeTime   time.Duration = 1500 * time.Millisecond
 )
@@ -136,6 +143,7 @@ type Node struct {
 	Seeds                   []*Node
 	PersistentPeers         []*Node
 	Perturbations           []Perturbation
+	Misbehaviors            map[int64]Misbehavior
 	SendNoLoad              bool
 	Prometheus              bool
 	PrometheusProxyPort     uint32
@@ -272,6 +280,7 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 			RetainBlocks:            nodeManifest.RetainBlocks,
 			EnableCompanionPruning:  nodeManifest.EnableCompanionPruning,
 			Perturbations:           []Perturbation{},
+			Misbehaviors:            map[int64]Misbehavior{},
 			SendNoLoad:              nodeManifest.SendNoLoad,
 			Prometheus:              testnet.Prometheus,
 			Zone:                    ZoneID(nodeManifest.Zone),
@@ -309,6 +318,13 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		for _, p := range nodeManifest.Perturb {
 			node.Perturbations = append(node.Perturbations, Perturbation(p))
 		}
+		for heightStr, m := range nodeManifest.Misbehaviors {
+			height, err := strconv.ParseInt(heightStr, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid misbehavior height %q for node %q: %w", heightStr, name, err)
+			}
+			node.Misbehaviors[height] = Misbehavior(m)
+		}
 		if nodeManifest.Zone != "" {
 			node.Zone = ZoneID(nodeManifest.Zone)
 		} else if testnet.DefaultZone != "" {
@@ -596,6 +612,29 @@ func (n Node) Validate(testnet Testnet) error {
 		}
 	}
 
+	for height, misbehavior := range n.Misbehaviors {
+		switch misbehavior {
+		case MisbehaviorDoublePrevote, MisbehaviorDoublePrecommit, MisbehaviorConflictingProposal,
+			MisbehaviorInvalidBlockParts, MisbehaviorWithholdVotes:
+		default:
+			return fmt.Errorf("invalid misbehavior %q", misbehavior)
+		}
+		if height < n.Testnet.InitialHeight {
+			return fmt.Errorf("misbehavior height %v is lower than initial height %v", height, n.Testnet.InitialHeight)
+		}
+	}
+	if len(n.Misbehaviors) > 0 {
+		if n.Mode != ModeValidator {
+			return errors.New("misbehaviors only supported on validators")
+		}
+		if n.ABCIProtocol != ProtocolBuiltin && n.ABCIProtocol != ProtocolBuiltinConnSync {
+			return errors.New("misbehaviors require the builtin ABCI protocol")
+		}
+		if n.PrivvalProtocol != ProtocolFile {
+			return errors.New("misbehaviors require the file privval protocol")
+		}
+	}
+
 	return nil
 }
 
@@ -647,6 +686,18 @@ func (t Testnet) HasPerturbations() bool {
 	return false
 }
 
+// LastMisbehaviorHeight returns the highest height at which a node
+// misbehaves, or 0 if no node does.
+func (t Testnet) LastMisbehaviorHeight() int64 {
+	var last int64
+	for _, node := range t.Nodes {
+		for height := range node.Misbehaviors {
+			last = max(last, height)
+		}
+	}
+	return last
+}
+
 //go:embed templates/prometheus-yaml.tmpl
 var prometheusYamlTemplate string
 
@@ -158,6 +158,12 @@ func NewCLI() *CLI {
 				}
 			}
 
+			if height := cli.testnet.LastMisbehaviorHeight(); height > 0 {
+				if err := WaitUntil(cmd.Context(), cli.testnet, height+5); err != nil { // ensure evidence is committed
+					return err
+				}
+			}
+
 			loadCancel()
 			if err := <-chLoadResult; err != nil {
 				return err
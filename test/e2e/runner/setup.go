@@ -183,6 +183,7 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 	cfg.GRPC.VersionService.Enabled = true
 	cfg.GRPC.BlockService.Enabled = true
 	cfg.GRPC.BlockResultsService.Enabled = true
+	cfg.GRPC.MempoolService.Enabled = true
 
 	cfg.P2P.ExternalAddress = fmt.Sprintf("tcp://%v", node.AddressP2P(false))
 	cfg.P2P.AddrBookStrict = false
@@ -376,6 +377,14 @@ func MakeAppConfig(node *e2e.Node) ([]byte, error) {
 		cfg["validator_update"] = validatorUpdates
 	}
 
+	if len(node.Misbehaviors) > 0 {
+		misbehaviors := map[string]string{}
+		for height, misbehavior := range node.Misbehaviors {
+			misbehaviors[strconv.FormatInt(height, 10)] = string(misbehavior)
+		}
+		cfg["misbehaviors"] = misbehaviors
+	}
+
 	var buf bytes.Buffer
 	err := toml.NewEncoder(&buf).Encode(cfg)
 	if err != nil {
@@ -1,9 +1,13 @@
 package e2e_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+	"github.com/cometbft/cometbft/types"
 )
 
 // assert that all nodes that have blocks at the height of a misbehavior has evidence
@@ -13,10 +17,70 @@ func TestEvidence_Misbehavior(t *testing.T) {
 	testnet := loadTestnet(t)
 	seenEvidence := 0
 	for _, block := range blocks {
-		if len(block.Evidence.Evidence) != 0 {
-			seenEvidence += len(block.Evidence.Evidence)
+		for _, ev := range block.Evidence.Evidence {
+			// The evidence of the byzantine validators is checked by
+			// TestEvidence_ByzantineValidators.
+			if byzantineDoubleVote(testnet, ev) {
+				continue
+			}
+			seenEvidence++
 		}
 	}
 	require.Equal(t, testnet.Evidence, seenEvidence,
 		"difference between the amount of evidence produced and committed")
 }
+
+// assert that the double votes of the byzantine validators are committed as
+// evidence, and that the network kept producing blocks despite the
+// misbehaviors.
+func TestEvidence_ByzantineValidators(t *testing.T) {
+	blocks := fetchBlockChain(t)
+	testnet := loadTestnet(t)
+	last := testnet.LastMisbehaviorHeight()
+	if last == 0 {
+		return
+	}
+	require.Greater(t, blocks[len(blocks)-1].Height, last,
+		"the network did not produce blocks past the misbehaviors")
+
+	for _, node := range testnet.Nodes {
+		for height, misbehavior := range node.Misbehaviors {
+			if misbehavior != e2e.MisbehaviorDoublePrevote && misbehavior != e2e.MisbehaviorDoublePrecommit {
+				continue
+			}
+			if height < blocks[0].Height {
+				continue
+			}
+			found := false
+			for _, block := range blocks {
+				for _, ev := range block.Evidence.Evidence {
+					dve, ok := ev.(*types.DuplicateVoteEvidence)
+					if ok && dve.VoteA.Height == height &&
+						bytes.Equal(dve.VoteA.ValidatorAddress, node.PrivvalKey.PubKey().Address()) {
+						found = true
+					}
+				}
+			}
+			require.True(t, found, "no evidence committed for the %v of %v at height %v",
+				misbehavior, node.Name, height)
+		}
+	}
+}
+
+// byzantineDoubleVote returns whether ev is the evidence of a double vote of a
+// byzantine validator of the testnet.
+func byzantineDoubleVote(testnet e2e.Testnet, ev types.Evidence) bool {
+	dve, ok := ev.(*types.DuplicateVoteEvidence)
+	if !ok {
+		return false
+	}
+	for _, node := range testnet.Nodes {
+		switch node.Misbehaviors[dve.VoteA.Height] {
+		case e2e.MisbehaviorDoublePrevote, e2e.MisbehaviorDoublePrecommit:
+			if bytes.Equal(dve.VoteA.ValidatorAddress, node.PrivvalKey.PubKey().Address()) {
+				return true
+			}
+		}
+	}
+	return false
+}
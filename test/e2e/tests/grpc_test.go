@@ -3,12 +3,15 @@ package e2e_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	grpcclient "github.com/cometbft/cometbft/rpc/grpc/client"
 	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
+	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/version"
 )
 
@@ -94,6 +97,42 @@ func TestGRPC_GetBlockResults(t *testing.T) {
 	})
 }
 
+// Test the GRPC Mempool service. Invoke the GetTxEvents method and check that
+// an event is received when a tx is added to the mempool.
+func TestGRPC_Mempool_GetTxEvents(t *testing.T) {
+	testFullNodesOrValidators(t, 0, func(t *testing.T, node e2e.Node) {
+		t.Helper()
+
+		ctx, ctxCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer ctxCancel()
+
+		gRPCClient, err := node.GRPCClient(ctx)
+		require.NoError(t, err)
+		defer gRPCClient.Close()
+
+		tx := types.Tx(fmt.Sprintf("testgrpc-tx-%v=%v", node.Name, time.Now().UnixNano()))
+		txEventsCh, err := gRPCClient.GetTxEvents(ctx,
+			grpcclient.GetTxEventsQuery(fmt.Sprintf("mempool_tx.hash='%X' AND mempool_tx.action='added'", tx.Hash())))
+		require.NoError(t, err)
+
+		client, err := node.Client()
+		require.NoError(t, err)
+		res, err := client.BroadcastTxSync(ctx, tx)
+		require.NoError(t, err)
+		require.Zero(t, res.Code)
+
+		select {
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for mempool tx event")
+		case event, ok := <-txEventsCh:
+			require.True(t, ok, "failed to receive mempool tx event from channel")
+			require.NoError(t, event.Error)
+			require.Equal(t, tx, event.Tx)
+			require.Equal(t, types.MempoolTxAdded, event.Action)
+		}
+	})
+}
+
 // Test the GRPC Privileged Pruning Service methods to set and get the block retain height.
 func TestGRPC_BlockRetainHeight(t *testing.T) {
 	t.Helper()
@@ -13,6 +13,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/bits"
@@ -145,6 +146,15 @@ func (b *Block) Hash() cmtbytes.HexBytes {
 // This is the form in which the block is gossipped to peers.
 // CONTRACT: partSize is greater than zero.
 func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
+	return b.MakeCodedPartSet(partSize, 0)
+}
+
+// MakeCodedPartSet returns an erasure-coded PartSet containing parts of a
+// serialized block, with the given number of parity parts. The block can be
+// reconstructed from any Total-Parity of the parts. If parity is zero, it is
+// equivalent to MakePartSet.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakeCodedPartSet(partSize, parity uint32) (*PartSet, error) {
 	if b == nil {
 		return nil, errors.New("nil block")
 	}
@@ -159,7 +169,7 @@ func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewPartSetFromData(bz, partSize), nil
+	return NewCodedPartSetFromData(bz, partSize, parity)
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -692,6 +702,28 @@ func (cs CommitSig) ValidateBasic() error {
 	return nil
 }
 
+// validateBasicAggregated performs basic validation of a CommitSig of an
+// aggregated commit, which has neither a validator address nor a signature.
+func (cs CommitSig) validateBasicAggregated() error {
+	switch cs.BlockIDFlag {
+	case BlockIDFlagAbsent:
+		if !cs.Timestamp.IsZero() {
+			return errors.New("time is present")
+		}
+	case BlockIDFlagCommit, BlockIDFlagNil:
+	default:
+		return fmt.Errorf("unknown BlockIDFlag: %v", cs.BlockIDFlag)
+	}
+
+	if len(cs.ValidatorAddress) != 0 {
+		return errors.New("validator address is present")
+	}
+	if len(cs.Signature) != 0 {
+		return errors.New("signature is present")
+	}
+	return nil
+}
+
 // ToProto converts CommitSig to protobuf.
 func (cs *CommitSig) ToProto() *cmtproto.CommitSig {
 	if cs == nil {
@@ -850,6 +882,11 @@ type Commit struct {
 	Round      int32       `json:"round"`
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
+	// AggregatedSignature, if set, is the BLS12-381 aggregate of the
+	// signatures of all the non-absent CommitSigs, which then have neither a
+	// ValidatorAddress nor a Signature: validators are identified by their
+	// index in the validator set. See Aggregate.
+	AggregatedSignature []byte `json:"aggregated_signature,omitempty"`
 
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
@@ -866,9 +903,80 @@ func (commit *Commit) Clone() *Commit {
 	return &commCopy
 }
 
+// IsAggregated returns true if the signatures of the commit are aggregated.
+func (commit *Commit) IsAggregated() bool {
+	return len(commit.AggregatedSignature) > 0
+}
+
+// Aggregate returns a copy of the commit, whose signatures are aggregated into
+// a single signature: the CommitSigs only retain their BlockIDFlag and
+// Timestamp. All the signatures must be BLS12-381 signatures, i.e. all the
+// validators must use BLS12-381 keys (see ValidatorSet.AllKeysAreBLS12381).
+//
+// An aggregated signature is only valid if the signed votes are distinct (see
+// bls12381.VerifyAggregateSignature), so the commit is returned as is if two
+// of its votes have the same BlockIDFlag and Timestamp.
+func (commit *Commit) Aggregate() (*Commit, error) {
+	if commit.IsAggregated() {
+		return commit, nil
+	}
+
+	type signedVote struct {
+		flag      BlockIDFlag
+		timestamp int64
+	}
+	var (
+		sigs       = make([][]byte, 0, len(commit.Signatures))
+		aggregated = make([]CommitSig, len(commit.Signatures))
+		signed     = make(map[signedVote]struct{}, len(commit.Signatures))
+	)
+	for i, commitSig := range commit.Signatures {
+		aggregated[i] = CommitSig{
+			BlockIDFlag: commitSig.BlockIDFlag,
+			Timestamp:   commitSig.Timestamp,
+		}
+		if commitSig.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+		vote := signedVote{flag: commitSig.BlockIDFlag, timestamp: commitSig.Timestamp.UnixNano()}
+		if _, ok := signed[vote]; ok {
+			return commit, nil
+		}
+		signed[vote] = struct{}{}
+		sigs = append(sigs, commitSig.Signature)
+	}
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating commit signatures: %w", err)
+	}
+
+	return &Commit{
+		Height:              commit.Height,
+		Round:               commit.Round,
+		BlockID:             commit.BlockID,
+		Signatures:          aggregated,
+		AggregatedSignature: aggSig,
+	}, nil
+}
+
+// SignerAddress returns the address of the validator at valIdx, which is the
+// ValidatorAddress of its CommitSig, or for an aggregated commit, the address
+// of the validator at valIdx in vals, the validator set of the commit. Returns
+// nil if there is no such validator.
+func (commit *Commit) SignerAddress(valIdx int32, vals *ValidatorSet) Address {
+	if !commit.IsAggregated() {
+		return commit.Signatures[valIdx].ValidatorAddress
+	}
+	if commit.Signatures[valIdx].BlockIDFlag == BlockIDFlagAbsent || vals == nil || int(valIdx) >= vals.Size() {
+		return nil
+	}
+	return vals.Validators[valIdx].Address
+}
+
 // GetVote converts the CommitSig for the given valIdx to a Vote. Commits do
 // not contain vote extensions, so the vote extension and vote extension
-// signature will not be present in the returned vote.
+// signature will not be present in the returned vote. Neither will the
+// validator address and the signature if the commit is aggregated.
 // Returns nil if the precommit at valIdx is nil.
 // Panics if valIdx >= commit.Size().
 func (commit *Commit) GetVote(valIdx int32) *Vote {
@@ -925,11 +1033,36 @@ func (commit *Commit) ValidateBasic() error {
 		if len(commit.Signatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
+		if commit.IsAggregated() {
+			return commit.validateBasicAggregated()
+		}
 		for i, commitSig := range commit.Signatures {
 			if err := commitSig.ValidateBasic(); err != nil {
 				return fmt.Errorf("wrong CommitSig #%d: %w", i, err)
 			}
 		}
+	} else if commit.IsAggregated() {
+		return errors.New("aggregated signature is present")
+	}
+	return nil
+}
+
+func (commit *Commit) validateBasicAggregated() error {
+	if len(commit.AggregatedSignature) != bls12381.SignatureLength {
+		return fmt.Errorf("expected AggregatedSignature size to be %d bytes, got %d bytes",
+			bls12381.SignatureLength,
+			len(commit.AggregatedSignature),
+		)
+	}
+	signed := false
+	for i, commitSig := range commit.Signatures {
+		if err := commitSig.validateBasicAggregated(); err != nil {
+			return fmt.Errorf("wrong CommitSig #%d: %w", i, err)
+		}
+		signed = signed || commitSig.BlockIDFlag != BlockIDFlagAbsent
+	}
+	if !signed {
+		return errors.New("no signatures in aggregated commit")
 	}
 	return nil
 }
@@ -949,7 +1082,7 @@ func (commit *Commit) MedianTime(validators *ValidatorSet) time.Time {
 		if commitSig.BlockIDFlag == BlockIDFlagAbsent {
 			continue
 		}
-		_, validator := validators.GetByAddressMut(commitSig.ValidatorAddress)
+		_, validator := validators.GetByAddressMut(commit.SignerAddress(int32(i), validators))
 		// If there's no condition, TestValidateBlockCommit panics; not needed normally.
 		if validator != nil {
 			totalVotingPower += validator.VotingPower
@@ -976,6 +1109,9 @@ func (commit *Commit) Hash() cmtbytes.HexBytes {
 
 			bs[i] = bz
 		}
+		if commit.IsAggregated() {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.HashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -1016,12 +1152,14 @@ func (commit *Commit) StringIndented(indent string) string {
 %s  BlockID:    %v
 %s  Signatures:
 %s    %v
+%s  AggregatedSignature: %X
 %s}#%v`,
 		indent, commit.Height,
 		indent, commit.Round,
 		indent, commit.BlockID,
 		indent,
 		indent, strings.Join(commitSigStrings, "\n"+indent+"    "),
+		indent, cmtbytes.Fingerprint(commit.AggregatedSignature),
 		indent, commit.hash)
 }
 
@@ -1041,6 +1179,7 @@ func (commit *Commit) ToProto() *cmtproto.Commit {
 	c.Height = commit.Height
 	c.Round = commit.Round
 	c.BlockID = commit.BlockID.ToProto()
+	c.AggregatedSignature = commit.AggregatedSignature
 
 	return c
 }
@@ -1061,7 +1200,15 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 
 	sigs := make([]CommitSig, len(cp.Signatures))
 	for i := range cp.Signatures {
-		if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
+		// The CommitSigs of an aggregated commit are validated along with it.
+		if len(cp.AggregatedSignature) > 0 {
+			sigs[i] = CommitSig{
+				BlockIDFlag:      BlockIDFlag(cp.Signatures[i].BlockIdFlag),
+				ValidatorAddress: cp.Signatures[i].ValidatorAddress,
+				Timestamp:        cp.Signatures[i].Timestamp,
+				Signature:        cp.Signatures[i].Signature,
+			}
+		} else if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
 			return nil, err
 		}
 	}
@@ -1070,6 +1217,7 @@ func CommitFromProto(cp *cmtproto.Commit) (*Commit, error) {
 	commit.Height = cp.Height
 	commit.Round = cp.Round
 	commit.BlockID = *bi
+	commit.AggregatedSignature = cp.AggregatedSignature
 
 	return commit, commit.ValidateBasic()
 }
@@ -1124,9 +1272,13 @@ func (ec *ExtendedCommit) addSigsToVoteSet(voteSet *VoteSet) {
 }
 
 // ToVoteSet constructs a VoteSet from the Commit and validator set.
-// Panics if signatures from the commit can't be added to the voteset.
+// Panics if signatures from the commit can't be added to the voteset, which is
+// the case of an aggregated commit.
 // Inverse of VoteSet.MakeCommit().
 func (commit *Commit) ToVoteSet(chainID string, vals *ValidatorSet) *VoteSet {
+	if commit.IsAggregated() {
+		panic("cannot reconstruct the votes of an aggregated commit")
+	}
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, PrecommitType, vals)
 	for idx, cs := range commit.Signatures {
 		if cs.BlockIDFlag == BlockIDFlagAbsent {
@@ -15,6 +15,7 @@ import (
 
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/bits"
@@ -186,7 +187,7 @@ func makeBlockIDRandom() BlockID {
 	)
 	rand.Read(blockHash)   //nolint: errcheck // ignore errcheck for read
 	rand.Read(partSetHash) //nolint: errcheck // ignore errcheck for read
-	return BlockID{blockHash, PartSetHeader{123, partSetHash}}
+	return BlockID{blockHash, PartSetHeader{Total: 123, Hash: partSetHash}}
 }
 
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) BlockID {
@@ -265,6 +266,93 @@ func TestCommitValidateBasic(t *testing.T) {
 	}
 }
 
+// aggregatedCommit returns the commit in aggregated form, with a random
+// aggregated signature.
+func aggregatedCommit(commit *Commit) *Commit {
+	sigs := make([]CommitSig, len(commit.Signatures))
+	for i, commitSig := range commit.Signatures {
+		sigs[i] = CommitSig{BlockIDFlag: commitSig.BlockIDFlag, Timestamp: commitSig.Timestamp}
+	}
+	return &Commit{
+		Height:              commit.Height,
+		Round:               commit.Round,
+		BlockID:             commit.BlockID,
+		Signatures:          sigs,
+		AggregatedSignature: cmtrand.Bytes(bls12381.SignatureLength),
+	}
+}
+
+func TestAggregatedCommitValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName       string
+		malleateCommit func(*Commit)
+		expectErr      bool
+	}{
+		{"Aggregated Commit", func(_ *Commit) {}, false},
+		{"Incorrect aggregated signature", func(com *Commit) { com.AggregatedSignature = []byte{0} }, true},
+		{"Signature present", func(com *Commit) { com.Signatures[0].Signature = cmtrand.Bytes(64) }, true},
+		{"Validator address present", func(com *Commit) {
+			com.Signatures[0].ValidatorAddress = cmtrand.Bytes(crypto.AddressSize)
+		}, true},
+		{"No signatures", func(com *Commit) {
+			for i := range com.Signatures {
+				com.Signatures[i] = NewCommitSigAbsent()
+			}
+		}, true},
+		{"Zero height", func(com *Commit) { com.Height = 0 }, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			com := aggregatedCommit(randCommit(cmttime.Now()))
+			tc.malleateCommit(com)
+			assert.Equal(t, tc.expectErr, com.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestAggregatedCommit(t *testing.T) {
+	commit := randCommit(cmttime.Now())
+	aggCommit := aggregatedCommit(commit)
+	assert.False(t, commit.IsAggregated())
+	assert.True(t, aggCommit.IsAggregated())
+
+	// The aggregated signature is part of the hash.
+	assert.NotEqual(t, commit.Hash(), aggCommit.Hash())
+	aggCommit2 := aggregatedCommit(commit)
+	assert.NotEqual(t, aggCommit.Hash(), aggCommit2.Hash())
+
+	// The signers are the validators at the same index.
+	vals, _ := RandValidatorSet(len(commit.Signatures), 1)
+	for i := range commit.Signatures {
+		assert.Equal(t, commit.Signatures[i].ValidatorAddress, commit.SignerAddress(int32(i), vals))
+		assert.Equal(t, vals.Validators[i].Address, aggCommit.SignerAddress(int32(i), vals))
+	}
+	assert.Equal(t, commit.VoteSignBytes("test_chain_id", 0), aggCommit.VoteSignBytes("test_chain_id", 0))
+
+	// Aggregated commits survive a protobuf round trip.
+	aggCommit3, err := CommitFromProto(aggCommit.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit.AggregatedSignature, aggCommit3.AggregatedSignature)
+	assert.Equal(t, aggCommit.Hash(), aggCommit3.Hash())
+
+	// The votes of an aggregated commit cannot be reconstructed.
+	assert.Panics(t, func() { aggCommit.ToVoteSet("test_chain_id", vals) })
+
+	// The votes signed at the same time have the same sign bytes, so the
+	// commit is not aggregated.
+	notAggCommit, err := commit.Aggregate()
+	require.NoError(t, err)
+	assert.Equal(t, commit, notAggCommit)
+
+	// Only BLS12-381 signatures can be aggregated.
+	for i := range commit.Signatures {
+		commit.Signatures[i].Timestamp = commit.Signatures[i].Timestamp.Add(time.Duration(i))
+	}
+	_, err = commit.Aggregate()
+	require.Error(t, err)
+	assert.False(t, vals.AllKeysAreBLS12381())
+}
+
 func TestMaxCommitBytes(t *testing.T) {
 	// time is varint encoded so need to pick the max.
 	// year int, month Month, day, hour, min, sec, nsec int, loc *Location
@@ -186,6 +186,31 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+// PublishEventMempoolTx publishes a mempool tx event. It adds the predefined
+// keys MempoolTxHashKey, MempoolTxActionKey, MempoolTxSizeKey,
+// MempoolTxGasWantedKey, MempoolTxSenderKey and MempoolTxReasonKey (the last
+// two only if not empty), so that subscribers can filter the events.
+func (b *EventBus) PublishEventMempoolTx(data EventDataMempoolTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:          {EventMempoolTx},
+		MempoolTxHashKey:      {fmt.Sprintf("%X", data.Tx.Hash())},
+		MempoolTxActionKey:    {data.Action},
+		MempoolTxSizeKey:      {strconv.Itoa(len(data.Tx))},
+		MempoolTxGasWantedKey: {strconv.FormatInt(data.GasWanted, 10)},
+	}
+	if data.Sender != "" {
+		events[MempoolTxSenderKey] = []string{data.Sender}
+	}
+	if data.Reason != "" {
+		events[MempoolTxReasonKey] = []string{data.Reason}
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return b.Publish(EventNewRoundStep, data)
 }
@@ -266,6 +291,10 @@ func (NopEventBus) PublishEventTx(EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventMempoolTx(EventDataMempoolTx) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(EventDataRoundState) error {
 	return nil
 }
@@ -342,6 +342,47 @@ func TestEventBusPublishEventNewEvidence(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventMempoolTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	query := fmt.Sprintf("tm.event='MempoolTx' AND mempool_tx.hash='%X' AND mempool_tx.action='evicted' AND "+
+		"mempool_tx.size=3 AND mempool_tx.gas_wanted>5 AND mempool_tx.sender='peer' AND mempool_tx.reason='ttl'", tx.Hash())
+	txsSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustCompile(query))
+	require.NoError(t, err)
+
+	data := EventDataMempoolTx{
+		Tx:        tx,
+		Action:    MempoolTxEvicted,
+		Height:    2,
+		GasWanted: 10,
+		Sender:    "peer",
+		Reason:    "ttl",
+	}
+	done := make(chan struct{})
+	go func() {
+		msg := <-txsSub.Out()
+		assert.Equal(t, data, msg.Data().(EventDataMempoolTx))
+		close(done)
+	}()
+
+	err = eventBus.PublishEventMempoolTx(data)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a mempool tx after 1 sec.")
+	}
+}
+
 func TestEventBusPublish(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -352,7 +393,7 @@ func TestEventBusPublish(t *testing.T) {
 		}
 	})
 
-	const numEventsExpected = 14
+	const numEventsExpected = 15
 
 	sub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.All, numEventsExpected)
 	require.NoError(t, err)
@@ -397,6 +438,8 @@ func TestEventBusPublish(t *testing.T) {
 	require.NoError(t, err)
 	err = eventBus.PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates{})
 	require.NoError(t, err)
+	err = eventBus.PublishEventMempoolTx(EventDataMempoolTx{})
+	require.NoError(t, err)
 
 	select {
 	case <-done:
@@ -23,6 +23,11 @@ const (
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// Mempool events.
+	// These are triggered from the mempool when a tx is added to it, evicted
+	// from it, or removed from it after being committed.
+	EventMempoolTx = "MempoolTx"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -52,6 +57,7 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
+	cmtjson.RegisterType(EventDataMempoolTx{}, "tendermint/event/MempoolTx")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
@@ -89,6 +95,28 @@ type EventDataTx struct {
 	abci.TxResult
 }
 
+// Actions reported by EventDataMempoolTx.
+const (
+	MempoolTxAdded     = "added"
+	MempoolTxEvicted   = "evicted"
+	MempoolTxCommitted = "committed"
+)
+
+// EventDataMempoolTx is fired when a tx is added to the mempool, evicted from
+// it, or removed from it because it was included in a committed block.
+type EventDataMempoolTx struct {
+	Tx     Tx     `json:"tx"`
+	Action string `json:"action"`
+	// Height at which the tx was added, evicted or committed.
+	Height    int64 `json:"height"`
+	GasWanted int64 `json:"gas_wanted"`
+	// ID of the peer that first sent the tx. It is empty if the tx was
+	// received via RPC.
+	Sender string `json:"sender"`
+	// Reason why the tx was evicted: "recheck", "full" or "ttl".
+	Reason string `json:"reason"`
+}
+
 // NOTE: This goes into the replay WAL.
 type EventDataRoundState struct {
 	Height int64  `json:"height"`
@@ -143,11 +171,21 @@ const (
 
 	// BlockHeightKey is a reserved key used for indexing FinalizeBlock events.
 	BlockHeightKey = "block.height"
+
+	// Reserved keys of mempool tx events.
+	// see EventBus#PublishEventMempoolTx.
+	MempoolTxHashKey      = "mempool_tx.hash"
+	MempoolTxActionKey    = "mempool_tx.action"
+	MempoolTxSizeKey      = "mempool_tx.size"
+	MempoolTxGasWantedKey = "mempool_tx.gas_wanted"
+	MempoolTxSenderKey    = "mempool_tx.sender"
+	MempoolTxReasonKey    = "mempool_tx.reason"
 )
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
 	EventQueryLock                = QueryForEvent(EventLock)
+	EventQueryMempoolTx           = QueryForEvent(EventMempoolTx)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewBlockEvents      = QueryForEvent(EventNewBlockEvents)
@@ -185,3 +223,8 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(tx EventDataTx) error
 }
+
+// MempoolEventPublisher publishes mempool related events.
+type MempoolEventPublisher interface {
+	PublishEventMempoolTx(tx EventDataMempoolTx) error
+}
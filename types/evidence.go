@@ -257,12 +257,13 @@ func (l *LightClientAttackEvidence) GetByzantineValidators(commonVals *Validator
 	// First check if the header is invalid. This means that it is a lunatic attack and therefore we take the
 	// validators who are in the commonVals and voted for the lunatic header
 	if l.ConflictingHeaderIsInvalid(trusted.Header) {
-		for _, commitSig := range l.ConflictingBlock.Commit.Signatures {
+		for i, commitSig := range l.ConflictingBlock.Commit.Signatures {
 			if commitSig.BlockIDFlag != BlockIDFlagCommit {
 				continue
 			}
 
-			_, val := commonVals.GetByAddress(commitSig.ValidatorAddress)
+			address := l.ConflictingBlock.Commit.SignerAddress(int32(i), l.ConflictingBlock.ValidatorSet)
+			_, val := commonVals.GetByAddress(address)
 			if val == nil {
 				// validator wasn't in the common validator set
 				continue
@@ -287,7 +288,8 @@ func (l *LightClientAttackEvidence) GetByzantineValidators(commonVals *Validator
 				continue
 			}
 
-			_, val := l.ConflictingBlock.ValidatorSet.GetByAddress(sigA.ValidatorAddress)
+			address := l.ConflictingBlock.Commit.SignerAddress(int32(i), l.ConflictingBlock.ValidatorSet)
+			_, val := l.ConflictingBlock.ValidatorSet.GetByAddress(address)
 			validators = append(validators, val)
 		}
 		sort.Sort(ValidatorsByVotingPower(validators))
@@ -65,10 +65,12 @@ type EvidenceParams struct {
 	MaxBytes        int64         `json:"max_bytes"`
 }
 
-// ValidatorParams restrict the public key types validators can use.
+// ValidatorParams restrict the public key types validators can use, and
+// set the algorithm selecting the proposer among them.
 // NOTE: uses ABCI pubkey naming, not Amino names.
 type ValidatorParams struct {
-	PubKeyTypes []string `json:"pub_key_types"`
+	PubKeyTypes       []string          `json:"pub_key_types"`
+	ProposerSelection ProposerSelection `json:"proposer_selection"`
 }
 
 // VersionParams contain the version of specific components of CometBFT.
@@ -169,10 +171,12 @@ func DefaultEvidenceParams() EvidenceParams {
 }
 
 // DefaultValidatorParams returns a default ValidatorParams, which allows
-// only ed25519 pubkeys.
+// only ed25519 pubkeys and selects the proposer with the weighted
+// round-robin.
 func DefaultValidatorParams() ValidatorParams {
 	return ValidatorParams{
-		PubKeyTypes: []string{ABCIPubKeyTypeEd25519},
+		PubKeyTypes:       []string{ABCIPubKeyTypeEd25519},
+		ProposerSelection: ProposerSelectionWeightedRoundRobin,
 	}
 }
 
@@ -285,6 +289,11 @@ func (params ConsensusParams) ValidateBasic() error {
 		}
 	}
 
+	if !params.Validator.ProposerSelection.IsValid() {
+		return fmt.Errorf("validator.ProposerSelection, %d, is an unknown proposer selection",
+			params.Validator.ProposerSelection)
+	}
+
 	return nil
 }
 
@@ -377,15 +386,18 @@ func validateUpdateFeatureEnableHeight(param int64, updated int64, h int64, feat
 }
 
 // Hash returns a hash of a subset of the parameters to store in the block header.
-// Only the Block.MaxBytes and Block.MaxGas are included in the hash.
-// This allows the ConsensusParams to evolve more without breaking the block
-// protocol. No need for a Merkle tree here, just a small struct to hash.
+// Only the Block.MaxBytes, Block.MaxGas and Validator.ProposerSelection are
+// included in the hash. The latter is omitted from the encoding when it is the
+// default weighted round-robin, so that the hash is unchanged for the chains
+// using it. This allows the ConsensusParams to evolve more without breaking the
+// block protocol. No need for a Merkle tree here, just a small struct to hash.
 func (params ConsensusParams) Hash() []byte {
 	hasher := tmhash.New()
 
 	hp := cmtproto.HashedParams{
-		BlockMaxBytes: params.Block.MaxBytes,
-		BlockMaxGas:   params.Block.MaxGas,
+		BlockMaxBytes:     params.Block.MaxBytes,
+		BlockMaxGas:       params.Block.MaxGas,
+		ProposerSelection: cmtproto.ProposerSelection(params.Validator.ProposerSelection),
 	}
 
 	bz, err := hp.Marshal()
@@ -423,6 +435,9 @@ func (params ConsensusParams) Update(params2 *cmtproto.ConsensusParams) Consensu
 		// Copy params2.Validator.PubkeyTypes, and set result's value to the copy.
 		// This avoids having to initialize the slice to 0 values, and then write to it again.
 		res.Validator.PubKeyTypes = append([]string{}, params2.Validator.PubKeyTypes...)
+		if params2.Validator.ProposerSelection != nil {
+			res.Validator.ProposerSelection = ProposerSelection(params2.Validator.ProposerSelection.Value)
+		}
 	}
 	if params2.Version != nil {
 		res.Version.App = params2.Version.App
@@ -461,6 +476,9 @@ func (params *ConsensusParams) ToProto() cmtproto.ConsensusParams {
 		},
 		Validator: &cmtproto.ValidatorParams{
 			PubKeyTypes: params.Validator.PubKeyTypes,
+			ProposerSelection: &cmtproto.ProposerSelectionValue{
+				Value: cmtproto.ProposerSelection(params.Validator.ProposerSelection),
+			},
 		},
 		Version: &cmtproto.VersionParams{
 			App: params.Version.App,
@@ -488,7 +506,8 @@ func ConsensusParamsFromProto(pbParams cmtproto.ConsensusParams) ConsensusParams
 			MaxBytes:        pbParams.Evidence.MaxBytes,
 		},
 		Validator: ValidatorParams{
-			PubKeyTypes: pbParams.Validator.PubKeyTypes,
+			PubKeyTypes:       pbParams.Validator.PubKeyTypes,
+			ProposerSelection: ProposerSelection(pbParams.Validator.GetProposerSelection().GetValue()),
 		},
 		Version: VersionParams{
 			App: pbParams.Version.App,
@@ -289,6 +289,25 @@ func TestConsensusParamsValidation(t *testing.T) {
 				}),
 			valid: true,
 		},
+		// validator params
+		{
+			name: "stake-weighted random proposer selection",
+			params: makeParams(makeParamsArgs{
+				blockBytes:        1,
+				evidenceAge:       2,
+				proposerSelection: ProposerSelectionStakeWeightedRandom,
+			}),
+			valid: true,
+		},
+		{
+			name: "unknown proposer selection",
+			params: makeParams(makeParamsArgs{
+				blockBytes:        1,
+				evidenceAge:       2,
+				proposerSelection: 7,
+			}),
+			valid: false,
+		},
 	}
 	for _, tc := range testCases {
 		if tc.valid {
@@ -307,6 +326,7 @@ type makeParamsArgs struct {
 	evidenceAge         int64
 	maxEvidenceBytes    int64
 	pubkeyTypes         []string
+	proposerSelection   ProposerSelection
 	voteExtensionHeight int64
 	pbtsHeight          int64
 	precision           time.Duration
@@ -329,7 +349,8 @@ func makeParams(args makeParamsArgs) ConsensusParams {
 			MaxBytes:        args.maxEvidenceBytes,
 		},
 		Validator: ValidatorParams{
-			PubKeyTypes: args.pubkeyTypes,
+			PubKeyTypes:       args.pubkeyTypes,
+			ProposerSelection: args.proposerSelection,
 		},
 		Synchrony: SynchronyParams{
 			Precision:    args.precision,
@@ -352,6 +373,7 @@ func TestConsensusParamsHash(t *testing.T) {
 		makeParams(makeParamsArgs{blockBytes: 9, blockGas: 5, evidenceAge: 4, maxEvidenceBytes: 1}),
 		makeParams(makeParamsArgs{blockBytes: 7, blockGas: 8, evidenceAge: 9, maxEvidenceBytes: 1}),
 		makeParams(makeParamsArgs{blockBytes: 4, blockGas: 6, evidenceAge: 5, maxEvidenceBytes: 1}),
+		makeParams(makeParamsArgs{blockBytes: 4, blockGas: 6, evidenceAge: 5, maxEvidenceBytes: 1, proposerSelection: ProposerSelectionStakeWeightedRandom}),
 	}
 
 	hashes := make([][]byte, len(params))
@@ -487,6 +509,56 @@ func TestConsensusParamsUpdate(t *testing.T) {
 			},
 			updatedParams: makeParams(makeParamsArgs{blockBytes: 1, blockGas: 2, evidenceAge: 3, pubkeyTypes: valEd25519AndSecp256k1}),
 		},
+
+		// proposer selection
+		{
+			intialParams: makeParams(makeParamsArgs{blockBytes: 1, blockGas: 2, evidenceAge: 3}),
+			updates: &cmtproto.ConsensusParams{
+				Validator: &cmtproto.ValidatorParams{
+					PubKeyTypes: valEd25519,
+					ProposerSelection: &cmtproto.ProposerSelectionValue{
+						Value: cmtproto.ProposerSelectionStakeWeightedRandom,
+					},
+				},
+			},
+			updatedParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				proposerSelection: ProposerSelectionStakeWeightedRandom,
+			}),
+		},
+		// an update of the pubkey types keeps the proposer selection
+		{
+			intialParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				proposerSelection: ProposerSelectionStakeWeightedRandom,
+			}),
+			updates: &cmtproto.ConsensusParams{
+				Validator: &cmtproto.ValidatorParams{
+					PubKeyTypes: valSecp256k1,
+				},
+			},
+			updatedParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				pubkeyTypes:       valSecp256k1,
+				proposerSelection: ProposerSelectionStakeWeightedRandom,
+			}),
+		},
+		// the weighted round-robin can be selected back explicitly
+		{
+			intialParams: makeParams(makeParamsArgs{
+				blockBytes: 1, blockGas: 2, evidenceAge: 3,
+				proposerSelection: ProposerSelectionStakeWeightedRandom,
+			}),
+			updates: &cmtproto.ConsensusParams{
+				Validator: &cmtproto.ValidatorParams{
+					PubKeyTypes: valEd25519,
+					ProposerSelection: &cmtproto.ProposerSelectionValue{
+						Value: cmtproto.ProposerSelectionWeightedRoundRobin,
+					},
+				},
+			},
+			updatedParams: makeParams(makeParamsArgs{blockBytes: 1, blockGas: 2, evidenceAge: 3}),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -645,6 +717,7 @@ func consensusParamsForTestProto() []ConsensusParams {
 		makeParams(makeParamsArgs{pbtsHeight: 100}),
 		makeParams(makeParamsArgs{voteExtensionHeight: 100, pbtsHeight: 42}),
 		makeParams(makeParamsArgs{pbtsHeight: 100}),
+		makeParams(makeParamsArgs{proposerSelection: ProposerSelectionStakeWeightedRandom}),
 	}
 }
 
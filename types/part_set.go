@@ -2,13 +2,16 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/internal/bits"
+	"github.com/cometbft/cometbft/internal/erasure"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
@@ -20,6 +23,21 @@ var (
 	ErrPartSetInvalidProof    = errors.New("error part set invalid proof")
 	ErrPartTooBig             = errors.New("error part size too big")
 	ErrPartInvalidSize        = errors.New("error inner part with invalid size")
+	// ErrPartSetInconsistentCoding is returned when the parts of an
+	// erasure-coded part set are not consistent with each other, i.e. when
+	// the data reconstructed from them does not hash to the part set hash.
+	ErrPartSetInconsistentCoding = errors.New("error part set inconsistent erasure coding")
+)
+
+const (
+	// MaxCodedParts is the maximum total number of parts, data and parity, of
+	// an erasure-coded part set.
+	MaxCodedParts = erasure.MaxShards
+
+	// codedLengthPrefixSize is the size of the length prefix of the data of
+	// an erasure-coded part set, which is padded to a multiple of the part
+	// size.
+	codedLengthPrefixSize = 4
 )
 
 type Part struct {
@@ -100,22 +118,34 @@ func PartFromProto(pb *cmtproto.Part) (*Part, error) {
 type PartSetHeader struct {
 	Total uint32            `json:"total"`
 	Hash  cmtbytes.HexBytes `json:"hash"`
+	// Number of parity parts, among the total, of an erasure-coded part set.
+	Parity uint32 `json:"parity,omitempty"`
 }
 
 // String returns a string representation of PartSetHeader.
 //
 // 1. total number of parts
-// 2. first 6 bytes of the hash.
+// 2. number of parity parts, if erasure-coded
+// 3. first 6 bytes of the hash.
 func (psh PartSetHeader) String() string {
+	if psh.Parity > 0 {
+		return fmt.Sprintf("%v(%v parity):%X", psh.Total, psh.Parity, cmtbytes.Fingerprint(psh.Hash))
+	}
 	return fmt.Sprintf("%v:%X", psh.Total, cmtbytes.Fingerprint(psh.Hash))
 }
 
 func (psh PartSetHeader) IsZero() bool {
-	return psh.Total == 0 && len(psh.Hash) == 0
+	return psh.Total == 0 && len(psh.Hash) == 0 && psh.Parity == 0
 }
 
 func (psh PartSetHeader) Equals(other PartSetHeader) bool {
-	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash)
+	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash) && psh.Parity == other.Parity
+}
+
+// DataTotal returns the number of data parts, that is, the number of parts
+// needed to reconstruct the data.
+func (psh PartSetHeader) DataTotal() uint32 {
+	return psh.Total - psh.Parity
 }
 
 // ValidateBasic performs basic validation.
@@ -124,6 +154,14 @@ func (psh PartSetHeader) ValidateBasic() error {
 	if err := ValidateHash(psh.Hash); err != nil {
 		return fmt.Errorf("wrong Hash: %w", err)
 	}
+	if psh.Parity > 0 {
+		if psh.Total > MaxCodedParts {
+			return fmt.Errorf("too many parts for an erasure-coded part set: %d > %d", psh.Total, MaxCodedParts)
+		}
+		if psh.Parity >= psh.Total {
+			return fmt.Errorf("too many parity parts: %d of %d", psh.Parity, psh.Total)
+		}
+	}
 	return nil
 }
 
@@ -134,8 +172,9 @@ func (psh *PartSetHeader) ToProto() cmtproto.PartSetHeader {
 	}
 
 	return cmtproto.PartSetHeader{
-		Total: psh.Total,
-		Hash:  psh.Hash,
+		Total:  psh.Total,
+		Hash:   psh.Hash,
+		Parity: psh.Parity,
 	}
 }
 
@@ -147,6 +186,7 @@ func PartSetHeaderFromProto(ppsh *cmtproto.PartSetHeader) (*PartSetHeader, error
 	psh := new(PartSetHeader)
 	psh.Total = ppsh.Total
 	psh.Hash = ppsh.Hash
+	psh.Parity = ppsh.Parity
 
 	return psh, psh.ValidateBasic()
 }
@@ -154,14 +194,15 @@ func PartSetHeaderFromProto(ppsh *cmtproto.PartSetHeader) (*PartSetHeader, error
 // ProtoPartSetHeaderIsZero is similar to the IsZero function for
 // PartSetHeader, but for the Protobuf representation.
 func ProtoPartSetHeaderIsZero(ppsh *cmtproto.PartSetHeader) bool {
-	return ppsh.Total == 0 && len(ppsh.Hash) == 0
+	return ppsh.Total == 0 && len(ppsh.Hash) == 0 && ppsh.Parity == 0
 }
 
 // -------------------------------------
 
 type PartSet struct {
-	total uint32
-	hash  []byte
+	total  uint32
+	hash   []byte
+	parity uint32
 
 	mtx           cmtsync.Mutex
 	parts         []*Part
@@ -170,6 +211,12 @@ type PartSet struct {
 	// a count of the total size (in bytes). Used to ensure that the
 	// part set doesn't exceed the maximum block bytes
 	byteSize int64
+
+	// data is the decoded data of a complete erasure-coded part set.
+	data []byte
+	// codingErr is set if the parts of an erasure-coded part set turned out
+	// to be inconsistent, in which case the part set cannot be completed.
+	codingErr error
 }
 
 // NewPartSetFromData returns an immutable, full PartSet from the data bytes.
@@ -204,11 +251,87 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	}
 }
 
+// NewCodedPartSetFromData returns an immutable, full erasure-coded PartSet
+// from the data bytes. The length-prefixed data is padded and split into k
+// "partSize" data parts, to which "parity" parity parts are added, so that any
+// k of the parts are enough to reconstruct the data. The merkle tree is
+// computed over all the parts. If parity is zero, it is equivalent to
+// NewPartSetFromData.
+// CONTRACT: partSize is greater than zero.
+func NewCodedPartSetFromData(data []byte, partSize, parity uint32) (*PartSet, error) {
+	if parity == 0 {
+		return NewPartSetFromData(data, partSize), nil
+	}
+	dataTotal := numCodedDataParts(len(data), partSize)
+	total := dataTotal + parity
+	if total > MaxCodedParts {
+		return nil, fmt.Errorf("too many parts for an erasure-coded part set: %d > %d", total, MaxCodedParts)
+	}
+	enc, err := erasure.NewEncoder(int(dataTotal), int(parity))
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, int(total)*int(partSize))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[codedLengthPrefixSize:], data)
+	partsBytes := make([][]byte, total)
+	for i := uint32(0); i < total; i++ {
+		partsBytes[i] = buf[i*partSize : (i+1)*partSize]
+	}
+	if err := enc.Encode(partsBytes); err != nil {
+		return nil, err
+	}
+
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	parts := make([]*Part, total)
+	for i := uint32(0); i < total; i++ {
+		parts[i] = &Part{
+			Index: i,
+			Bytes: partsBytes[i],
+			Proof: *proofs[i],
+		}
+	}
+	partsBitArray := bits.NewBitArrayFromFn(int(total), func(int) bool { return true })
+	return &PartSet{
+		total:         total,
+		hash:          root,
+		parity:        parity,
+		parts:         parts,
+		partsBitArray: partsBitArray,
+		count:         total,
+		byteSize:      int64(len(data)),
+		data:          data,
+	}, nil
+}
+
+// NumParityParts returns the number of parity parts to add to the erasure-coded
+// part set of dataSize bytes, so that there are about ratio parity parts per
+// data part, within the limit of MaxCodedParts parts in total.
+func NumParityParts(dataSize int, partSize uint32, ratio float64) uint32 {
+	if ratio <= 0 {
+		return 0
+	}
+	dataTotal := numCodedDataParts(dataSize, partSize)
+	if dataTotal >= MaxCodedParts {
+		return 0
+	}
+	parity := uint32(math.Ceil(float64(dataTotal) * ratio))
+	return min(parity, MaxCodedParts-dataTotal)
+}
+
+// numCodedDataParts returns the number of data parts of an erasure-coded part
+// set of dataSize bytes.
+func numCodedDataParts(dataSize int, partSize uint32) uint32 {
+	return (uint32(dataSize) + codedLengthPrefixSize + partSize - 1) / partSize
+}
+
 // NewPartSetFromHeader returns an empty PartSet ready to be populated.
 func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	return &PartSet{
 		total:         header.Total,
 		hash:          header.Hash,
+		parity:        header.Parity,
 		parts:         make([]*Part, header.Total),
 		partsBitArray: bits.NewBitArray(int(header.Total)),
 		count:         0,
@@ -221,8 +344,9 @@ func (ps *PartSet) Header() PartSetHeader {
 		return PartSetHeader{}
 	}
 	return PartSetHeader{
-		Total: ps.total,
-		Hash:  ps.hash,
+		Total:  ps.total,
+		Hash:   ps.hash,
+		Parity: ps.parity,
 	}
 }
 
@@ -284,6 +408,11 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
 
+	// The erasure-coded part set cannot be completed.
+	if ps.codingErr != nil {
+		return false, ps.codingErr
+	}
+
 	// Invalid part index
 	if part.Index >= ps.total {
 		return false, ErrPartSetUnexpectedIndex
@@ -308,10 +437,88 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.parts[part.Index] = part
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
-	ps.byteSize += int64(len(part.Bytes))
+	if ps.parity == 0 {
+		ps.byteSize += int64(len(part.Bytes))
+		return true, nil
+	}
+
+	// Erasure-coded part set: do not count the length prefix, and reconstruct
+	// the missing parts as soon as there are enough of them.
+	ps.byteSize = cmtmath.MaxInt64(0, int64(ps.count)*int64(len(part.Bytes))-codedLengthPrefixSize)
+	if ps.count == ps.total-ps.parity {
+		if err := ps.reconstruct(); err != nil {
+			ps.codingErr = err
+			return true, err
+		}
+	}
 	return true, nil
 }
 
+// reconstruct computes the missing parts of an erasure-coded part set, from
+// the data parts received, and checks that they are consistent with the part
+// set hash. The caller must hold ps.mtx.
+func (ps *PartSet) reconstruct() error {
+	dataTotal := ps.total - ps.parity
+	enc, err := erasure.NewEncoder(int(dataTotal), int(ps.parity))
+	if err != nil {
+		return err
+	}
+	partsBytes := make([][]byte, ps.total)
+	for i, part := range ps.parts {
+		if part != nil {
+			partsBytes[i] = part.Bytes
+		}
+	}
+	if err := enc.Reconstruct(partsBytes); err != nil {
+		return fmt.Errorf("%w: %w", ErrPartSetInconsistentCoding, err)
+	}
+
+	// All the parts must be consistent with the hash, otherwise different
+	// subsets of the parts would decode to different data.
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	if !bytes.Equal(root, ps.hash) {
+		return ErrPartSetInconsistentCoding
+	}
+
+	buf := make([]byte, 0, int(dataTotal)*len(partsBytes[0]))
+	for _, bz := range partsBytes[:dataTotal] {
+		buf = append(buf, bz...)
+	}
+	data, err := TrimCodedData(buf)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPartSetInconsistentCoding, err)
+	}
+
+	for i, part := range ps.parts {
+		if part == nil {
+			ps.parts[i] = &Part{
+				Index: uint32(i),
+				Bytes: partsBytes[i],
+				Proof: *proofs[i],
+			}
+			ps.partsBitArray.SetIndex(i, true)
+		}
+	}
+	ps.count = ps.total
+	ps.byteSize = int64(len(data))
+	ps.data = data
+	return nil
+}
+
+// TrimCodedData returns the data of an erasure-coded part set, given the
+// concatenation of its data parts, by removing the length prefix and the
+// padding.
+func TrimCodedData(bz []byte) ([]byte, error) {
+	if len(bz) < codedLengthPrefixSize {
+		return nil, errors.New("erasure-coded data too short")
+	}
+	size := binary.BigEndian.Uint32(bz)
+	if uint64(size) > uint64(len(bz)-codedLengthPrefixSize) {
+		return nil, fmt.Errorf("erasure-coded data length %d exceeds the parts size %d", size, len(bz)-codedLengthPrefixSize)
+	}
+	return bz[codedLengthPrefixSize : codedLengthPrefixSize+int(size)], nil
+}
+
 func (ps *PartSet) GetPart(index int) *Part {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
@@ -326,6 +533,9 @@ func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
+	if ps.parity > 0 {
+		return bytes.NewReader(ps.data)
+	}
 	return NewPartSetReader(ps.parts)
 }
 
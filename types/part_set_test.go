@@ -105,6 +105,102 @@ func TestWrongProof(t *testing.T) {
 	}
 }
 
+func TestCodedPartSet(t *testing.T) {
+	const (
+		dataSize = testPartSize*10 + 100
+		parity   = 5
+	)
+	data := cmtrand.Bytes(dataSize)
+	partSet, err := NewCodedPartSetFromData(data, testPartSize, parity)
+	require.NoError(t, err)
+
+	// The length-prefixed data is padded to 11 data parts.
+	assert.EqualValues(t, 11+parity, partSet.Total())
+	assert.EqualValues(t, parity, partSet.Header().Parity)
+	assert.EqualValues(t, 11, partSet.Header().DataTotal())
+	assert.True(t, partSet.IsComplete())
+	assert.EqualValues(t, dataSize, partSet.ByteSize())
+	for i := 0; i < int(partSet.Total()); i++ {
+		require.NoError(t, partSet.GetPart(i).ValidateBasic())
+	}
+
+	// Any 11 parts reconstruct the data, and the missing parts.
+	for _, indexes := range [][]int{
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		{0, 2, 4, 6, 8, 10, 11, 12, 13, 14, 15},
+	} {
+		partSet2 := NewPartSetFromHeader(partSet.Header())
+		for i, index := range indexes {
+			assert.False(t, partSet2.IsComplete())
+			added, err := partSet2.AddPart(partSet.GetPart(index))
+			require.NoError(t, err)
+			require.True(t, added)
+			assert.LessOrEqual(t, partSet2.ByteSize(), int64(dataSize))
+			if i < len(indexes)-1 {
+				assert.EqualValues(t, i+1, partSet2.Count())
+			}
+		}
+		require.True(t, partSet2.IsComplete(), "parts %v", indexes)
+		assert.EqualValues(t, dataSize, partSet2.ByteSize())
+		assert.True(t, partSet2.BitArray().IsFull())
+		for i := 0; i < int(partSet.Total()); i++ {
+			assert.Equal(t, partSet.GetPart(i), partSet2.GetPart(i))
+		}
+		data2, err := io.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+	}
+
+	// Too many parts.
+	_, err = NewCodedPartSetFromData(data, testPartSize, MaxCodedParts)
+	require.Error(t, err)
+
+	// No parity parts.
+	partSet, err = NewCodedPartSetFromData(data, testPartSize, 0)
+	require.NoError(t, err)
+	assert.Equal(t, NewPartSetFromData(data, testPartSize).Header(), partSet.Header())
+}
+
+func TestCodedPartSetInconsistent(t *testing.T) {
+	// 4 data parts, with the length prefix, and 2 parity parts.
+	data := cmtrand.Bytes(testPartSize*4 - 4)
+	partSet, err := NewCodedPartSetFromData(data, testPartSize, 2)
+	require.NoError(t, err)
+
+	// Corrupt a parity part, and commit to the corrupted parts.
+	partsBytes := make([][]byte, partSet.Total())
+	for i := range partsBytes {
+		partsBytes[i] = partSet.GetPart(i).Bytes
+	}
+	partsBytes[5] = cmtrand.Bytes(testPartSize)
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	header := PartSetHeader{Total: partSet.Total(), Hash: root, Parity: 2}
+
+	partSet2 := NewPartSetFromHeader(header)
+	for _, index := range []int{0, 1, 2, 3} {
+		added, err := partSet2.AddPart(&Part{Index: uint32(index), Bytes: partsBytes[index], Proof: *proofs[index]})
+		require.True(t, added)
+		if index < 3 {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, ErrPartSetInconsistentCoding)
+		}
+	}
+	assert.False(t, partSet2.IsComplete())
+	added, err := partSet2.AddPart(&Part{Index: 5, Bytes: partsBytes[5], Proof: *proofs[5]})
+	assert.False(t, added)
+	require.ErrorIs(t, err, ErrPartSetInconsistentCoding)
+}
+
+func TestNumParityParts(t *testing.T) {
+	assert.EqualValues(t, 0, NumParityParts(testPartSize*10, testPartSize, 0))
+	assert.EqualValues(t, 6, NumParityParts(testPartSize*10, testPartSize, 0.5))
+	assert.EqualValues(t, 11, NumParityParts(testPartSize*10, testPartSize, 1))
+	assert.EqualValues(t, MaxCodedParts-200, NumParityParts(testPartSize*200-4, testPartSize, 1))
+	assert.EqualValues(t, 0, NumParityParts(testPartSize*MaxCodedParts, testPartSize, 1))
+}
+
 func TestPartSetHeaderValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName              string
@@ -113,6 +209,12 @@ func TestPartSetHeaderValidateBasic(t *testing.T) {
 	}{
 		{"Good PartSet", func(_ *PartSetHeader) {}, false},
 		{"Invalid Hash", func(psHeader *PartSetHeader) { psHeader.Hash = make([]byte, 1) }, true},
+		{"Good Parity", func(psHeader *PartSetHeader) { psHeader.Parity = 99 }, false},
+		{"Too Many Parity Parts", func(psHeader *PartSetHeader) { psHeader.Parity = 100 }, true},
+		{"Too Many Coded Parts", func(psHeader *PartSetHeader) {
+			psHeader.Total = MaxCodedParts + 1
+			psHeader.Parity = 1
+		}, true},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(t *testing.T) {
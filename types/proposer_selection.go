@@ -0,0 +1,135 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// ProposerSelection is the algorithm selecting the proposer of each round
+// among the validators of a height. It is set by the
+// ValidatorParams.ProposerSelection consensus parameter.
+type ProposerSelection int32
+
+const (
+	// ProposerSelectionWeightedRoundRobin selects the validator with the
+	// highest proposer priority, as computed by IncrementProposerPriority.
+	ProposerSelectionWeightedRoundRobin = ProposerSelection(cmtproto.ProposerSelectionWeightedRoundRobin)
+	// ProposerSelectionStakeWeightedRandom selects a validator at random, with
+	// a probability proportional to its voting power. The draw is seeded from
+	// the hash of the previous block, the height and the round, thus it is
+	// deterministic.
+	ProposerSelectionStakeWeightedRandom = ProposerSelection(cmtproto.ProposerSelectionStakeWeightedRandom)
+)
+
+var proposerSelectionNames = map[ProposerSelection]string{
+	ProposerSelectionWeightedRoundRobin:  "weighted_round_robin",
+	ProposerSelectionStakeWeightedRandom: "stake_weighted_random",
+}
+
+// IsValid returns whether ps is a known proposer selection algorithm.
+func (ps ProposerSelection) IsValid() bool {
+	_, ok := proposerSelectionNames[ps]
+	return ok
+}
+
+// String returns the name of the algorithm, as used in JSON.
+func (ps ProposerSelection) String() string {
+	if name, ok := proposerSelectionNames[ps]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int32(ps))
+}
+
+// MarshalJSON encodes the algorithm as its name.
+func (ps ProposerSelection) MarshalJSON() ([]byte, error) {
+	if !ps.IsValid() {
+		return nil, fmt.Errorf("unknown proposer selection %d", int32(ps))
+	}
+	return json.Marshal(ps.String())
+}
+
+// UnmarshalJSON decodes the algorithm from its name.
+func (ps *ProposerSelection) UnmarshalJSON(bz []byte) error {
+	var name string
+	if err := json.Unmarshal(bz, &name); err != nil {
+		return err
+	}
+	for sel, selName := range proposerSelectionNames {
+		if selName == name {
+			*ps = sel
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown proposer selection %q", name)
+}
+
+// SelectProposer sets the proposer of the validator set, whose proposer
+// priorities must be those of round at height, according to selection, the
+// previous block having hash prevBlockHash (empty at the initial height).
+//
+// With the weighted round-robin, the proposer is the one set when the
+// priorities were last incremented, thus it must be called after
+// IncrementProposerPriority, and before any other call to SelectProposer.
+func (vals *ValidatorSet) SelectProposer(selection ProposerSelection, prevBlockHash []byte, height int64, round int32) {
+	if vals.IsNilOrEmpty() {
+		return
+	}
+	switch selection {
+	case ProposerSelectionStakeWeightedRandom:
+		vals.Proposer = vals.stakeWeightedRandomProposer(prevBlockHash, height, round)
+	default:
+		if vals.Proposer == nil {
+			vals.Proposer = vals.findProposer()
+		}
+	}
+}
+
+// ProposerForRound returns the proposer of round at height according to
+// selection, the proposer priorities of the validator set being those of
+// round 0, as are those of the validator set of a height in the state, and
+// the previous block having hash prevBlockHash. It does not modify the set.
+func (vals *ValidatorSet) ProposerForRound(selection ProposerSelection, prevBlockHash []byte, height int64, round int32) *Validator {
+	if vals.IsNilOrEmpty() {
+		return nil
+	}
+	switch selection {
+	case ProposerSelectionStakeWeightedRandom:
+		return vals.stakeWeightedRandomProposer(prevBlockHash, height, round).Copy()
+	default:
+		if round == 0 {
+			return vals.GetProposer()
+		}
+		return vals.CopyIncrementProposerPriority(round).GetProposer()
+	}
+}
+
+// stakeWeightedRandomProposer draws a validator with a probability
+// proportional to its voting power, from the SHA-256 hash of prevBlockHash,
+// height and round. The validators are taken in the order of the set, which is
+// the same on every node.
+func (vals *ValidatorSet) stakeWeightedRandomProposer(prevBlockHash []byte, height int64, round int32) *Validator {
+	seed := make([]byte, 0, len(prevBlockHash)+12)
+	seed = append(seed, prevBlockHash...)
+	seed = binary.BigEndian.AppendUint64(seed, uint64(height))
+	seed = binary.BigEndian.AppendUint32(seed, uint32(round))
+	hash := sha256.Sum256(seed)
+
+	// The hash is far larger than the total voting power, which makes the bias
+	// of the modulo negligible.
+	draw := new(big.Int).SetBytes(hash[:])
+	draw.Mod(draw, big.NewInt(vals.TotalVotingPower()))
+	target := draw.Int64()
+
+	for _, val := range vals.Validators {
+		if target < val.VotingPower {
+			return val
+		}
+		target -= val.VotingPower
+	}
+	panic("stake-weighted draw exceeds the total voting power")
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
+
+func TestProposerForRoundWeightedRoundRobin(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 1000),
+		newValidator([]byte("bar"), 300),
+		newValidator([]byte("baz"), 330),
+	})
+	incremented := vset.Copy()
+	for round := int32(0); round < 20; round++ {
+		proposer := vset.ProposerForRound(ProposerSelectionWeightedRoundRobin, nil, 1, round)
+		assert.Equal(t, incremented.GetProposer().Address, proposer.Address, "round %d", round)
+
+		// Selecting the proposer of an incremented set keeps it.
+		incremented.SelectProposer(ProposerSelectionWeightedRoundRobin, nil, 1, round)
+		assert.Equal(t, proposer.Address, incremented.GetProposer().Address, "round %d", round)
+		incremented.IncrementProposerPriority(1)
+	}
+}
+
+func TestProposerForRoundStakeWeightedRandom(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 600),
+		newValidator([]byte("bar"), 300),
+		newValidator([]byte("baz"), 100),
+	})
+	original := vset.Copy()
+
+	counts := make(map[string]int)
+	const draws = 10000
+	for i := 0; i < draws; i++ {
+		prevBlockHash := tmhash.Sum([]byte(fmt.Sprintf("block %d", i)))
+		height := int64(i + 2)
+		round := int32(i % 3)
+
+		proposer := vset.ProposerForRound(ProposerSelectionStakeWeightedRandom, prevBlockHash, height, round)
+		require.NotNil(t, proposer)
+		counts[string(proposer.Address)]++
+
+		// The selection is deterministic, and does not depend on the
+		// proposer priorities.
+		again := vset.CopyIncrementProposerPriority(round + 1)
+		again.SelectProposer(ProposerSelectionStakeWeightedRandom, prevBlockHash, height, round)
+		assert.Equal(t, proposer.Address, again.GetProposer().Address)
+	}
+	assert.Equal(t, original, vset, "the validator set was modified")
+
+	// The proposers are drawn proportionally to their voting power.
+	assert.InDelta(t, 0.6, float64(counts["foo"])/draws, 0.03)
+	assert.InDelta(t, 0.3, float64(counts["bar"])/draws, 0.03)
+	assert.InDelta(t, 0.1, float64(counts["baz"])/draws, 0.03)
+
+	// The draw depends on the previous block hash, the height and the round.
+	hash := tmhash.Sum([]byte("block"))
+	proposers := make(map[string]bool)
+	for round := int32(0); round < 20; round++ {
+		proposers[string(vset.ProposerForRound(ProposerSelectionStakeWeightedRandom, hash, 2, round).Address)] = true
+	}
+	assert.Len(t, proposers, 3)
+}
+
+func TestProposerSelectionJSON(t *testing.T) {
+	for _, ps := range []ProposerSelection{ProposerSelectionWeightedRoundRobin, ProposerSelectionStakeWeightedRandom} {
+		bz, err := json.Marshal(ps)
+		require.NoError(t, err)
+		assert.Equal(t, `"`+ps.String()+`"`, string(bz))
+
+		var decoded ProposerSelection
+		require.NoError(t, json.Unmarshal(bz, &decoded))
+		assert.Equal(t, ps, decoded)
+	}
+
+	_, err := json.Marshal(ProposerSelection(7))
+	require.Error(t, err)
+	var decoded ProposerSelection
+	require.Error(t, json.Unmarshal([]byte(`"round_robin"`), &decoded))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cometbft/cometbft/crypto/batch"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmterrors "github.com/cometbft/cometbft/types/errors"
@@ -43,6 +44,11 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// only count the signatures that are for the block
 	count := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagCommit }
 
+	// verify the aggregated signature, if any
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded, ignore, count)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -105,6 +111,11 @@ func verifyCommitLightInternal(
 	// count all the remaining signatures
 	count := func(_ CommitSig) bool { return true }
 
+	// verify the aggregated signature, if any
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded, ignore, count)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -184,6 +195,23 @@ func verifyCommitLightTrustingInternal(
 	// count all the remaining signatures
 	count := func(_ CommitSig) bool { return true }
 
+	// The signers of an aggregated commit are only known by their index, and
+	// the aggregated signature can only be verified with all their public
+	// keys, so vals must be the validator set of the commit. As we don't know
+	// whether it is, a commit that cannot be verified is deemed not to have
+	// been signed by enough voting power, so that the light client falls back
+	// to verifying the intermediate headers.
+	if commit.IsAggregated() {
+		if vals.Size() != len(commit.Signatures) {
+			return ErrNotEnoughVotingPowerSigned{Got: 0, Needed: votingPowerNeeded}
+		}
+		err := verifyCommitAggregated(chainID, vals, commit, votingPowerNeeded, ignore, count)
+		if err != nil && !IsErrNotEnoughVotingPowerSigned(err) {
+			return ErrNotEnoughVotingPowerSigned{Got: 0, Needed: votingPowerNeeded}
+		}
+		return err
+	}
+
 	// attempt to batch verify commit. As the validator set doesn't necessarily
 	// correspond with the validator set that signed the block we need to look
 	// up by address rather than index.
@@ -323,6 +351,57 @@ func verifyCommitBatch(
 	return errors.New("BUG: batch verification failed with no invalid signatures")
 }
 
+// Aggregated Verification
+
+// verifyCommitAggregated verifies the aggregated signature of a commit, whose
+// signers are looked up by index in vals. As the signatures are verified all at
+// once, they are always all checked.
+func verifyCommitAggregated(
+	chainID string,
+	vals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+) error {
+	var (
+		pubKeys            = make([]bls12381.PubKey, 0, len(commit.Signatures))
+		msgs               = make([][]byte, 0, len(commit.Signatures))
+		talliedVotingPower int64
+	)
+	for idx, commitSig := range commit.Signatures {
+		// All the non-absent signatures are aggregated, including the ignored
+		// ones, which must therefore be verified too.
+		if commitSig.BlockIDFlag == BlockIDFlagAbsent {
+			continue
+		}
+
+		val := vals.Validators[idx]
+		pubKey, ok := val.PubKey.(bls12381.PubKey)
+		if !ok {
+			return fmt.Errorf("validator %v does not have a BLS12-381 key at index %d", val, idx)
+		}
+		pubKeys = append(pubKeys, pubKey)
+		msgs = append(msgs, commit.VoteSignBytes(chainID, int32(idx)))
+
+		// If this signature counts then add the voting power of the validator
+		// to the tally
+		if !ignoreSig(commitSig) && countSig(commitSig) {
+			talliedVotingPower += val.VotingPower
+		}
+	}
+
+	// ensure that enough voting power signed before verifying the signature
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
+	}
+
+	if !bls12381.VerifyAggregateSignature(commit.AggregatedSignature, pubKeys, msgs) {
+		return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	return nil
+}
+
 // Single Verification
 
 // verifyCommitSingle single verifies commits.
@@ -292,6 +292,38 @@ func TestValidatorSet_VerifyCommitLightTrusting(t *testing.T) {
 	}
 }
 
+func TestValidatorSet_VerifyAggregatedCommit(t *testing.T) {
+	var (
+		chainID               = "test_chain_id"
+		blockID               = makeBlockIDRandom()
+		voteSet, valSet, vals = randVoteSet(1, 1, PrecommitType, 4, 1, false)
+		extCommit, err        = MakeExtCommit(blockID, 1, 1, voteSet, vals, cmttime.Now(), false)
+	)
+	require.NoError(t, err)
+	commit := aggregatedCommit(extCommit.ToCommit())
+
+	// The validators do not have BLS12-381 keys.
+	err = valSet.VerifyCommit(chainID, blockID, 1, commit)
+	require.ErrorContains(t, err, "BLS12-381")
+	err = valSet.VerifyCommitLight(chainID, blockID, 1, commit)
+	require.ErrorContains(t, err, "BLS12-381")
+
+	// The trusting verification falls back to the voting power not being
+	// enough.
+	err = valSet.VerifyCommitLightTrusting(chainID, commit, cmtmath.Fraction{Numerator: 1, Denominator: 3})
+	require.True(t, IsErrNotEnoughVotingPowerSigned(err), err)
+	newValSet, _ := RandValidatorSet(2, 1)
+	err = newValSet.VerifyCommitLightTrusting(chainID, commit, cmtmath.Fraction{Numerator: 1, Denominator: 3})
+	require.True(t, IsErrNotEnoughVotingPowerSigned(err), err)
+
+	// Not enough voting power signed.
+	for i := range commit.Signatures {
+		commit.Signatures[i] = NewCommitSigAbsent()
+	}
+	err = valSet.VerifyCommit(chainID, blockID, 1, commit)
+	require.True(t, IsErrNotEnoughVotingPowerSigned(err), err)
+}
+
 func TestValidatorSet_VerifyCommitLightTrustingErrorsOnOverflow(t *testing.T) {
 	var (
 		blockID               = makeBlockIDRandom()
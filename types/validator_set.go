@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 )
@@ -776,6 +777,16 @@ func (vals *ValidatorSet) AllKeysHaveSameType() bool {
 	return vals.allKeysHaveSameType
 }
 
+// AllKeysAreBLS12381 returns true if all validators use BLS12-381 keys, in
+// which case the signatures of their commits can be aggregated.
+func (vals *ValidatorSet) AllKeysAreBLS12381() bool {
+	if vals.Size() == 0 || !vals.allKeysHaveSameType {
+		return false
+	}
+	pubKey := vals.Validators[0].PubKey
+	return pubKey != nil && pubKey.Type() == bls12381.KeyType
+}
+
 // -----------------
 
 // IsErrNotEnoughVotingPowerSigned returns true if err is